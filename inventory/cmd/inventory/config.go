@@ -0,0 +1,225 @@
+// file: cmd/inventory/config.go
+package main
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/infrastructure/kafka/consumer"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+	"github.com/inventory-service/internal/infrastructure/postgres"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
+
+// Config holds the service configuration loaded from the environment
+type Config struct {
+	HTTPAddr        string
+	ShutdownTimeout time.Duration
+	Database        postgres.Config
+	MigrateOnStart  bool // apply pending migrations before serving instead of refusing to start
+	StockRetry      usecase.RetryPolicy
+	ReservationTTL  usecase.ReservationTTLPolicy
+	Backorders      usecase.BackorderOrdering // which open backorders arriving stock serves first
+	Adjustments     usecase.AdjustmentPolicy  // reason code catalog and approval thresholds
+	SweepInterval   time.Duration             // how often the leader expires overdue reservations
+	Kafka           producer.Config
+	Consumer        consumer.Config
+	Outbox          producer.OutboxConfig
+	TopicPrefix     string
+	JWT             middleware.JWTConfig
+}
+
+// loadConfig reads the service configuration from environment variables
+func loadConfig() (Config, error) {
+	cfg := Config{
+		HTTPAddr:        envString("HTTP_ADDR", ":8080"),
+		ShutdownTimeout: envDuration("SHUTDOWN_TIMEOUT", 15*time.Second),
+		Database:        postgres.DefaultConfig(),
+		MigrateOnStart:  envBool("MIGRATE_ON_START", false),
+		StockRetry:      usecase.DefaultRetryPolicy(),
+		ReservationTTL:  usecase.DefaultReservationTTLPolicy(),
+		Adjustments:     usecase.DefaultAdjustmentPolicy(),
+		SweepInterval:   envDuration("RESERVATION_SWEEP_INTERVAL", 30*time.Second),
+		Kafka:           producer.DefaultConfig(),
+		Consumer:        consumer.DefaultConfig(),
+		Outbox:          producer.DefaultOutboxConfig(),
+		TopicPrefix:     os.Getenv("KAFKA_TOPIC_PREFIX"),
+		JWT: middleware.JWTConfig{
+			JWKSURL:         os.Getenv("JWT_JWKS_URL"),
+			Issuer:          os.Getenv("JWT_ISSUER"),
+			Audience:        os.Getenv("JWT_AUDIENCE"),
+			ClockSkew:       envDuration("JWT_CLOCK_SKEW", 30*time.Second),
+			RefreshInterval: envDuration("JWT_JWKS_REFRESH_INTERVAL", 15*time.Minute),
+		},
+	}
+
+	cfg.Database.DSN = envString("DATABASE_URL", cfg.Database.DSN)
+	cfg.Database.MaxConns = int32(envInt("DATABASE_MAX_CONNS", int(cfg.Database.MaxConns)))
+	if brokers := os.Getenv("KAFKA_BROKERS"); brokers != "" {
+		cfg.Kafka.Brokers = strings.Split(brokers, ",")
+	}
+	cfg.Kafka.ClientID = envString("KAFKA_CLIENT_ID", cfg.Kafka.ClientID)
+	cfg.Kafka.CompressionType = envString("KAFKA_COMPRESSION", cfg.Kafka.CompressionType)
+	cfg.Consumer.Brokers = cfg.Kafka.Brokers
+	cfg.Consumer.ClientID = cfg.Kafka.ClientID
+	cfg.Consumer.GroupID = envString("KAFKA_CONSUMER_GROUP", cfg.Consumer.GroupID)
+	if topics := os.Getenv("KAFKA_CONSUMER_TOPICS"); topics != "" {
+		cfg.Consumer.Topics = strings.Split(topics, ",")
+	}
+	cfg.Consumer.InitialOffset = envString("KAFKA_CONSUMER_INITIAL_OFFSET", cfg.Consumer.InitialOffset)
+	cfg.Consumer.DeadLetterTopic = envString("KAFKA_DEAD_LETTER_TOPIC", cfg.Consumer.DeadLetterTopic)
+	if tiers := os.Getenv("KAFKA_RETRY_TIERS"); tiers != "" {
+		delays, err := parseDurations(tiers)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid KAFKA_RETRY_TIERS: %w", err)
+		}
+		cfg.Consumer.RetryTiers = delays
+	}
+	cfg.Consumer.ProcessingLease = envDuration("KAFKA_CONSUMER_PROCESSING_LEASE", cfg.Consumer.ProcessingLease)
+	cfg.Consumer.IdempotencyTTL = envDuration("IDEMPOTENCY_TTL", cfg.Consumer.IdempotencyTTL)
+	cfg.Outbox.PollInterval = envDuration("OUTBOX_POLL_INTERVAL", cfg.Outbox.PollInterval)
+	cfg.Outbox.BatchSize = envInt("OUTBOX_BATCH_SIZE", cfg.Outbox.BatchSize)
+	cfg.Outbox.MaxRetries = envInt("OUTBOX_MAX_RETRIES", cfg.Outbox.MaxRetries)
+	cfg.Outbox.RetentionPeriod = envDuration("OUTBOX_RETENTION_PERIOD", cfg.Outbox.RetentionPeriod)
+	cfg.StockRetry.MaxAttempts = envInt("STOCK_RETRY_MAX_ATTEMPTS", cfg.StockRetry.MaxAttempts)
+	cfg.StockRetry.BaseDelay = envDuration("STOCK_RETRY_BASE_DELAY", cfg.StockRetry.BaseDelay)
+	cfg.StockRetry.MaxDelay = envDuration("STOCK_RETRY_MAX_DELAY", cfg.StockRetry.MaxDelay)
+	cfg.ReservationTTL.DefaultTTL = envDuration("RESERVATION_DEFAULT_TTL", cfg.ReservationTTL.DefaultTTL)
+	cfg.ReservationTTL.DefaultMaxLifetime = envDuration("RESERVATION_MAX_LIFETIME", cfg.ReservationTTL.DefaultMaxLifetime)
+	cfg.ReservationTTL.MaxLifetime = map[string]time.Duration{
+		middleware.RoleAdmin:            24 * time.Hour,
+		middleware.RoleInventoryManager: 24 * time.Hour,
+		middleware.RoleOrderService:     2 * time.Hour,
+	}
+	if limits := os.Getenv("RESERVATION_ROLE_MAX_LIFETIMES"); limits != "" {
+		if err := parseRoleDurations(limits, cfg.ReservationTTL.MaxLifetime); err != nil {
+			return cfg, fmt.Errorf("invalid RESERVATION_ROLE_MAX_LIFETIMES: %w", err)
+		}
+	}
+	cfg.Backorders = usecase.BackorderOrdering(strings.ToUpper(envString("BACKORDER_ORDERING", "fifo")))
+	if cfg.Backorders != usecase.BackorderOrderingFIFO && cfg.Backorders != usecase.BackorderOrderingPriority {
+		return cfg, fmt.Errorf("invalid BACKORDER_ORDERING %q: want fifo or priority", cfg.Backorders)
+	}
+	if codes := os.Getenv("ADJUSTMENT_REASON_CODES"); codes != "" {
+		cfg.Adjustments.ReasonCodes = nil
+		for _, code := range strings.Split(codes, ",") {
+			if code = strings.ToUpper(strings.TrimSpace(code)); code != "" {
+				cfg.Adjustments.ReasonCodes = append(cfg.Adjustments.ReasonCodes, code)
+			}
+		}
+	}
+	cfg.Adjustments.ApprovalQuantity = envInt("ADJUSTMENT_APPROVAL_QUANTITY", cfg.Adjustments.ApprovalQuantity)
+	cfg.Adjustments.ApprovalValue = envFloat("ADJUSTMENT_APPROVAL_VALUE", cfg.Adjustments.ApprovalValue)
+	if len(cfg.Adjustments.ReasonCodes) == 0 {
+		return cfg, errors.New("ADJUSTMENT_REASON_CODES must list at least one reason code")
+	}
+	if cfg.ReservationTTL.DefaultTTL <= 0 {
+		return cfg, errors.New("RESERVATION_DEFAULT_TTL must be positive")
+	}
+
+	if path := os.Getenv("JWT_PUBLIC_KEY_FILE"); path != "" {
+		key, err := loadRSAPublicKey(path)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.JWT.PublicKey = key
+	}
+	if cfg.JWT.PublicKey == nil && cfg.JWT.JWKSURL == "" {
+		return cfg, errors.New("either JWT_PUBLIC_KEY_FILE or JWT_JWKS_URL must be set")
+	}
+
+	return cfg, nil
+}
+
+func loadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT public key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("JWT public key is not PEM encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("JWT public key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func envString(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func envInt(key string, fallback int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return fallback
+}
+
+func envFloat(key string, fallback float64) float64 {
+	if v, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return v
+	}
+	return fallback
+}
+
+func envBool(key string, fallback bool) bool {
+	if v, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// parseDurations parses a comma-separated list of durations such as "30s,5m,30m"
+func parseDurations(list string) ([]time.Duration, error) {
+	var durations []time.Duration
+	for _, v := range strings.Split(list, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		durations = append(durations, d)
+	}
+	return durations, nil
+}
+
+// parseRoleDurations parses a comma-separated list of role limits such as
+// "order_service=2h,admin=24h" into limits, overriding the roles it names
+func parseRoleDurations(list string, limits map[string]time.Duration) error {
+	for _, v := range strings.Split(list, ",") {
+		role, value, ok := strings.Cut(strings.TrimSpace(v), "=")
+		if !ok || role == "" {
+			return fmt.Errorf("%q is not role=duration", v)
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		limits[role] = d
+	}
+	return nil
+}
+
+func envDuration(key string, fallback time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,217 @@
+// file: cmd/inventory/main.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/infrastructure/kafka/consumer"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+	"github.com/inventory-service/internal/infrastructure/postgres"
+	"github.com/inventory-service/internal/infrastructure/postgres/migrate"
+	"github.com/inventory-service/internal/infrastructure/scheduler"
+	"github.com/inventory-service/internal/interfaces/http/handler"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+	"github.com/inventory-service/internal/interfaces/http/router"
+)
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	slog.SetDefault(logger)
+
+	if err := run(logger); err != nil {
+		logger.Error("inventory service exited with error", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func run(logger *slog.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	pool, err := postgres.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	if err := checkSchema(ctx, pool, cfg.MigrateOnStart, logger); err != nil {
+		return err
+	}
+
+	db := postgres.NewDB(pool)
+	products := postgres.NewProductRepository(db)
+	warehouses := postgres.NewWarehouseRepository(db)
+	stockItems := postgres.NewStockItemRepository(db)
+	movements := postgres.NewStockMovementRepository(db)
+	reservations := postgres.NewReservationRepository(db)
+	backorders := postgres.NewBackorderRepository(db)
+	transfers := postgres.NewTransferRepository(db)
+	adjustments := postgres.NewAdjustmentRepository(db)
+	countSessions := postgres.NewCountSessionRepository(db)
+	lots := postgres.NewLotRepository(db)
+	serials := postgres.NewSerialUnitRepository(db)
+	locations := postgres.NewLocationRepository(db)
+	kits := postgres.NewKitRepository(db)
+	units := postgres.NewUnitOfMeasureRepository(db)
+	publisher := postgres.NewOutboxPublisher(db)
+
+	kafkaProducer, err := producer.NewKafkaProducer(cfg.Kafka)
+	if err != nil {
+		return err
+	}
+	defer kafkaProducer.Close()
+
+	outbox := producer.NewOutboxProcessor(db, postgres.NewOutboxStore(db), kafkaProducer,
+		producer.NewDefaultTopicMapper(cfg.TopicPrefix), cfg.Outbox, logger)
+	if err := outbox.Start(ctx); err != nil {
+		return err
+	}
+
+	reservationUseCase := usecase.NewReservationUseCase(db, products, warehouses, stockItems, movements, lots, serials,
+		locations, kits, units, reservations, backorders, publisher, usecase.NewAllocator(products, warehouses, stockItems, kits),
+		cfg.ReservationTTL, cfg.StockRetry)
+	backorderUseCase := usecase.NewBackorderUseCase(db, backorders, reservationUseCase, cfg.Backorders)
+	idempotency := postgres.NewIdempotencyStore(db)
+	orderEvents := consumer.NewOrderEventHandler(db, idempotency,
+		usecase.NewOrderEventUseCase(db, products, warehouses, reservationUseCase, publisher),
+		cfg.Consumer.ProcessingLease, logger)
+	deadLetters := postgres.NewDeadLetterStore(db)
+	orderConsumer, err := consumer.NewGroupConsumer(cfg.Consumer, orderEvents, kafkaProducer, deadLetters, logger)
+	if err != nil {
+		return err
+	}
+	if err := orderConsumer.Start(ctx); err != nil {
+		return err
+	}
+	purger := consumer.NewIdempotencyPurger(idempotency, cfg.Consumer, logger)
+	if err := purger.Start(ctx); err != nil {
+		return err
+	}
+	sweeper := scheduler.NewReservationSweeper(reservationUseCase,
+		postgres.NewAdvisoryLockElector(db, "reservation-expiry-sweeper", cfg.SweepInterval, logger),
+		cfg.SweepInterval, logger)
+	if err := sweeper.Start(ctx); err != nil {
+		return err
+	}
+
+	jwt, err := middleware.NewJWTMiddleware(cfg.JWT)
+	if err != nil {
+		return fmt.Errorf("failed to initialize JWT middleware: %w", err)
+	}
+
+	httpHandler := router.New(router.Config{
+		JWT:  jwt,
+		RBAC: middleware.NewRBACMiddleware(),
+		Product: handler.NewProductHandler(
+			usecase.NewProductUseCase(db, products, stockItems, kits, units)),
+		Warehouse: handler.NewWarehouseHandler(
+			usecase.NewWarehouseUseCase(warehouses, stockItems)),
+		StockItem: handler.NewStockItemHandler(
+			usecase.NewStockItemUseCase(db, products, warehouses, stockItems, movements, locations, publisher)),
+		Reservation: handler.NewReservationHandler(reservationUseCase),
+		Backorder:   handler.NewBackorderHandler(backorderUseCase),
+		Transfer: handler.NewTransferHandler(
+			usecase.NewTransferUseCase(db, products, warehouses, stockItems, movements, lots, locations, kits, transfers,
+				publisher, backorderUseCase, cfg.StockRetry)),
+		Adjustment: handler.NewAdjustmentHandler(
+			usecase.NewAdjustmentUseCase(db, products, warehouses, stockItems, movements, lots, locations, kits, units,
+				adjustments, publisher, backorderUseCase, cfg.Adjustments, cfg.StockRetry)),
+		CountSession: handler.NewCountSessionHandler(
+			usecase.NewCountSessionUseCase(db, products, warehouses, stockItems, movements, lots, locations, kits,
+				countSessions, publisher, backorderUseCase, cfg.StockRetry)),
+		StockMovement: handler.NewStockMovementHandler(
+			usecase.NewStockMovementUseCase(db, products, warehouses, stockItems, movements, lots, serials, locations,
+				units, publisher, backorderUseCase, cfg.StockRetry)),
+		Lot: handler.NewLotHandler(
+			usecase.NewLotUseCase(stockItems, lots)),
+		Serial: handler.NewSerialUnitHandler(
+			usecase.NewSerialUseCase(db, products, warehouses, stockItems, movements, lots, serials, locations, kits,
+				publisher, cfg.StockRetry)),
+		Location: handler.NewLocationHandler(
+			usecase.NewLocationUseCase(db, products, warehouses, stockItems, movements, locations, publisher,
+				cfg.StockRetry)),
+		Unit: handler.NewUnitHandler(
+			usecase.NewUnitUseCase(products, units)),
+		Alert: handler.NewAlertHandler(
+			usecase.NewAlertUseCase(products, warehouses, stockItems, kits)),
+		DeadLetter: handler.NewDeadLetterHandler(
+			usecase.NewDeadLetterUseCase(db, deadLetters, consumer.NewReplayer(cfg.Consumer, kafkaProducer))),
+	})
+
+	server := &http.Server{
+		Addr:              cfg.HTTPAddr,
+		Handler:           httpHandler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("http server listening", slog.String("addr", cfg.HTTPAddr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("http server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	logger.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("http server shutdown failed: %w", err)
+	}
+	if err := orderConsumer.Stop(shutdownCtx); err != nil {
+		return err
+	}
+	if err := purger.Stop(shutdownCtx); err != nil {
+		return err
+	}
+	if err := sweeper.Stop(shutdownCtx); err != nil {
+		return err
+	}
+	if err := outbox.Stop(shutdownCtx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkSchema refuses to start the service unless the live schema is exactly at the
+// version this build expects. Pending migrations are applied first when migrateOnStart is set.
+func checkSchema(ctx context.Context, pool *pgxpool.Pool, migrateOnStart bool, logger *slog.Logger) error {
+	migrations, err := migrate.Embedded()
+	if err != nil {
+		return err
+	}
+	migrator := migrate.New(pool, migrations, logger)
+
+	if migrateOnStart {
+		if _, err := migrator.Up(ctx); err != nil {
+			return fmt.Errorf("failed to migrate schema: %w", err)
+		}
+	}
+	if err := migrator.Verify(ctx); err != nil {
+		return fmt.Errorf("refusing to start on this schema (see `migrate status` and `migrate diff`): %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// file: cmd/migrate/main.go
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/inventory-service/internal/infrastructure/postgres"
+	"github.com/inventory-service/internal/infrastructure/postgres/migrate"
+)
+
+const usage = `usage: migrate [-dsn DSN] <command>
+
+commands:
+  up             apply all pending migrations
+  down [N]       roll back the last N migrations (default 1)
+  status         list applied and pending migrations
+  diff           print differences between the expected and the live schema
+  force VERSION  mark VERSION as cleanly applied after a manual repair (0 clears history)
+`
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg := postgres.DefaultConfig()
+	if dsn := os.Getenv("DATABASE_URL"); dsn != "" {
+		cfg.DSN = dsn
+	}
+	flag.StringVar(&cfg.DSN, "dsn", cfg.DSN, "PostgreSQL connection string")
+	flag.Usage = func() { fmt.Fprint(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		return errors.New("missing command")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	migrations, err := migrate.Embedded()
+	if err != nil {
+		return err
+	}
+	pool, err := postgres.NewPool(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	migrator := migrate.New(pool, migrations, logger)
+
+	switch args[0] {
+	case "up":
+		n, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migration(s)\n", n)
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		n, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("rolled back %d migration(s)\n", n)
+
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printStatus(status)
+		if err := status.Err(); err != nil {
+			return err
+		}
+
+	case "diff":
+		diff, err := migrator.Diff(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Print(diff)
+		if !diff.Empty() {
+			return errors.New("live schema differs from expected schema")
+		}
+
+	case "force":
+		if len(args) < 2 {
+			return errors.New("force requires a version")
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		if err := migrator.Force(ctx, version); err != nil {
+			return err
+		}
+		fmt.Printf("forced schema version to %d\n", version)
+
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return nil
+}
+
+func printStatus(status migrate.Status) {
+	if status.Unmanaged {
+		fmt.Println("schema has tables but no migration history")
+	}
+	for _, a := range status.Applied {
+		state := "applied"
+		if a.Dirty {
+			state = "DIRTY"
+		}
+		fmt.Printf("%-8s %s  %s\n", state, a, a.AppliedAt.Format("2006-01-02 15:04:05Z07:00"))
+	}
+	for _, mig := range status.Pending {
+		fmt.Printf("%-8s %s\n", "pending", mig)
+	}
+	for _, a := range status.Unknown {
+		fmt.Printf("%-8s %s  (not part of this build)\n", "unknown", a)
+	}
+	for _, a := range status.Mismatched {
+		fmt.Printf("%-8s %s  (checksum differs from this build)\n", "modified", a)
+	}
+}
@@ -0,0 +1,58 @@
+// file: internal/application/port/dead_letter.go
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// DeadLetterStatus is the state of a quarantined message
+type DeadLetterStatus string
+
+const (
+	// DeadLetterStatusQuarantined means the message is waiting for an operator
+	DeadLetterStatusQuarantined DeadLetterStatus = "QUARANTINED"
+	// DeadLetterStatusReplayed means the message was sent back into processing
+	DeadLetterStatusReplayed DeadLetterStatus = "REPLAYED"
+)
+
+// DeadLetter is a consumed message that could not be processed. The embedded event keeps
+// the original Topic, Partition, Offset, Key, Value and Headers.
+type DeadLetter struct {
+	ID string
+	ConsumedEvent
+	Reason         string
+	Attempts       int // Delivery attempts made before the message was dead-lettered
+	Status         DeadLetterStatus
+	DeadLetteredAt time.Time
+	ReplayCount    int
+	ReplayedAt     *time.Time
+	ReplayedBy     string
+}
+
+// DeadLetterFilter defines filtering options for dead letter queries
+type DeadLetterFilter struct {
+	Topic  *string
+	Status *DeadLetterStatus
+	Limit  int
+	Offset int
+}
+
+// DeadLetterStore defines the port for quarantining messages
+type DeadLetterStore interface {
+	// Save quarantines a message. A message dead-lettered again after a replay is
+	// identified by its original topic, partition and offset and updated in place.
+	Save(ctx context.Context, dl *DeadLetter) error
+	// GetByID retrieves a dead letter by its ID
+	GetByID(ctx context.Context, id string) (*DeadLetter, error)
+	// List retrieves dead letters, most recent first, with the total count
+	List(ctx context.Context, filter DeadLetterFilter) ([]*DeadLetter, int, error)
+	// MarkReplayed records that a quarantined dead letter was sent back into processing.
+	// It reports false when the dead letter is not quarantined.
+	MarkReplayed(ctx context.Context, id, replayedBy string) (bool, error)
+}
+
+// MessageReplayer defines the port for sending a dead-lettered message back into processing
+type MessageReplayer interface {
+	Replay(ctx context.Context, dl *DeadLetter) error
+}
@@ -3,6 +3,8 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 )
 
 // ConsumedEvent represents a raw event consumed from Kafka
@@ -24,10 +26,55 @@ type EventConsumer interface {
 	Stop(ctx context.Context) error
 }
 
-// IdempotencyStore defines the port for tracking processed events
+// IdempotencyStatus is the processing state of a consumed event
+type IdempotencyStatus string
+
+const (
+	// IdempotencyStatusInProgress means a consumer holds a lease on the event
+	IdempotencyStatusInProgress IdempotencyStatus = "IN_PROGRESS"
+	// IdempotencyStatusCompleted means the event was handled and its result stored
+	IdempotencyStatusCompleted IdempotencyStatus = "COMPLETED"
+	// IdempotencyStatusFailed means the last attempt failed; the event may be claimed again
+	IdempotencyStatusFailed IdempotencyStatus = "FAILED"
+)
+
+var (
+	// ErrEventInProgress is returned by Claim while another consumer holds a live lease
+	ErrEventInProgress = errors.New("event is being processed by another consumer")
+	// ErrLeaseLost is returned when completing or failing an event whose lease expired
+	// and was claimed by another consumer
+	ErrLeaseLost = errors.New("processing lease was lost")
+)
+
+// IdempotencyRecord tracks the processing of one consumed event
+type IdempotencyRecord struct {
+	EventID        string
+	Topic          string
+	Status         IdempotencyStatus
+	Owner          string // Lease holder while in progress
+	LeaseExpiresAt *time.Time
+	Attempts       int
+	Result         []byte // Handler result, stored on completion
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IdempotencyStore defines the port for tracking processed events.
+// A consumer claims a lease on an event before handling it, then completes it in the
+// handler's transaction or marks it failed. A lease that is not completed in time
+// expires, and the event may then be claimed by another consumer.
 type IdempotencyStore interface {
-	// IsProcessed checks if an event has already been processed
-	IsProcessed(ctx context.Context, eventID string) (bool, error)
-	// MarkProcessed marks an event as processed
-	MarkProcessed(ctx context.Context, eventID string, topic string) error
-}
\ No newline at end of file
+	// Claim takes a lease on an event for owner. It returns the record in progress for
+	// owner, the completed record when the event was already handled, or
+	// ErrEventInProgress while another owner's lease is live.
+	Claim(ctx context.Context, eventID, topic, owner string, lease time.Duration) (*IdempotencyRecord, error)
+	// Complete stores the handler result and marks the event completed.
+	// Returns ErrLeaseLost if owner no longer holds the lease.
+	Complete(ctx context.Context, eventID, owner string, result []byte) error
+	// Fail releases the lease and records the error so the event can be claimed again.
+	// Returns ErrLeaseLost if owner no longer holds the lease.
+	Fail(ctx context.Context, eventID, owner, reason string) error
+	// Purge deletes completed and failed records, and abandoned leases, last updated before the given time
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
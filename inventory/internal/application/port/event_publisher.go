@@ -3,11 +3,12 @@ package port
 
 import (
 	"context"
+	"time"
 )
 
 // OutboxEntry represents an event to be published via the outbox pattern
 type OutboxEntry struct {
-	ID            string
+	ID            string // ID of the domain event, sent as the event_id header
 	AggregateType string
 	AggregateID   string
 	EventType     string
@@ -30,4 +31,27 @@ type OutboxProcessor interface {
 	Start(ctx context.Context) error
 	// Stop gracefully stops the outbox processor
 	Stop(ctx context.Context) error
-}
\ No newline at end of file
+}
+// PendingOutboxEntry is an outbox entry claimed for delivery
+type PendingOutboxEntry struct {
+	OutboxEntry
+	Sequence int64 // Insertion order, used to deliver each aggregate's events in order
+	Attempts int   // Failed delivery attempts so far
+}
+
+// OutboxStore defines the port for the delivery state of outbox entries.
+// ClaimPending must be called within a transaction; claimed entries stay locked
+// against other processors until that transaction ends.
+type OutboxStore interface {
+	// ClaimPending locks up to limit entries that are due for delivery, ordered by sequence.
+	// An entry is only returned when no earlier undelivered entry of the same aggregate
+	// is held elsewhere, so each aggregate's events can be delivered in order.
+	ClaimPending(ctx context.Context, limit int) ([]PendingOutboxEntry, error)
+	// MarkPublished records that the given entries were delivered
+	MarkPublished(ctx context.Context, ids []string) error
+	// MarkFailed records a failed delivery attempt. The entry is retried at nextAttemptAt,
+	// or parked and no longer retried when park is set.
+	MarkFailed(ctx context.Context, id string, reason string, nextAttemptAt time.Time, park bool) error
+	// PurgePublished deletes entries delivered before the given time and returns how many were deleted
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+}
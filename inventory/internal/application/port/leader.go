@@ -0,0 +1,14 @@
+// file: internal/application/port/leader.go
+package port
+
+import (
+	"context"
+)
+
+// LeaderElector defines the port for electing a single replica to run a background job
+type LeaderElector interface {
+	// RunWhileLeader campaigns for leadership until ctx is done. Each time this replica
+	// becomes leader fn is called with a context that is cancelled when leadership is
+	// lost; fn should return promptly once it is. At most one replica runs fn at a time.
+	RunWhileLeader(ctx context.Context, fn func(ctx context.Context)) error
+}
@@ -0,0 +1,15 @@
+// file: internal/application/port/transaction.go
+package port
+
+import (
+	"context"
+)
+
+// TransactionManager defines the port for running work inside a single database transaction
+type TransactionManager interface {
+	// WithinTransaction executes fn in a transaction carried by the context passed to fn.
+	// The transaction is committed when fn returns nil and rolled back otherwise.
+	// A call nested in an active transaction is undone on its own when it fails,
+	// leaving the outer transaction usable.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,381 @@
+// file: internal/application/usecase/adjustment_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// AdjustmentPolicy holds the reason code catalog and the thresholds above which a
+// stock adjustment waits for approval. A non-positive threshold is not enforced. While
+// the value threshold is enforced, an adjustment without a unit cost has no known value
+// and always waits for approval.
+type AdjustmentPolicy struct {
+	ReasonCodes      []string // Accepted reason codes, upper case
+	ApprovalQuantity int      // Adjustments of more units than this need approval
+	ApprovalValue    float64  // Adjustments worth more than this need approval
+}
+
+// DefaultAdjustmentPolicy returns the adjustment policy used when none is configured
+func DefaultAdjustmentPolicy() AdjustmentPolicy {
+	return AdjustmentPolicy{
+		ReasonCodes:      []string{"DAMAGE", "SHRINKAGE", "THEFT", "EXPIRED", "FOUND", "DATA_CORRECTION"},
+		ApprovalQuantity: 50,
+		ApprovalValue:    1000,
+	}
+}
+
+// requiresApproval reports whether an adjustment exceeds a threshold of the policy
+func (p AdjustmentPolicy) requiresApproval(a *entity.Adjustment) bool {
+	if p.ApprovalQuantity > 0 && max(a.Quantity, -a.Quantity) > p.ApprovalQuantity {
+		return true
+	}
+	if p.ApprovalValue <= 0 {
+		return false
+	}
+	value, known := a.Value()
+	return !known || value > p.ApprovalValue
+}
+
+// CreateAdjustmentInput carries the data required to adjust a stock item's on-hand quantity
+type CreateAdjustmentInput struct {
+	StockItemID string
+	Quantity    int    // Positive for found stock, negative for losses
+	Unit        string // Unit of measure of Quantity and UnitCost; the product's base unit when empty
+	ReasonCode  string
+	Notes       string
+	UnitCost    *float64
+	PerformedBy string
+}
+
+// ReviewAdjustmentInput carries a reviewer's decision on a pending adjustment
+type ReviewAdjustmentInput struct {
+	Notes      string
+	ReviewedBy string
+}
+
+// AdjustmentDetails is an adjustment together with the backorders found stock was reserved for
+type AdjustmentDetails struct {
+	*entity.Adjustment
+	FilledBackorders []*entity.Backorder // Set when an increase was applied
+}
+
+// AdjustmentUseCase orchestrates manual stock adjustments and their approval
+type AdjustmentUseCase struct {
+	tx          port.TransactionManager
+	products    repository.ProductRepository
+	warehouses  repository.WarehouseRepository
+	stockItems  repository.StockItemRepository
+	movements   repository.StockMovementRepository
+	lots        *lotLedger
+	bins        *binLedger
+	kits        *kitStock
+	units       *unitConverter
+	adjustments repository.AdjustmentRepository
+	publisher   port.EventPublisher
+	backorders  *BackorderUseCase
+	policy      AdjustmentPolicy
+	retry       RetryPolicy
+}
+
+// NewAdjustmentUseCase creates a new AdjustmentUseCase
+func NewAdjustmentUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	lots repository.LotRepository,
+	locations repository.LocationRepository,
+	kits repository.KitRepository,
+	units repository.UnitOfMeasureRepository,
+	adjustments repository.AdjustmentRepository,
+	publisher port.EventPublisher,
+	backorders *BackorderUseCase,
+	policy AdjustmentPolicy,
+	retry RetryPolicy,
+) *AdjustmentUseCase {
+	return &AdjustmentUseCase{
+		tx:          tx,
+		products:    products,
+		warehouses:  warehouses,
+		stockItems:  stockItems,
+		movements:   movements,
+		lots:        newLotLedger(lots),
+		bins:        newBinLedger(locations),
+		kits:        newKitStock(kits, stockItems),
+		units:       newUnitConverter(units),
+		adjustments: adjustments,
+		publisher:   publisher,
+		backorders:  backorders,
+		policy:      policy,
+		retry:       retry,
+	}
+}
+
+// ReasonCodes returns the reason codes adjustments may be made for
+func (uc *AdjustmentUseCase) ReasonCodes() []string {
+	return slices.Clone(uc.policy.ReasonCodes)
+}
+
+// Create records an adjustment of a stock item. Adjustments within the approval
+// thresholds are applied straight away; larger ones wait in PENDING_APPROVAL for Approve.
+// A quantity given in another unit is recorded as the base units it converts to, and its
+// unit cost as the cost per base unit.
+func (uc *AdjustmentUseCase) Create(ctx context.Context, in CreateAdjustmentInput) (*AdjustmentDetails, error) {
+	reasonCode := strings.ToUpper(strings.TrimSpace(in.ReasonCode))
+	if !slices.Contains(uc.policy.ReasonCodes, reasonCode) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownReasonCode, in.ReasonCode)
+	}
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *AdjustmentDetails
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		item, err := uc.loadStockItem(ctx, loader, in.StockItemID)
+		if err != nil {
+			return err
+		}
+		product, err := loader.product(ctx, item.ProductID)
+		if err != nil {
+			return err
+		}
+		if product.Serialized {
+			return fmt.Errorf("product %s: %w", product.ID, ErrSerializedMovement)
+		}
+		quantity, err := uc.units.toBase(ctx, product, in.Quantity, in.Unit)
+		if err != nil {
+			return err
+		}
+		unitCost := in.UnitCost
+		if unitCost != nil && quantity != in.Quantity {
+			baseCost := *unitCost * float64(in.Quantity) / float64(quantity)
+			unitCost = &baseCost
+		}
+		adjustment, err := entity.NewAdjustment(uuid.NewString(), in.StockItemID, quantity, reasonCode,
+			in.Notes, unitCost, in.PerformedBy)
+		if err != nil {
+			return err
+		}
+		result = &AdjustmentDetails{Adjustment: adjustment}
+
+		if uc.policy.requiresApproval(adjustment) {
+			// Refuse adjustments that could not be applied even now
+			if item.QuantityOnHand+adjustment.Quantity < item.QuantityReserved {
+				return entity.ErrAdjustmentBelowReserved
+			}
+			if err := uc.adjustments.Create(ctx, adjustment); err != nil {
+				return fmt.Errorf("failed to create adjustment: %w", err)
+			}
+			return uc.publishApprovalRequested(ctx, adjustment, item, correlationID)
+		}
+
+		if err := uc.apply(ctx, loader, adjustment, item, in.PerformedBy, correlationID); err != nil {
+			return err
+		}
+		if err := uc.adjustments.Create(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to create adjustment: %w", err)
+		}
+		result.FilledBackorders, err = uc.fillBackorders(ctx, adjustment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Approve applies a pending adjustment on behalf of a reviewer other than its requester
+func (uc *AdjustmentUseCase) Approve(ctx context.Context, id string, in ReviewAdjustmentInput) (*AdjustmentDetails, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *AdjustmentDetails
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		adjustment, err := uc.adjustments.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get adjustment %s: %w", id, err)
+		}
+		if err := adjustment.Approve(in.ReviewedBy, in.Notes); err != nil {
+			return err
+		}
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		item, err := uc.loadStockItem(ctx, loader, adjustment.StockItemID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.apply(ctx, loader, adjustment, item, in.ReviewedBy, correlationID); err != nil {
+			return err
+		}
+		if err := uc.adjustments.Update(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to update adjustment: %w", err)
+		}
+		result = &AdjustmentDetails{Adjustment: adjustment}
+		result.FilledBackorders, err = uc.fillBackorders(ctx, adjustment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Reject declines a pending adjustment without touching stock
+func (uc *AdjustmentUseCase) Reject(ctx context.Context, id string, in ReviewAdjustmentInput) (*entity.Adjustment, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *entity.Adjustment
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		adjustment, err := uc.adjustments.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get adjustment %s: %w", id, err)
+		}
+		if err := adjustment.Reject(in.ReviewedBy, in.Notes); err != nil {
+			return err
+		}
+		if err := uc.adjustments.Update(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to update adjustment: %w", err)
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.AdjustmentRejectedEvent{
+			EventID:       meta.EventID,
+			CorrelationID: meta.CorrelationID,
+			Timestamp:     meta.Timestamp,
+			Version:       meta.Version,
+			AdjustmentID:  adjustment.ID,
+			StockItemID:   adjustment.StockItemID,
+			Quantity:      adjustment.Quantity,
+			ReasonCode:    adjustment.ReasonCode,
+			RequestedBy:   adjustment.RequestedBy,
+			ReviewedBy:    adjustment.ReviewedBy,
+			ReviewNotes:   adjustment.ReviewNotes,
+		}
+		if err := publishEvent(ctx, uc.publisher, AggregateTypeAdjustment, evt, meta); err != nil {
+			return err
+		}
+		result = adjustment
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetByID retrieves an adjustment by its ID
+func (uc *AdjustmentUseCase) GetByID(ctx context.Context, id string) (*entity.Adjustment, error) {
+	adjustment, err := uc.adjustments.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjustment %s: %w", id, err)
+	}
+	return adjustment, nil
+}
+
+// List retrieves adjustments matching the filter along with the total match count
+func (uc *AdjustmentUseCase) List(ctx context.Context, filter repository.AdjustmentFilter) ([]*entity.Adjustment, int, error) {
+	adjustments, total, err := uc.adjustments.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list adjustments: %w", err)
+	}
+	return adjustments, total, nil
+}
+
+// apply adjusts the stock item, records the ADJUSTMENT movement and marks the
+// adjustment applied
+func (uc *AdjustmentUseCase) apply(
+	ctx context.Context,
+	loader *referenceLoader,
+	adjustment *entity.Adjustment,
+	item *StockItemDetails,
+	performedBy, correlationID string,
+) error {
+	before := snapshotOf(item.StockItem)
+	if err := item.Adjust(adjustment.Quantity); err != nil {
+		return fmt.Errorf("product %s: %w", item.ProductID, err)
+	}
+	if err := uc.stockItems.UpdateWithLock(ctx, item.StockItem, item.Version); err != nil {
+		return fmt.Errorf("failed to update stock item: %w", err)
+	}
+
+	reason := adjustment.ReasonCode
+	if adjustment.Notes != "" {
+		reason += ": " + adjustment.Notes
+	}
+	movement, err := newMovement(item.StockItem, before, entity.MovementTypeAdjustment, adjustment.Quantity,
+		adjustment.ID, ReferenceTypeAdjustment, reason, performedBy)
+	if err != nil {
+		return err
+	}
+	if err := uc.movements.Create(ctx, movement); err != nil {
+		return fmt.Errorf("failed to record stock movement: %w", err)
+	}
+	if err := uc.lots.post(ctx, movement, nil); err != nil {
+		return fmt.Errorf("failed to post lot movements: %w", err)
+	}
+	if _, err := uc.bins.post(ctx, item.StockItem, movement, ""); err != nil {
+		return fmt.Errorf("failed to post bin movements: %w", err)
+	}
+	if err := publishMovementRecorded(ctx, uc.publisher, movement, item, correlationID); err != nil {
+		return err
+	}
+	if err := adjustment.MarkApplied(movement.ID); err != nil {
+		return err
+	}
+
+	product, err := loader.product(ctx, item.ProductID)
+	if err != nil {
+		return err
+	}
+	if err := publishLowStockAlert(ctx, uc.publisher, item, before, product.MinStock, correlationID); err != nil {
+		return err
+	}
+	return uc.kits.publishLowStockAlerts(ctx, loader, uc.publisher, item, before, correlationID)
+}
+
+// fillBackorders reserves found stock for open backorders of the adjusted stock item
+func (uc *AdjustmentUseCase) fillBackorders(ctx context.Context, adjustment *entity.Adjustment) ([]*entity.Backorder, error) {
+	if adjustment.Quantity < 0 {
+		return nil, nil
+	}
+	return uc.backorders.fill(ctx, adjustment.StockItemID)
+}
+
+func (uc *AdjustmentUseCase) publishApprovalRequested(ctx context.Context, adjustment *entity.Adjustment, item *StockItemDetails, correlationID string) error {
+	meta := newEventMetadata(correlationID)
+	evt := event.AdjustmentApprovalRequestedEvent{
+		EventID:       meta.EventID,
+		CorrelationID: meta.CorrelationID,
+		Timestamp:     meta.Timestamp,
+		Version:       meta.Version,
+		AdjustmentID:  adjustment.ID,
+		StockItemID:   item.ID,
+		ProductID:     item.ProductID,
+		SKU:           item.SKU,
+		WarehouseID:   item.WarehouseID,
+		Quantity:      adjustment.Quantity,
+		ReasonCode:    adjustment.ReasonCode,
+		RequestedBy:   adjustment.RequestedBy,
+	}
+	if value, known := adjustment.Value(); known {
+		evt.Value = &value
+	}
+	return publishEvent(ctx, uc.publisher, AggregateTypeAdjustment, evt, meta)
+}
+
+func (uc *AdjustmentUseCase) loadStockItem(ctx context.Context, loader *referenceLoader, id string) (*StockItemDetails, error) {
+	item, err := uc.stockItems.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock item %s: %w", id, err)
+	}
+	return loader.stockItemDetails(ctx, item)
+}
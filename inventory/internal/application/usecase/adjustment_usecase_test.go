@@ -0,0 +1,39 @@
+// file: internal/application/usecase/adjustment_usecase_test.go
+package usecase
+
+import (
+	"testing"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+func TestAdjustmentPolicyRequiresApproval(t *testing.T) {
+	cost := func(c float64) *float64 { return &c }
+	policy := AdjustmentPolicy{ApprovalQuantity: 50, ApprovalValue: 1000}
+
+	tests := []struct {
+		name     string
+		policy   AdjustmentPolicy
+		quantity int
+		unitCost *float64
+		want     bool
+	}{
+		{name: "within thresholds", policy: policy, quantity: -10, unitCost: cost(5), want: false},
+		{name: "over quantity", policy: policy, quantity: -51, unitCost: cost(1), want: true},
+		{name: "over value", policy: policy, quantity: 20, unitCost: cost(60), want: true},
+		{name: "unknown value", policy: policy, quantity: -1, want: true},
+		{name: "unknown value without value threshold", policy: AdjustmentPolicy{ApprovalQuantity: 50}, quantity: -1, want: false},
+		{name: "no thresholds", policy: AdjustmentPolicy{}, quantity: 1000, unitCost: cost(1000), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adjustment, err := entity.NewAdjustment("adj-1", "item-1", tt.quantity, "DAMAGE", "", tt.unitCost, "user-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.policy.requiresApproval(adjustment); got != tt.want {
+				t.Fatalf("requiresApproval: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
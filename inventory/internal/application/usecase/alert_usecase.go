@@ -0,0 +1,88 @@
+// file: internal/application/usecase/alert_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// LowStockAlertDetails is a stock item at or below its reorder point, or the stock of a
+// kit in a warehouse at or below the kit's minimum stock
+type LowStockAlertDetails struct {
+	*StockItemDetails
+	Kit      bool // Stock the kit's components make up; there is no stock item and the reorder point is the kit's minimum stock
+	Severity event.LowStockSeverity
+}
+
+// AlertUseCase reports stock levels that need attention
+type AlertUseCase struct {
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	kits       repository.KitRepository
+}
+
+// NewAlertUseCase creates a new AlertUseCase
+func NewAlertUseCase(
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	kits repository.KitRepository,
+) *AlertUseCase {
+	return &AlertUseCase{
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		kits:       kits,
+	}
+}
+
+// GetLowStockAlerts lists every stock item currently at or below its reorder point,
+// followed by the kits at or below their minimum stock in a warehouse
+func (uc *AlertUseCase) GetLowStockAlerts(ctx context.Context) ([]*LowStockAlertDetails, error) {
+	items, err := uc.stockItems.GetLowStockItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get low stock items: %w", err)
+	}
+
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	alerts := make([]*LowStockAlertDetails, 0, len(items))
+	for _, item := range items {
+		details, err := loader.stockItemDetails(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, &LowStockAlertDetails{
+			StockItemDetails: details,
+			Severity:         lowStockSeverity(item),
+		})
+	}
+
+	kits, err := uc.kits.GetLowStock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get low stock kits: %w", err)
+	}
+	for _, kit := range kits {
+		item := &entity.StockItem{
+			ProductID:        kit.KitID,
+			WarehouseID:      kit.WarehouseID,
+			QuantityOnHand:   kit.QuantityOnHand,
+			QuantityReserved: kit.QuantityOnHand - kit.Available,
+			ReorderPoint:     kit.MinStock,
+		}
+		details, err := loader.stockItemDetails(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, &LowStockAlertDetails{
+			StockItemDetails: details,
+			Kit:              true,
+			Severity:         lowStockSeverity(item),
+		})
+	}
+	return alerts, nil
+}
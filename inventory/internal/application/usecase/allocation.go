@@ -0,0 +1,477 @@
+// file: internal/application/usecase/allocation.go
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// Built-in allocation strategy names
+const (
+	AllocationPreferredFirst = "preferred-first"
+	AllocationPriority       = "priority"
+	AllocationFewestSplits   = "fewest-splits"
+	AllocationNearest        = "nearest"
+)
+
+// DefaultAllocationStrategy is used when a request does not name a strategy
+const DefaultAllocationStrategy = AllocationPreferredFirst
+
+// AllocationRequest asks for a quantity of one product
+type AllocationRequest struct {
+	ProductID            string
+	Quantity             int
+	PreferredWarehouseID string
+	Destination          *entity.WarehouseAddress // Ship-to address, used by the nearest strategy
+	Strategy             string                   // Empty selects DefaultAllocationStrategy
+	NoSplit              bool                     // Serve the quantity from a single stock item
+}
+
+// AllocationCandidate is a stock item of the requested product in an active warehouse.
+// For a kit, Item stands for the kits the components stocked in the warehouse make up.
+type AllocationCandidate struct {
+	Item      *entity.StockItem
+	Warehouse *entity.Warehouse
+
+	kit []kitPart // Stock items of a kit's components; nil for other products
+}
+
+// kitPart is the stock item a component of a kit is reserved from, and the quantity of
+// the component one kit takes
+type kitPart struct {
+	item     *entity.StockItem
+	quantity int
+}
+
+// RankedCandidate is a candidate together with why the strategy put it where it is
+type RankedCandidate struct {
+	AllocationCandidate
+	Reason string
+}
+
+// AllocationStrategy decides the order in which candidates are drawn from.
+// Implementations must return every candidate exactly once.
+type AllocationStrategy interface {
+	Name() string
+	Rank(req AllocationRequest, candidates []AllocationCandidate) []RankedCandidate
+}
+
+// AllocationLine is the part of a request served by one stock item, or for a kit by the
+// stock items of its components in one warehouse, in which case StockItemID is empty
+type AllocationLine struct {
+	StockItemID string
+	WarehouseID string
+	Quantity    int
+	Reason      string
+
+	item *entity.StockItem // As read while allocating
+	kit  []kitPart
+}
+
+// parts returns the stock items the line is reserved from, with the quantity each takes
+// per unit of the line
+func (l AllocationLine) parts() []kitPart {
+	if l.kit != nil {
+		return l.kit
+	}
+	return []kitPart{{item: l.item, quantity: 1}}
+}
+
+// AllocationPlan is the outcome of allocating a request. Lines may cover less than
+// the requested quantity; the caller decides whether a shortfall is acceptable.
+type AllocationPlan struct {
+	ProductID   string
+	Requested   int
+	Available   int // What the plan could draw on: the total available, or the most of any one candidate with NoSplit
+	Strategy    string
+	Lines       []AllocationLine
+	Explanation string
+}
+
+// Allocated returns the quantity covered by the plan's lines
+func (p *AllocationPlan) Allocated() int {
+	total := 0
+	for _, line := range p.Lines {
+		total += line.Quantity
+	}
+	return total
+}
+
+// Shortfall returns the requested quantity the plan could not cover
+func (p *AllocationPlan) Shortfall() int {
+	return p.Requested - p.Allocated()
+}
+
+// Allocator picks the stock items a product quantity is reserved from, using one of
+// a set of named strategies, and splits the quantity across stock items when no single
+// one can cover it. A kit is allocated by warehouse, each warehouse offering the kits
+// its component stock makes up, so all components of a kit come from one warehouse.
+type Allocator struct {
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	kits       repository.KitRepository
+	strategies map[string]AllocationStrategy
+}
+
+// NewAllocator creates an Allocator with the built-in strategies. Additional strategies
+// are registered under their names, replacing a built-in one of the same name.
+func NewAllocator(
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	kits repository.KitRepository,
+	strategies ...AllocationStrategy,
+) *Allocator {
+	a := &Allocator{
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		kits:       kits,
+		strategies: make(map[string]AllocationStrategy),
+	}
+	builtin := []AllocationStrategy{PreferredFirstStrategy{}, PriorityStrategy{}, FewestSplitsStrategy{}, NearestStrategy{}}
+	for _, s := range append(builtin, strategies...) {
+		a.strategies[s.Name()] = s
+	}
+	return a
+}
+
+// Allocate plans how a request would be served from current stock without reserving anything
+func (a *Allocator) Allocate(ctx context.Context, req AllocationRequest) (*AllocationPlan, error) {
+	return a.allocate(ctx, newReferenceLoader(a.products, a.warehouses), req)
+}
+
+func (a *Allocator) allocate(ctx context.Context, loader *referenceLoader, req AllocationRequest) (*AllocationPlan, error) {
+	if req.Quantity <= 0 {
+		return nil, entity.ErrReservationItemQuantity
+	}
+	name := req.Strategy
+	if name == "" {
+		name = DefaultAllocationStrategy
+	}
+	strategy, ok := a.strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownAllocationStrategy, name)
+	}
+
+	candidates, err := a.candidates(ctx, loader, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("product %s: %w", req.ProductID, ErrProductNotStocked)
+	}
+
+	plan := &AllocationPlan{ProductID: req.ProductID, Requested: req.Quantity, Strategy: name}
+	for _, c := range candidates {
+		if req.NoSplit {
+			plan.Available = max(plan.Available, c.Item.ReservableQuantity())
+		} else {
+			plan.Available += c.Item.ReservableQuantity()
+		}
+	}
+
+	ranked := strategy.Rank(req, candidates)
+	if req.NoSplit {
+		plan.Lines = drawSingle(ranked, req.Quantity)
+	} else {
+		plan.Lines = drawInOrder(ranked, req.Quantity)
+	}
+	plan.Explanation = explain(plan, len(candidates), req.NoSplit)
+	return plan, nil
+}
+
+// candidates returns the stock items of a product held by active warehouses
+func (a *Allocator) candidates(ctx context.Context, loader *referenceLoader, productID string) ([]AllocationCandidate, error) {
+	product, err := loader.product(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsKit {
+		return a.kitCandidates(ctx, loader, product)
+	}
+
+	items, err := a.activeStockItems(ctx, loader, productID)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]AllocationCandidate, 0, len(items))
+	for _, item := range items {
+		warehouse, err := loader.warehouse(ctx, item.WarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, AllocationCandidate{Item: item, Warehouse: warehouse})
+	}
+	return candidates, nil
+}
+
+// kitCandidates returns the kits of the active warehouses stocking every component of a kit
+func (a *Allocator) kitCandidates(ctx context.Context, loader *referenceLoader, product *entity.Product) ([]AllocationCandidate, error) {
+	kit, err := a.kits.GetByProductID(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kit %s: %w", product.ID, err)
+	}
+
+	byWarehouse := make(map[string]map[string]*entity.StockItem)
+	var warehouseIDs []string
+	for _, c := range kit.Components {
+		items, err := a.activeStockItems(ctx, loader, c.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if byWarehouse[item.WarehouseID] == nil {
+				byWarehouse[item.WarehouseID] = make(map[string]*entity.StockItem, len(kit.Components))
+				warehouseIDs = append(warehouseIDs, item.WarehouseID)
+			}
+			byWarehouse[item.WarehouseID][c.ProductID] = item
+		}
+	}
+
+	var candidates []AllocationCandidate
+	for _, warehouseID := range warehouseIDs {
+		items := byWarehouse[warehouseID]
+		stock, ok := kit.Stock(items)
+		if !ok {
+			continue
+		}
+		warehouse, err := loader.warehouse(ctx, warehouseID)
+		if err != nil {
+			return nil, err
+		}
+		parts := make([]kitPart, 0, len(kit.Components))
+		for _, c := range kit.Components {
+			parts = append(parts, kitPart{item: items[c.ProductID], quantity: c.Quantity})
+		}
+		candidates = append(candidates, AllocationCandidate{Item: stock, Warehouse: warehouse, kit: parts})
+	}
+	return candidates, nil
+}
+
+// activeStockItems returns the stock items of a product held by active warehouses
+func (a *Allocator) activeStockItems(ctx context.Context, loader *referenceLoader, productID string) ([]*entity.StockItem, error) {
+	items, _, err := a.stockItems.List(ctx, repository.StockItemFilter{ProductID: &productID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stock items for product %s: %w", productID, err)
+	}
+	active := make([]*entity.StockItem, 0, len(items))
+	for _, item := range items {
+		warehouse, err := loader.warehouse(ctx, item.WarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		if warehouse.IsDeleted() || !warehouse.IsActive {
+			continue
+		}
+		active = append(active, item)
+	}
+	return active, nil
+}
+
+// drawInOrder takes as much as each candidate has available, in ranked order, until
+// the quantity is covered
+func drawInOrder(ranked []RankedCandidate, quantity int) []AllocationLine {
+	var lines []AllocationLine
+	remaining := quantity
+	for _, c := range ranked {
+		if remaining == 0 {
+			break
+		}
+		take := min(c.Item.ReservableQuantity(), remaining)
+		if take <= 0 {
+			continue
+		}
+		lines = append(lines, allocationLine(c, take))
+		remaining -= take
+	}
+	return lines
+}
+
+// drawSingle takes the whole quantity from the first candidate that can cover it, or
+// else as much as possible from the candidate with the most available
+func drawSingle(ranked []RankedCandidate, quantity int) []AllocationLine {
+	var best *RankedCandidate
+	for i, c := range ranked {
+		available := c.Item.ReservableQuantity()
+		if available >= quantity {
+			return []AllocationLine{allocationLine(c, quantity)}
+		}
+		if available > 0 && (best == nil || available > best.Item.ReservableQuantity()) {
+			best = &ranked[i]
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return []AllocationLine{allocationLine(*best, best.Item.ReservableQuantity())}
+}
+
+func allocationLine(c RankedCandidate, quantity int) AllocationLine {
+	return AllocationLine{
+		StockItemID: c.Item.ID,
+		WarehouseID: c.Warehouse.ID,
+		Quantity:    quantity,
+		Reason: fmt.Sprintf("%s: %s, %d of %d available",
+			c.Warehouse.Code, c.Reason, quantity, c.Item.ReservableQuantity()),
+		item: c.Item,
+		kit:  c.kit,
+	}
+}
+
+func explain(plan *AllocationPlan, candidates int, noSplit bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s strategy over %d active warehouse(s)", plan.Strategy, candidates)
+	if noSplit {
+		b.WriteString(", splitting not allowed")
+	}
+	fmt.Fprintf(&b, ": allocated %d of %d", plan.Allocated(), plan.Requested)
+	if len(plan.Lines) > 1 {
+		fmt.Fprintf(&b, " split across %d warehouses", len(plan.Lines))
+	}
+	if shortfall := plan.Shortfall(); shortfall > 0 {
+		fmt.Fprintf(&b, ", short by %d (%d available)", shortfall, plan.Available)
+	}
+	return b.String()
+}
+
+// PriorityStrategy draws from warehouses in ascending priority, then by code
+type PriorityStrategy struct{}
+
+// Name returns the strategy name
+func (PriorityStrategy) Name() string { return AllocationPriority }
+
+// Rank orders candidates by warehouse priority
+func (PriorityStrategy) Rank(_ AllocationRequest, candidates []AllocationCandidate) []RankedCandidate {
+	return rankBy(candidates, byPriority, func(c AllocationCandidate) string {
+		return fmt.Sprintf("priority %d", c.Warehouse.Priority)
+	})
+}
+
+// PreferredFirstStrategy draws from the requested warehouse first and from the others
+// in priority order
+type PreferredFirstStrategy struct{}
+
+// Name returns the strategy name
+func (PreferredFirstStrategy) Name() string { return AllocationPreferredFirst }
+
+// Rank puts the preferred warehouse first and orders the rest by priority
+func (PreferredFirstStrategy) Rank(req AllocationRequest, candidates []AllocationCandidate) []RankedCandidate {
+	preferred := func(c AllocationCandidate) int {
+		if c.Warehouse.ID == req.PreferredWarehouseID {
+			return 0
+		}
+		return 1
+	}
+	return rankBy(candidates,
+		func(a, b AllocationCandidate) int {
+			return cmp.Or(cmp.Compare(preferred(a), preferred(b)), byPriority(a, b))
+		},
+		func(c AllocationCandidate) string {
+			if preferred(c) == 0 {
+				return "preferred warehouse"
+			}
+			return fmt.Sprintf("priority %d", c.Warehouse.Priority)
+		})
+}
+
+// FewestSplitsStrategy minimises the number of stock items a quantity is split across:
+// a warehouse that can cover the whole quantity is used when there is one, otherwise
+// warehouses are drawn from largest available stock first
+type FewestSplitsStrategy struct{}
+
+// Name returns the strategy name
+func (FewestSplitsStrategy) Name() string { return AllocationFewestSplits }
+
+// Rank orders candidates covering the full quantity first, then by available stock
+func (FewestSplitsStrategy) Rank(req AllocationRequest, candidates []AllocationCandidate) []RankedCandidate {
+	covers := func(c AllocationCandidate) bool { return c.Item.ReservableQuantity() >= req.Quantity }
+	return rankBy(candidates,
+		func(a, b AllocationCandidate) int {
+			if covers(a) != covers(b) {
+				if covers(a) {
+					return -1
+				}
+				return 1
+			}
+			if covers(a) {
+				return byPriority(a, b)
+			}
+			return cmp.Or(cmp.Compare(b.Item.ReservableQuantity(), a.Item.ReservableQuantity()), byPriority(a, b))
+		},
+		func(c AllocationCandidate) string {
+			if covers(c) {
+				return "covers the full quantity"
+			}
+			return "largest available stock"
+		})
+}
+
+// NearestStrategy draws from the warehouses closest to the destination address first.
+// Closeness is judged from the address alone: same postal code, then same city, same
+// state and same country; ties are broken by priority. Without a destination it
+// behaves like PriorityStrategy.
+type NearestStrategy struct{}
+
+// Name returns the strategy name
+func (NearestStrategy) Name() string { return AllocationNearest }
+
+// Rank orders candidates by how much of their address matches the destination
+func (NearestStrategy) Rank(req AllocationRequest, candidates []AllocationCandidate) []RankedCandidate {
+	if req.Destination == nil {
+		return rankBy(candidates, byPriority, func(c AllocationCandidate) string {
+			return fmt.Sprintf("no destination given, priority %d", c.Warehouse.Priority)
+		})
+	}
+	dest := *req.Destination
+	return rankBy(candidates,
+		func(a, b AllocationCandidate) int {
+			return cmp.Or(cmp.Compare(proximity(dest, a.Warehouse.Address), proximity(dest, b.Warehouse.Address)), byPriority(a, b))
+		},
+		func(c AllocationCandidate) string {
+			return proximityReasons[proximity(dest, c.Warehouse.Address)]
+		})
+}
+
+var proximityReasons = []string{"same postal code", "same city", "same state", "same country", "different country"}
+
+// proximity grades how close an address is to the destination, 0 being closest
+func proximity(dest, addr entity.WarehouseAddress) int {
+	same := func(a, b string) bool {
+		a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+		return a != "" && strings.EqualFold(a, b)
+	}
+	switch {
+	case !same(dest.Country, addr.Country):
+		return 4
+	case same(dest.PostalCode, addr.PostalCode):
+		return 0
+	case same(dest.City, addr.City) && (dest.State == "" || same(dest.State, addr.State)):
+		return 1
+	case same(dest.State, addr.State):
+		return 2
+	default:
+		return 3
+	}
+}
+
+func byPriority(a, b AllocationCandidate) int {
+	return cmp.Or(cmp.Compare(a.Warehouse.Priority, b.Warehouse.Priority), strings.Compare(a.Warehouse.Code, b.Warehouse.Code))
+}
+
+func rankBy(candidates []AllocationCandidate, compare func(a, b AllocationCandidate) int, reason func(AllocationCandidate) string) []RankedCandidate {
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, compare)
+	ranked := make([]RankedCandidate, 0, len(sorted))
+	for _, c := range sorted {
+		ranked = append(ranked, RankedCandidate{AllocationCandidate: c, Reason: reason(c)})
+	}
+	return ranked
+}
@@ -0,0 +1,188 @@
+// file: internal/application/usecase/allocation_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// newTestAllocator stocks product-1 in three active warehouses and one inactive one:
+//
+//	A  priority 1  Berlin 10115  4 reservable (6 on hand, 1 reserved, 1 expired)
+//	B  priority 2  Munich 80331  10 reservable
+//	C  priority 3  Berlin 10117  6 reservable
+//	D  priority 0  inactive      100 reservable
+func newTestAllocator() *Allocator {
+	products := &fakeProducts{products: map[string]*entity.Product{"product-1": {ID: "product-1"}}}
+	berlin := func(postalCode string) entity.WarehouseAddress {
+		return entity.WarehouseAddress{City: "Berlin", State: "BE", Country: "DE", PostalCode: postalCode}
+	}
+	warehouses := &fakeWarehouses{warehouses: map[string]*entity.Warehouse{
+		"wh-a": {ID: "wh-a", Code: "A", Priority: 1, IsActive: true, Address: berlin("10115")},
+		"wh-b": {ID: "wh-b", Code: "B", Priority: 2, IsActive: true,
+			Address: entity.WarehouseAddress{City: "Munich", State: "BY", Country: "DE", PostalCode: "80331"}},
+		"wh-c": {ID: "wh-c", Code: "C", Priority: 3, IsActive: true, Address: berlin("10117")},
+		"wh-d": {ID: "wh-d", Code: "D", Priority: 0, Address: berlin("10115")},
+	}}
+	stockItems := &casStockItems{items: map[string]*entity.StockItem{
+		"item-a": {ID: "item-a", ProductID: "product-1", WarehouseID: "wh-a", QuantityOnHand: 6, QuantityReserved: 1, QuantityExpired: 1},
+		"item-b": {ID: "item-b", ProductID: "product-1", WarehouseID: "wh-b", QuantityOnHand: 10},
+		"item-c": {ID: "item-c", ProductID: "product-1", WarehouseID: "wh-c", QuantityOnHand: 6},
+		"item-d": {ID: "item-d", ProductID: "product-1", WarehouseID: "wh-d", QuantityOnHand: 100},
+	}}
+	return NewAllocator(products, warehouses, stockItems, fakeKits{})
+}
+
+func TestAllocatorStrategies(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       AllocationRequest
+		wantLines []string // Warehouse ID and quantity of each line, in order
+	}{
+		{
+			name:      "priority",
+			req:       AllocationRequest{Strategy: AllocationPriority, Quantity: 12},
+			wantLines: []string{"wh-a:4", "wh-b:8"},
+		},
+		{
+			name:      "preferred warehouse first",
+			req:       AllocationRequest{Strategy: AllocationPreferredFirst, Quantity: 8, PreferredWarehouseID: "wh-c"},
+			wantLines: []string{"wh-c:6", "wh-a:2"},
+		},
+		{
+			name:      "default strategy without a preferred warehouse",
+			req:       AllocationRequest{Quantity: 12},
+			wantLines: []string{"wh-a:4", "wh-b:8"},
+		},
+		{
+			name:      "fewest splits with warehouses covering the quantity",
+			req:       AllocationRequest{Strategy: AllocationFewestSplits, Quantity: 5},
+			wantLines: []string{"wh-b:5"},
+		},
+		{
+			name:      "fewest splits without a warehouse covering the quantity",
+			req:       AllocationRequest{Strategy: AllocationFewestSplits, Quantity: 15},
+			wantLines: []string{"wh-b:10", "wh-c:5"},
+		},
+		{
+			name: "nearest by postal code, then city",
+			req: AllocationRequest{Strategy: AllocationNearest, Quantity: 8,
+				Destination: &entity.WarehouseAddress{City: "Berlin", Country: "DE", PostalCode: "10117"}},
+			wantLines: []string{"wh-c:6", "wh-a:2"},
+		},
+		{
+			name: "nearest by state, then priority",
+			req: AllocationRequest{Strategy: AllocationNearest, Quantity: 12,
+				Destination: &entity.WarehouseAddress{City: "Augsburg", State: "BY", Country: "DE"}},
+			wantLines: []string{"wh-b:10", "wh-a:2"},
+		},
+		{
+			name: "nearest in another country",
+			req: AllocationRequest{Strategy: AllocationNearest, Quantity: 6,
+				Destination: &entity.WarehouseAddress{City: "Berlin", Country: "US", PostalCode: "10117"}},
+			wantLines: []string{"wh-a:4", "wh-b:2"},
+		},
+		{
+			name:      "nearest without a destination",
+			req:       AllocationRequest{Strategy: AllocationNearest, Quantity: 6},
+			wantLines: []string{"wh-a:4", "wh-b:2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.ProductID = "product-1"
+			plan, err := newTestAllocator().Allocate(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := planLines(plan); !slices.Equal(got, tt.wantLines) {
+				t.Errorf("lines: got %v, want %v", got, tt.wantLines)
+			}
+			if plan.Shortfall() != 0 {
+				t.Errorf("shortfall: got %d, want none", plan.Shortfall())
+			}
+		})
+	}
+}
+
+func TestAllocatorSplits(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           AllocationRequest
+		wantLines     []string
+		wantAvailable int
+		wantShortfall int
+		wantErr       error
+	}{
+		{
+			name:          "split across every warehouse",
+			req:           AllocationRequest{Strategy: AllocationPriority, Quantity: 20},
+			wantLines:     []string{"wh-a:4", "wh-b:10", "wh-c:6"},
+			wantAvailable: 20,
+		},
+		{
+			name:          "more than the active warehouses hold",
+			req:           AllocationRequest{Strategy: AllocationPriority, Quantity: 25},
+			wantLines:     []string{"wh-a:4", "wh-b:10", "wh-c:6"},
+			wantAvailable: 20,
+			wantShortfall: 5,
+		},
+		{
+			name:          "no split, first warehouse in order covering the quantity",
+			req:           AllocationRequest{Strategy: AllocationPriority, Quantity: 5, NoSplit: true},
+			wantLines:     []string{"wh-b:5"},
+			wantAvailable: 10,
+		},
+		{
+			name:          "no split, no warehouse covering the quantity",
+			req:           AllocationRequest{Strategy: AllocationPriority, Quantity: 12, NoSplit: true},
+			wantLines:     []string{"wh-b:10"},
+			wantAvailable: 10,
+			wantShortfall: 2,
+		},
+		{
+			name:    "unknown strategy",
+			req:     AllocationRequest{Strategy: "cheapest", Quantity: 1},
+			wantErr: ErrUnknownAllocationStrategy,
+		},
+		{
+			name:    "zero quantity",
+			req:     AllocationRequest{Quantity: 0},
+			wantErr: entity.ErrReservationItemQuantity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.ProductID = "product-1"
+			plan, err := newTestAllocator().Allocate(context.Background(), tt.req)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := planLines(plan); !slices.Equal(got, tt.wantLines) {
+				t.Errorf("lines: got %v, want %v", got, tt.wantLines)
+			}
+			if plan.Available != tt.wantAvailable {
+				t.Errorf("available: got %d, want %d", plan.Available, tt.wantAvailable)
+			}
+			if plan.Shortfall() != tt.wantShortfall {
+				t.Errorf("shortfall: got %d, want %d", plan.Shortfall(), tt.wantShortfall)
+			}
+		})
+	}
+}
+
+func planLines(plan *AllocationPlan) []string {
+	var lines []string
+	for _, line := range plan.Lines {
+		lines = append(lines, fmt.Sprintf("%s:%d", line.WarehouseID, line.Quantity))
+	}
+	return lines
+}
@@ -0,0 +1,189 @@
+// file: internal/application/usecase/backorder_usecase.go
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// BackorderOrdering decides which open backorders arriving stock is reserved for first
+type BackorderOrdering string
+
+const (
+	// BackorderOrderingFIFO serves backorders oldest first
+	BackorderOrderingFIFO BackorderOrdering = "FIFO"
+	// BackorderOrderingPriority serves backorders by descending priority, oldest first
+	// within a priority
+	BackorderOrderingPriority BackorderOrdering = "PRIORITY"
+)
+
+// BackorderUseCase manages the backorder queue and converts backorders into
+// reservations as stock arrives
+type BackorderUseCase struct {
+	tx           port.TransactionManager
+	backorders   repository.BackorderRepository
+	reservations *ReservationUseCase
+	ordering     BackorderOrdering
+}
+
+// NewBackorderUseCase creates a new BackorderUseCase. An unknown ordering falls back to
+// BackorderOrderingFIFO.
+func NewBackorderUseCase(
+	tx port.TransactionManager,
+	backorders repository.BackorderRepository,
+	reservations *ReservationUseCase,
+	ordering BackorderOrdering,
+) *BackorderUseCase {
+	if ordering != BackorderOrderingPriority {
+		ordering = BackorderOrderingFIFO
+	}
+	return &BackorderUseCase{
+		tx:           tx,
+		backorders:   backorders,
+		reservations: reservations,
+		ordering:     ordering,
+	}
+}
+
+// GetByID retrieves a backorder by its ID
+func (uc *BackorderUseCase) GetByID(ctx context.Context, id string) (*entity.Backorder, error) {
+	backorder, err := uc.backorders.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backorder %s: %w", id, err)
+	}
+	return backorder, nil
+}
+
+// ListByOrder retrieves all backorders made for an order
+func (uc *BackorderUseCase) ListByOrder(ctx context.Context, orderID string) ([]*entity.Backorder, error) {
+	backorders, err := uc.backorders.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backorders for order %s: %w", orderID, err)
+	}
+	return backorders, nil
+}
+
+// Reprioritize changes the queue priority of an open backorder
+func (uc *BackorderUseCase) Reprioritize(ctx context.Context, id string, priority int) (*entity.Backorder, error) {
+	var result *entity.Backorder
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		backorder, err := uc.backorders.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get backorder %s: %w", id, err)
+		}
+		if err := backorder.Reprioritize(priority); err != nil {
+			return err
+		}
+		if err := uc.backorders.Update(ctx, backorder); err != nil {
+			return fmt.Errorf("failed to update backorder: %w", err)
+		}
+		result = backorder
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// fill reserves the available stock of a stock item for the open backorders it may
+// serve, in queue order, and returns the backorders it reserved stock for. Each fill
+// becomes a reservation of its own, published with a StockReservedEvent and a
+// BackorderFilledEvent. It must run inside the transaction that raised availability.
+func (uc *BackorderUseCase) fill(ctx context.Context, stockItemID string) ([]*entity.Backorder, error) {
+	r := uc.reservations
+	loader := newReferenceLoader(r.products, r.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+
+	item, err := r.loadStockItem(ctx, loader, stockItemID)
+	if err != nil {
+		return nil, err
+	}
+	warehouse, err := loader.warehouse(ctx, item.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if warehouse.IsDeleted() || !warehouse.IsActive || item.ReservableQuantity() <= 0 {
+		return nil, nil
+	}
+
+	queue, err := uc.backorders.GetOpen(ctx, item.ProductID, item.WarehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open backorders: %w", err)
+	}
+	if uc.ordering == BackorderOrderingPriority {
+		slices.SortStableFunc(queue, func(a, b *entity.Backorder) int {
+			return cmp.Compare(b.Priority, a.Priority)
+		})
+	}
+
+	var filled []*entity.Backorder
+	for _, backorder := range queue {
+		quantity := min(item.ReservableQuantity(), backorder.RemainingQuantity())
+		if quantity <= 0 {
+			break
+		}
+
+		reservationID := uuid.NewString()
+		if err := r.applyMovement(ctx, loader, item, entity.MovementTypeReservation, quantity,
+			reservationID, "backorder "+backorder.ID, SystemActor, correlationID, nil); err != nil {
+			return nil, err
+		}
+		line := entity.ReservationItem{
+			StockItemID: item.ID,
+			ProductID:   item.ProductID,
+			WarehouseID: item.WarehouseID,
+			Quantity:    quantity,
+		}
+		reservation, err := entity.NewReservation(reservationID, backorder.OrderID,
+			[]entity.ReservationItem{line}, time.Now().UTC().Add(r.ttl.DefaultTTL))
+		if err != nil {
+			return nil, err
+		}
+		if err := r.reservations.Create(ctx, reservation); err != nil {
+			return nil, fmt.Errorf("failed to create reservation: %w", err)
+		}
+		if _, err := r.publishReserved(ctx, reservation,
+			[]ReservationItemDetails{itemDetailsFor(line, item)}, correlationID); err != nil {
+			return nil, err
+		}
+
+		if err := backorder.Fill(quantity); err != nil {
+			return nil, err
+		}
+		if err := uc.backorders.Update(ctx, backorder); err != nil {
+			return nil, fmt.Errorf("failed to update backorder: %w", err)
+		}
+		meta := newEventMetadata(correlationID)
+		evt := event.BackorderFilledEvent{
+			EventID:           meta.EventID,
+			CorrelationID:     meta.CorrelationID,
+			Timestamp:         meta.Timestamp,
+			Version:           meta.Version,
+			BackorderID:       backorder.ID,
+			OrderID:           backorder.OrderID,
+			ReservationID:     reservation.ID,
+			ProductID:         backorder.ProductID,
+			SKU:               item.SKU,
+			WarehouseID:       item.WarehouseID,
+			QuantityReserved:  quantity,
+			QuantityRemaining: backorder.RemainingQuantity(),
+			Status:            string(backorder.Status),
+		}
+		if err := publishEvent(ctx, r.publisher, AggregateTypeBackorder, evt, meta); err != nil {
+			return nil, err
+		}
+		filled = append(filled, backorder)
+	}
+	return filled, nil
+}
@@ -0,0 +1,279 @@
+// file: internal/application/usecase/bin_ledger.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// BinPick is a quantity of a stock item taken from, or put into, one bin. An empty
+// LocationID stands for stock that is in no bin.
+type BinPick struct {
+	StockItemID string
+	LocationID  string
+	Path        string
+	Quantity    int
+}
+
+// binLedger keeps the bin balances of a stock item in step with its stock movements.
+// Stock in no bin has not been put away. Replenishments are put away to the bin given,
+// or else to the item's home bin and the bins already holding it as far as their
+// capacity goes; stock leaving the item is picked from its bins in path order and then
+// from stock not put away. Stock credited by anything but a replenishment is not put
+// away. Bin balances of one item change only along with a versioned update of the item,
+// and bins are locked while stock is put into them, so capacities hold.
+type binLedger struct {
+	locations repository.LocationRepository
+}
+
+func newBinLedger(locations repository.LocationRepository) *binLedger {
+	return &binLedger{locations: locations}
+}
+
+// bin returns a bin of the warehouse, locked for stock to be put into it
+func (l *binLedger) bin(ctx context.Context, warehouseID, locationID string) (*entity.Location, error) {
+	bin, err := l.locations.GetForUpdate(ctx, locationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location %s: %w", locationID, err)
+	}
+	if !bin.IsBin() {
+		return nil, fmt.Errorf("location %s: %w", bin.Path, entity.ErrLocationNotBin)
+	}
+	if bin.WarehouseID != warehouseID {
+		return nil, fmt.Errorf("location %s: %w", bin.Path, entity.ErrLocationWarehouseMismatch)
+	}
+	return bin, nil
+}
+
+// post splits a recorded stock movement of item across its bins and returns the bins
+// that took part. A replenishment goes to binID when it is set.
+func (l *binLedger) post(ctx context.Context, item *entity.StockItem, movement *entity.StockMovement, binID string) ([]BinPick, error) {
+	delta := movement.NewOnHand - movement.PreviousOnHand
+	switch {
+	case delta > 0 && movement.MovementType == entity.MovementTypeReplenishment:
+		return l.putaway(ctx, item, movement, binID, delta)
+	case delta < 0:
+		stocks, err := l.locations.GetBinStock(ctx, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bin stock: %w", err)
+		}
+		picks, err := planPicks(item.ID, stocks, movement.PreviousOnHand, -delta)
+		if err != nil {
+			return nil, err
+		}
+		// Picks from bins come first and follow the order of stocks
+		for i, pick := range picks {
+			if pick.LocationID == "" {
+				break
+			}
+			if err := l.record(ctx, movement, stocks[i], -pick.Quantity, stocks[i].Remove); err != nil {
+				return nil, err
+			}
+		}
+		return picks, nil
+	}
+	return nil, nil
+}
+
+// putaway puts received stock into binID, or else into the item's home bin and the bins
+// already holding it as far as their free capacity goes; the rest is not put away
+func (l *binLedger) putaway(ctx context.Context, item *entity.StockItem, movement *entity.StockMovement, binID string, quantity int) ([]BinPick, error) {
+	stocks, err := l.locations.GetBinStock(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bin stock: %w", err)
+	}
+
+	var candidates []string
+	if binID != "" {
+		candidates = []string{binID}
+	} else {
+		if item.BinLocationID != "" {
+			candidates = append(candidates, item.BinLocationID)
+		}
+		for _, stock := range stocks {
+			if stock.LocationID != item.BinLocationID {
+				candidates = append(candidates, stock.LocationID)
+			}
+		}
+	}
+
+	var picks []BinPick
+	remaining := quantity
+	for _, locationID := range candidates {
+		if remaining == 0 {
+			break
+		}
+		bin, err := l.bin(ctx, item.WarehouseID, locationID)
+		if err != nil {
+			return nil, err
+		}
+		take := remaining
+		if free := bin.FreeCapacity(); binID == "" && free >= 0 {
+			take = min(free, remaining)
+		}
+		if take == 0 {
+			continue
+		}
+		if err := l.put(ctx, movement, stocks, bin, take); err != nil {
+			return nil, err
+		}
+		picks = append(picks, BinPick{StockItemID: item.ID, LocationID: bin.ID, Path: bin.Path, Quantity: take})
+		remaining -= take
+	}
+	if remaining > 0 {
+		picks = append(picks, BinPick{StockItemID: item.ID, Quantity: remaining})
+	}
+	return picks, nil
+}
+
+// move records stock of item moved from one bin to another by a BIN_MOVE movement. An
+// empty fromID moves stock that has not been put away yet.
+func (l *binLedger) move(ctx context.Context, item *entity.StockItem, movement *entity.StockMovement, fromID, toID string, quantity int) error {
+	if fromID == toID {
+		return ErrBinMoveSameLocation
+	}
+	stocks, err := l.locations.GetBinStock(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get bin stock: %w", err)
+	}
+
+	if fromID == "" {
+		if unbinned(item.QuantityOnHand, stocks) < quantity {
+			return fmt.Errorf("stock of item %s not put away: %w", item.ID, entity.ErrInsufficientStock)
+		}
+	} else {
+		from := findBinStock(stocks, fromID)
+		if from == nil {
+			if _, err := l.bin(ctx, item.WarehouseID, fromID); err != nil {
+				return err
+			}
+			return fmt.Errorf("bin %s: %w", fromID, entity.ErrInsufficientStock)
+		}
+		if err := l.record(ctx, movement, from, -quantity, from.Remove); err != nil {
+			return err
+		}
+	}
+
+	to, err := l.bin(ctx, item.WarehouseID, toID)
+	if err != nil {
+		return err
+	}
+	return l.put(ctx, movement, stocks, to, quantity)
+}
+
+// put stores quantity units of the movement's item in a locked bin
+func (l *binLedger) put(ctx context.Context, movement *entity.StockMovement, stocks []*entity.BinStock, bin *entity.Location, quantity int) error {
+	if err := bin.Store(quantity); err != nil {
+		return fmt.Errorf("bin %s: %w", bin.Path, err)
+	}
+	stock := findBinStock(stocks, bin.ID)
+	if stock == nil {
+		var err error
+		if stock, err = entity.NewBinStock(movement.StockItemID, bin); err != nil {
+			return err
+		}
+	}
+	return l.record(ctx, movement, stock, quantity, stock.Add)
+}
+
+// picks suggests where quantity units of item are picked from
+func (l *binLedger) picks(ctx context.Context, item *entity.StockItem, quantity int) ([]BinPick, error) {
+	stocks, err := l.locations.GetBinStock(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bin stock: %w", err)
+	}
+	return planPicks(item.ID, stocks, item.QuantityOnHand, quantity)
+}
+
+// planPicks takes quantity units from the bins in the order of stocks, then from the
+// stock of an item holding onHand units that is in no bin
+func planPicks(stockItemID string, stocks []*entity.BinStock, onHand, quantity int) ([]BinPick, error) {
+	var picks []BinPick
+	remaining := quantity
+	for _, stock := range stocks {
+		if remaining == 0 {
+			break
+		}
+		take := min(stock.Quantity, remaining)
+		picks = append(picks, BinPick{StockItemID: stockItemID, LocationID: stock.LocationID, Path: stock.Path, Quantity: take})
+		remaining -= take
+	}
+	if remaining > 0 {
+		if remaining > unbinned(onHand, stocks) {
+			return nil, fmt.Errorf("bins of stock item %s: %w", stockItemID, entity.ErrInsufficientStock)
+		}
+		picks = append(picks, BinPick{StockItemID: stockItemID, Quantity: remaining})
+	}
+	return picks, nil
+}
+
+// unbinned returns the stock of an item holding onHand units that is in none of its bins
+func unbinned(onHand int, stocks []*entity.BinStock) int {
+	for _, stock := range stocks {
+		onHand -= stock.Quantity
+	}
+	return onHand
+}
+
+func findBinStock(stocks []*entity.BinStock, locationID string) *entity.BinStock {
+	for _, stock := range stocks {
+		if stock.LocationID == locationID {
+			return stock
+		}
+	}
+	return nil
+}
+
+// record applies one bin's share of a movement and records the bin movement
+func (l *binLedger) record(
+	ctx context.Context,
+	movement *entity.StockMovement,
+	stock *entity.BinStock,
+	signed int,
+	apply func(quantity int) error,
+) error {
+	previous := stock.Quantity
+	if err := apply(max(signed, -signed)); err != nil {
+		return fmt.Errorf("bin %s: %w", stock.Path, err)
+	}
+	if err := l.locations.SaveBinStock(ctx, stock); err != nil {
+		return fmt.Errorf("failed to update bin %s: %w", stock.Path, err)
+	}
+
+	err := l.locations.CreateMovement(ctx, &entity.BinMovement{
+		ID:               uuid.NewString(),
+		LocationID:       stock.LocationID,
+		StockItemID:      stock.StockItemID,
+		MovementID:       movement.ID,
+		MovementType:     movement.MovementType,
+		Quantity:         signed,
+		PreviousQuantity: previous,
+		NewQuantity:      stock.Quantity,
+		ReferenceID:      movement.ReferenceID,
+		ReferenceType:    movement.ReferenceType,
+		CreatedBy:        movement.CreatedBy,
+		CreatedAt:        time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record bin movement: %w", err)
+	}
+	return nil
+}
+
+// homeBin resolves the path of a bin in a warehouse
+func (l *binLedger) homeBin(ctx context.Context, warehouseID, path string) (*entity.Location, error) {
+	bin, err := l.locations.GetByPath(ctx, warehouseID, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location %s: %w", path, err)
+	}
+	if !bin.IsBin() {
+		return nil, fmt.Errorf("location %s: %w", path, entity.ErrLocationNotBin)
+	}
+	return bin, nil
+}
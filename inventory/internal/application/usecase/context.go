@@ -0,0 +1,26 @@
+// file: internal/application/usecase/context.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type contextKey string
+
+const correlationIDKey contextKey = "correlation_id"
+
+// WithCorrelationID returns a context carrying the given correlation ID.
+// Events published by use cases invoked with this context reuse the ID.
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey, correlationID)
+}
+
+// CorrelationIDFromContext returns the correlation ID carried by ctx, or a new one
+func CorrelationIDFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(correlationIDKey).(string); ok && v != "" {
+		return v
+	}
+	return uuid.NewString()
+}
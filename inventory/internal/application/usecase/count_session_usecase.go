@@ -0,0 +1,505 @@
+// file: internal/application/usecase/count_session_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// CreateCountSessionInput carries the data required to open a cycle count. When
+// StockItemIDs is empty every stock item of the warehouse is counted.
+type CreateCountSessionInput struct {
+	WarehouseID  string
+	StockItemIDs []string
+	Blind        bool
+	Freeze       bool
+	Notes        string
+	PerformedBy  string
+}
+
+// CountInput is the quantity counted for one stock item
+type CountInput struct {
+	StockItemID string
+	Quantity    int
+}
+
+// RecordCountsInput carries the quantities counters found on the shelf
+type RecordCountsInput struct {
+	CountSessionID string
+	Counts         []CountInput
+	PerformedBy    string
+}
+
+// ApproveCountSessionInput carries a reviewer's approval of a submitted count. When
+// StockItemIDs is empty every variance is approved.
+type ApproveCountSessionInput struct {
+	CountSessionID string
+	StockItemIDs   []string
+	ApprovedBy     string
+}
+
+// CountSessionDetails is a count session together with the backorders found stock was
+// reserved for
+type CountSessionDetails struct {
+	*entity.CountSession
+	FilledBackorders []*entity.Backorder // Set by Approve only
+}
+
+// CountSessionUseCase orchestrates cycle counts. Approved variances are posted as
+// ADJUSTMENT movements that reference the count session ID.
+type CountSessionUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	movements  repository.StockMovementRepository
+	lots       *lotLedger
+	bins       *binLedger
+	kits       *kitStock
+	counts     repository.CountSessionRepository
+	publisher  port.EventPublisher
+	backorders *BackorderUseCase
+	retry      RetryPolicy
+}
+
+// NewCountSessionUseCase creates a new CountSessionUseCase
+func NewCountSessionUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	lots repository.LotRepository,
+	locations repository.LocationRepository,
+	kits repository.KitRepository,
+	counts repository.CountSessionRepository,
+	publisher port.EventPublisher,
+	backorders *BackorderUseCase,
+	retry RetryPolicy,
+) *CountSessionUseCase {
+	return &CountSessionUseCase{
+		tx:         tx,
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		movements:  movements,
+		lots:       newLotLedger(lots),
+		bins:       newBinLedger(locations),
+		kits:       newKitStock(kits, stockItems),
+		counts:     counts,
+		publisher:  publisher,
+		backorders: backorders,
+		retry:      retry,
+	}
+}
+
+// Create opens a count session for stock items of an active warehouse, snapshotting
+// their on-hand quantities. Serialized stock is not counted in bulk: a session over the
+// whole warehouse leaves it out, and listing it explicitly is refused. A freezing session refuses to start while another session
+// freezes one of its stock items.
+func (uc *CountSessionUseCase) Create(ctx context.Context, in CreateCountSessionInput) (*entity.CountSession, error) {
+	warehouse, err := uc.warehouses.GetByID(ctx, in.WarehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouse %s: %w", in.WarehouseID, err)
+	}
+	if warehouse.IsDeleted() || !warehouse.IsActive {
+		return nil, fmt.Errorf("warehouse %s: %w", warehouse.ID, ErrWarehouseInactive)
+	}
+
+	var result *entity.CountSession
+	err = withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		items, err := uc.stockItemsToCount(ctx, loader, in)
+		if err != nil {
+			return err
+		}
+		lines := make([]entity.CountLine, 0, len(items))
+		for _, item := range items {
+			lines = append(lines, entity.CountLine{
+				StockItemID:      item.ID,
+				ProductID:        item.ProductID,
+				ExpectedQuantity: item.QuantityOnHand,
+			})
+		}
+
+		session, err := entity.NewCountSession(uuid.NewString(), in.WarehouseID, lines, in.Blind, in.Freeze,
+			in.Notes, in.PerformedBy)
+		if err != nil {
+			return err
+		}
+		if err := uc.counts.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create count session: %w", err)
+		}
+
+		if session.Freeze {
+			for _, item := range items {
+				if err := item.Freeze(session.ID); err != nil {
+					return fmt.Errorf("stock item %s: %w", item.ID, err)
+				}
+				if err := uc.stockItems.UpdateWithLock(ctx, item, item.Version); err != nil {
+					return fmt.Errorf("failed to update stock item: %w", err)
+				}
+			}
+		}
+		result = session
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetByID retrieves a count session by its ID
+func (uc *CountSessionUseCase) GetByID(ctx context.Context, id string) (*entity.CountSession, error) {
+	session, err := uc.counts.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get count session %s: %w", id, err)
+	}
+	return session, nil
+}
+
+// List retrieves count sessions matching the filter along with the total match count
+func (uc *CountSessionUseCase) List(ctx context.Context, filter repository.CountSessionFilter) ([]*entity.CountSession, int, error) {
+	sessions, total, err := uc.counts.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list count sessions: %w", err)
+	}
+	return sessions, total, nil
+}
+
+// Record captures counted quantities for stock items of an open session. Each count is
+// compared with the stock item's on-hand quantity at the time it is recorded, so stock
+// moved before the shelf was counted does not show up as a variance.
+func (uc *CountSessionUseCase) Record(ctx context.Context, in RecordCountsInput) (*entity.CountSession, error) {
+	var result *entity.CountSession
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		session, err := uc.counts.GetByIDForUpdate(ctx, in.CountSessionID)
+		if err != nil {
+			return fmt.Errorf("failed to get count session %s: %w", in.CountSessionID, err)
+		}
+		for _, count := range in.Counts {
+			item, err := uc.stockItems.GetByID(ctx, count.StockItemID)
+			if err != nil {
+				return fmt.Errorf("failed to get stock item %s: %w", count.StockItemID, err)
+			}
+			if err := session.Record(item.ID, count.Quantity, item.QuantityOnHand, in.PerformedBy); err != nil {
+				return fmt.Errorf("stock item %s: %w", item.ID, err)
+			}
+		}
+		if err := uc.counts.Update(ctx, session); err != nil {
+			return fmt.Errorf("failed to update count session: %w", err)
+		}
+		result = session
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Submit ends counting once every stock item has been counted and hands the session's
+// variances to review
+func (uc *CountSessionUseCase) Submit(ctx context.Context, id, performedBy string) (*entity.CountSession, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *entity.CountSession
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		session, err := uc.counts.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get count session %s: %w", id, err)
+		}
+		if err := session.Submit(); err != nil {
+			return err
+		}
+		if err := uc.counts.Update(ctx, session); err != nil {
+			return fmt.Errorf("failed to update count session: %w", err)
+		}
+
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		var variances []event.CountVariance
+		for _, line := range session.Lines {
+			if line.Variance() == 0 {
+				continue
+			}
+			variance, err := countVarianceOf(ctx, loader, line)
+			if err != nil {
+				return err
+			}
+			variances = append(variances, variance)
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.CountSessionSubmittedEvent{
+			EventID:        meta.EventID,
+			CorrelationID:  meta.CorrelationID,
+			Timestamp:      meta.Timestamp,
+			Version:        meta.Version,
+			CountSessionID: session.ID,
+			WarehouseID:    session.WarehouseID,
+			Variances:      variances,
+			TotalVariance:  session.TotalVariance(),
+			SubmittedBy:    performedBy,
+		}
+		if err := publishEvent(ctx, uc.publisher, AggregateTypeCountSession, evt, meta); err != nil {
+			return err
+		}
+		result = session
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Approve posts the approved variances of a submitted session as ADJUSTMENT movements,
+// lifts the session's freeze and closes it. Found stock is reserved for open backorders.
+func (uc *CountSessionUseCase) Approve(ctx context.Context, in ApproveCountSessionInput) (*CountSessionDetails, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *CountSessionDetails
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		session, err := uc.counts.GetByIDForUpdate(ctx, in.CountSessionID)
+		if err != nil {
+			return fmt.Errorf("failed to get count session %s: %w", in.CountSessionID, err)
+		}
+		if err := session.Approve(in.ApprovedBy, in.StockItemIDs); err != nil {
+			return err
+		}
+
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		movementIDs := make(map[string]string)
+		var variances []event.CountVariance
+		for _, line := range session.Lines {
+			if !line.Approved {
+				if err := uc.unfreeze(ctx, session, line.StockItemID); err != nil {
+					return err
+				}
+				continue
+			}
+			movementID, err := uc.post(ctx, loader, session, line, in.ApprovedBy, correlationID)
+			if err != nil {
+				return err
+			}
+			movementIDs[line.StockItemID] = movementID
+			line.MovementID = movementID
+			variance, err := countVarianceOf(ctx, loader, line)
+			if err != nil {
+				return err
+			}
+			variances = append(variances, variance)
+		}
+		if err := session.MarkPosted(movementIDs); err != nil {
+			return err
+		}
+		if err := uc.counts.Update(ctx, session); err != nil {
+			return fmt.Errorf("failed to update count session: %w", err)
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.CountSessionPostedEvent{
+			EventID:        meta.EventID,
+			CorrelationID:  meta.CorrelationID,
+			Timestamp:      meta.Timestamp,
+			Version:        meta.Version,
+			CountSessionID: session.ID,
+			WarehouseID:    session.WarehouseID,
+			Variances:      variances,
+			PostedBy:       in.ApprovedBy,
+		}
+		if err := publishEvent(ctx, uc.publisher, AggregateTypeCountSession, evt, meta); err != nil {
+			return err
+		}
+
+		result = &CountSessionDetails{CountSession: session}
+		for _, line := range session.ApprovedLines() {
+			if line.Variance() < 0 {
+				continue
+			}
+			filled, err := uc.backorders.fill(ctx, line.StockItemID)
+			if err != nil {
+				return err
+			}
+			result.FilledBackorders = append(result.FilledBackorders, filled...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Cancel abandons a session that has not been posted and lifts its freeze
+func (uc *CountSessionUseCase) Cancel(ctx context.Context, id string) (*entity.CountSession, error) {
+	var result *entity.CountSession
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		session, err := uc.counts.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get count session %s: %w", id, err)
+		}
+		if err := session.Cancel(); err != nil {
+			return err
+		}
+		for _, line := range session.Lines {
+			if err := uc.unfreeze(ctx, session, line.StockItemID); err != nil {
+				return err
+			}
+		}
+		if err := uc.counts.Update(ctx, session); err != nil {
+			return fmt.Errorf("failed to update count session: %w", err)
+		}
+		result = session
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// stockItemsToCount resolves the stock items a new session counts, checking that
+// explicitly listed items belong to the session's warehouse. Variances could not be
+// posted for serialized products, so their stock items are skipped or refused.
+func (uc *CountSessionUseCase) stockItemsToCount(
+	ctx context.Context,
+	loader *referenceLoader,
+	in CreateCountSessionInput,
+) ([]*entity.StockItem, error) {
+	if len(in.StockItemIDs) == 0 {
+		all, _, err := uc.stockItems.List(ctx, repository.StockItemFilter{WarehouseID: &in.WarehouseID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list stock items: %w", err)
+		}
+		items := make([]*entity.StockItem, 0, len(all))
+		for _, item := range all {
+			product, err := loader.product(ctx, item.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			if !product.Serialized {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+
+	items := make([]*entity.StockItem, 0, len(in.StockItemIDs))
+	for _, id := range in.StockItemIDs {
+		item, err := uc.stockItems.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stock item %s: %w", id, err)
+		}
+		if item.WarehouseID != in.WarehouseID {
+			return nil, fmt.Errorf("stock item %s: %w", id, ErrStockItemNotInWarehouse)
+		}
+		product, err := loader.product(ctx, item.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if product.Serialized {
+			return nil, fmt.Errorf("stock item %s: %w", id, ErrSerializedCount)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// post applies an approved variance to its stock item and records the ADJUSTMENT
+// movement, returning the movement ID
+func (uc *CountSessionUseCase) post(
+	ctx context.Context,
+	loader *referenceLoader,
+	session *entity.CountSession,
+	line entity.CountLine,
+	performedBy, correlationID string,
+) (string, error) {
+	stockItem, err := uc.stockItems.GetByID(ctx, line.StockItemID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get stock item %s: %w", line.StockItemID, err)
+	}
+	item, err := loader.stockItemDetails(ctx, stockItem)
+	if err != nil {
+		return "", err
+	}
+
+	product, err := loader.product(ctx, item.ProductID)
+	if err != nil {
+		return "", err
+	}
+	before := snapshotOf(stockItem)
+	stockItem.Unfreeze(session.ID)
+	if err := stockItem.Adjust(line.Variance()); err != nil {
+		return "", fmt.Errorf("product %s: %w", item.ProductID, err)
+	}
+	if err := uc.stockItems.UpdateWithLock(ctx, stockItem, stockItem.Version); err != nil {
+		return "", fmt.Errorf("failed to update stock item: %w", err)
+	}
+
+	reason := fmt.Sprintf("cycle count: expected %d, counted %d", line.ExpectedQuantity, *line.CountedQuantity)
+	movement, err := newMovement(stockItem, before, entity.MovementTypeAdjustment, line.Variance(),
+		session.ID, ReferenceTypeCycleCount, reason, performedBy)
+	if err != nil {
+		return "", err
+	}
+	if err := uc.movements.Create(ctx, movement); err != nil {
+		return "", fmt.Errorf("failed to record stock movement: %w", err)
+	}
+	if err := uc.lots.post(ctx, movement, nil); err != nil {
+		return "", fmt.Errorf("failed to post lot movements: %w", err)
+	}
+	if _, err := uc.bins.post(ctx, stockItem, movement, ""); err != nil {
+		return "", fmt.Errorf("failed to post bin movements: %w", err)
+	}
+	if err := publishMovementRecorded(ctx, uc.publisher, movement, item, correlationID); err != nil {
+		return "", err
+	}
+	if err := publishLowStockAlert(ctx, uc.publisher, item, before, product.MinStock, correlationID); err != nil {
+		return "", err
+	}
+	return movement.ID, uc.kits.publishLowStockAlerts(ctx, loader, uc.publisher, item, before, correlationID)
+}
+
+// unfreeze lifts the session's freeze from a stock item, if it placed one
+func (uc *CountSessionUseCase) unfreeze(ctx context.Context, session *entity.CountSession, stockItemID string) error {
+	if !session.Freeze {
+		return nil
+	}
+	item, err := uc.stockItems.GetByID(ctx, stockItemID)
+	if err != nil {
+		return fmt.Errorf("failed to get stock item %s: %w", stockItemID, err)
+	}
+	if item.FrozenBy != session.ID {
+		return nil
+	}
+	item.Unfreeze(session.ID)
+	if err := uc.stockItems.UpdateWithLock(ctx, item, item.Version); err != nil {
+		return fmt.Errorf("failed to update stock item: %w", err)
+	}
+	return nil
+}
+
+func countVarianceOf(ctx context.Context, loader *referenceLoader, line entity.CountLine) (event.CountVariance, error) {
+	product, err := loader.product(ctx, line.ProductID)
+	if err != nil {
+		return event.CountVariance{}, err
+	}
+	return event.CountVariance{
+		StockItemID:      line.StockItemID,
+		ProductID:        line.ProductID,
+		SKU:              product.SKU,
+		ExpectedQuantity: line.ExpectedQuantity,
+		CountedQuantity:  *line.CountedQuantity,
+		Variance:         line.Variance(),
+		MovementID:       line.MovementID,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// file: internal/application/usecase/count_session_usecase_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+func TestCountSessionLeavesOutSerializedStock(t *testing.T) {
+	products := &fakeProducts{products: map[string]*entity.Product{
+		"product-1": {ID: "product-1"},
+		"product-2": {ID: "product-2", Serialized: true},
+	}}
+	stockItems := &casStockItems{items: map[string]*entity.StockItem{
+		"item-1": {ID: "item-1", ProductID: "product-1", WarehouseID: "wh-1"},
+		"item-2": {ID: "item-2", ProductID: "product-2", WarehouseID: "wh-1"},
+	}}
+	uc := &CountSessionUseCase{products: products, stockItems: stockItems}
+
+	tests := []struct {
+		name         string
+		stockItemIDs []string
+		want         []string
+		wantErr      error
+	}{
+		{name: "whole warehouse", want: []string{"item-1"}},
+		{name: "listed stock item", stockItemIDs: []string{"item-1"}, want: []string{"item-1"}},
+		{name: "listed serialized stock item", stockItemIDs: []string{"item-1", "item-2"}, wantErr: ErrSerializedCount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := CreateCountSessionInput{WarehouseID: "wh-1", StockItemIDs: tt.stockItemIDs}
+			items, err := uc.stockItemsToCount(context.Background(), newReferenceLoader(products, nil), in)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, item := range items {
+				got = append(got, item.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("stock items: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
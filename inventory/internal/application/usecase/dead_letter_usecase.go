@@ -0,0 +1,74 @@
+// file: internal/application/usecase/dead_letter_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// DeadLetterUseCase lets operators inspect quarantined messages and send them back
+// into processing once the cause of the failure has been fixed
+type DeadLetterUseCase struct {
+	tx       port.TransactionManager
+	store    port.DeadLetterStore
+	replayer port.MessageReplayer
+}
+
+// NewDeadLetterUseCase creates a new DeadLetterUseCase
+func NewDeadLetterUseCase(tx port.TransactionManager, store port.DeadLetterStore, replayer port.MessageReplayer) *DeadLetterUseCase {
+	return &DeadLetterUseCase{
+		tx:       tx,
+		store:    store,
+		replayer: replayer,
+	}
+}
+
+// List retrieves dead letters matching the filter, most recent first
+func (uc *DeadLetterUseCase) List(ctx context.Context, filter port.DeadLetterFilter) ([]*port.DeadLetter, int, error) {
+	dls, total, err := uc.store.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+	return dls, total, nil
+}
+
+// Get retrieves a dead letter with its original message
+func (uc *DeadLetterUseCase) Get(ctx context.Context, id string) (*port.DeadLetter, error) {
+	dl, err := uc.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letter %s: %w", id, err)
+	}
+	return dl, nil
+}
+
+// Replay sends a quarantined message back into processing. Should it fail again it goes
+// through the retry tiers once more and is quarantined under the same ID.
+//
+// The dead letter is marked replayed before the message is sent, in one transaction:
+// concurrent replays of the same dead letter wait for each other and only the first
+// sends the message, and a failed send leaves the dead letter quarantined.
+func (uc *DeadLetterUseCase) Replay(ctx context.Context, id, replayedBy string) (*port.DeadLetter, error) {
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		dl, err := uc.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		marked, err := uc.store.MarkReplayed(ctx, id, replayedBy)
+		if err != nil {
+			return fmt.Errorf("failed to mark dead letter %s replayed: %w", id, err)
+		}
+		if !marked {
+			return ErrDeadLetterReplayed
+		}
+		if err := uc.replayer.Replay(ctx, dl); err != nil {
+			return fmt.Errorf("failed to replay dead letter %s: %w", id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.Get(ctx, id)
+}
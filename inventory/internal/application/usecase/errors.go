@@ -0,0 +1,41 @@
+// file: internal/application/usecase/errors.go
+package usecase
+
+import (
+	"errors"
+)
+
+// Use case errors
+var (
+	ErrProductSKUExists            = errors.New("a product with this SKU already exists")
+	ErrWarehouseCodeExists         = errors.New("a warehouse with this code already exists")
+	ErrStockItemExists             = errors.New("a stock item already exists for this product and warehouse")
+	ErrProductInactive             = errors.New("product is not active")
+	ErrWarehouseInactive           = errors.New("warehouse is not active")
+	ErrProductNotStocked           = errors.New("product is not stocked in any active warehouse")
+	ErrNotStockedInWarehouse       = errors.New("product is not stocked in the warehouse")
+	ErrStockItemNotInWarehouse     = errors.New("stock item belongs to another warehouse")
+	ErrExpiryInPast                = errors.New("reservation expiry must be in the future")
+	ErrReservationNotExpired       = errors.New("reservation has not expired yet")
+	ErrReservationLifetimeExceeded = errors.New("reservation would exceed its maximum lifetime")
+	ErrUnknownAllocationStrategy   = errors.New("unknown allocation strategy")
+	ErrUnknownReservationPolicy    = errors.New("unknown reservation policy")
+	ErrUnknownReasonCode           = errors.New("unknown adjustment reason code")
+	ErrDeadLetterReplayed          = errors.New("dead letter has already been replayed")
+	ErrSerialNumbersRequired       = errors.New("serial numbers are required for serialized products")
+	ErrSerialNumberCount           = errors.New("serial numbers must match the quantity")
+	ErrSerialNumberDuplicate       = errors.New("serial number is named more than once")
+	ErrSerialUnitNotInShipment     = errors.New("serial unit is not held by a stock item the shipment ships from")
+	ErrProductNotSerialized        = errors.New("product is not serialized")
+	ErrSerializedMovement          = errors.New("serialized stock can only be moved by serial number")
+	ErrSerializedCount             = errors.New("serialized stock is not counted in a count session")
+	ErrLocationPathExists          = errors.New("a location with this path already exists in the warehouse")
+	ErrBinMoveSameLocation         = errors.New("stock cannot be moved to the bin it is in")
+	ErrVariantRequired             = errors.New("product has variants; a variant must be given")
+	ErrVariantNotOfProduct         = errors.New("variant belongs to another product")
+	ErrVariantExists               = errors.New("a variant with this size and color already exists")
+	ErrBaseProductStocked          = errors.New("product is stocked itself and cannot have variants")
+	ErrKitNotStocked               = errors.New("a kit is stocked only through its components")
+	ErrUnitExists                  = errors.New("a unit of measure with this code already exists")
+	ErrUnitNotConvertible          = errors.New("product has no conversion for this unit of measure")
+)
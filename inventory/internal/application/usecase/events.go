@@ -0,0 +1,136 @@
+// file: internal/application/usecase/events.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+)
+
+// eventSchemaVersion is the payload version stamped on every published event
+const eventSchemaVersion = "1.0"
+
+// Aggregate types recorded on outbox entries
+const (
+	AggregateTypeStockItem     = "StockItem"
+	AggregateTypeStockMovement = "StockMovement"
+	AggregateTypeReservation   = "Reservation"
+	AggregateTypeOrder         = "Order"
+	AggregateTypeBackorder     = "Backorder"
+	AggregateTypeTransfer      = "Transfer"
+	AggregateTypeAdjustment    = "Adjustment"
+	AggregateTypeCountSession  = "CountSession"
+)
+
+// newEventMetadata creates metadata for an event published within a use case
+func newEventMetadata(correlationID string) event.EventMetadata {
+	return event.NewEventMetadata(uuid.NewString(), correlationID, eventSchemaVersion)
+}
+
+// publishEvent serializes a domain event and stores it in the outbox.
+// It must be called with the transactional context of the state change it describes.
+func publishEvent(ctx context.Context, publisher port.EventPublisher, aggregateType string, evt event.DomainEvent, meta event.EventMetadata) error {
+	_, err := publishOutcome(ctx, publisher, aggregateType, evt, meta)
+	return err
+}
+
+// publishOutcome is publishEvent for events that report the outcome of a request.
+// It returns the stored outbox entry so the outcome can be reproduced exactly.
+func publishOutcome(ctx context.Context, publisher port.EventPublisher, aggregateType string, evt event.DomainEvent, meta event.EventMetadata) (port.OutboxEntry, error) {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return port.OutboxEntry{}, fmt.Errorf("failed to marshal %s: %w", evt.EventName(), err)
+	}
+
+	entry := port.OutboxEntry{
+		ID:            meta.EventID,
+		AggregateType: aggregateType,
+		AggregateID:   evt.AggregateID(),
+		EventType:     evt.EventName(),
+		Payload:       payload,
+		CorrelationID: meta.CorrelationID,
+		CreatedAt:     meta.Timestamp.UnixMilli(),
+	}
+	if err := publisher.PublishToOutbox(ctx, entry); err != nil {
+		return port.OutboxEntry{}, fmt.Errorf("failed to publish %s to outbox: %w", evt.EventName(), err)
+	}
+	return entry, nil
+}
+
+// publishMovementRecorded publishes the audit event for a recorded stock movement
+func publishMovementRecorded(ctx context.Context, publisher port.EventPublisher, movement *entity.StockMovement, item *StockItemDetails, correlationID string) error {
+	meta := newEventMetadata(correlationID)
+	evt := event.StockMovementRecordedEvent{
+		EventID:       meta.EventID,
+		CorrelationID: meta.CorrelationID,
+		Timestamp:     meta.Timestamp,
+		Version:       meta.Version,
+		MovementID:    movement.ID,
+		ProductID:     item.ProductID,
+		SKU:           item.SKU,
+		WarehouseID:   item.WarehouseID,
+		MovementType:  toEventMovementType(movement.MovementType),
+		Quantity:      movement.Quantity,
+		PreviousStock: movement.PreviousOnHand,
+		NewStock:      movement.NewOnHand,
+		ReferenceType: movement.ReferenceType,
+		ReferenceID:   movement.ReferenceID,
+		Reason:        movement.Reason,
+		PerformedBy:   movement.CreatedBy,
+	}
+	return publishEvent(ctx, publisher, AggregateTypeStockMovement, evt, meta)
+}
+
+// publishLowStockAlert publishes a low stock alert when a change pushed the item across its reorder point
+func publishLowStockAlert(ctx context.Context, publisher port.EventPublisher, item *StockItemDetails, before stockSnapshot, minimumStock int, correlationID string) error {
+	if !item.IsLowStock() || before.reservable() <= item.ReorderPoint {
+		return nil
+	}
+
+	meta := newEventMetadata(correlationID)
+	evt := event.LowStockAlertEvent{
+		EventID:       meta.EventID,
+		CorrelationID: meta.CorrelationID,
+		Timestamp:     meta.Timestamp,
+		Version:       meta.Version,
+		AlertID:       uuid.NewString(),
+		ProductID:     item.ProductID,
+		SKU:           item.SKU,
+		ProductName:   item.ProductName,
+		WarehouseID:   item.WarehouseID,
+		WarehouseName: item.WarehouseName,
+		CurrentStock:  item.ReservableQuantity(),
+		MinimumStock:  minimumStock,
+		Severity:      lowStockSeverity(item.StockItem),
+	}
+	return publishEvent(ctx, publisher, AggregateTypeStockItem, evt, meta)
+}
+
+// lowStockSeverity grades how far a stock item has fallen below its reorder point
+func lowStockSeverity(item *entity.StockItem) event.LowStockSeverity {
+	reservable := item.ReservableQuantity()
+	switch {
+	case reservable <= 0:
+		return event.SeverityOutOfStock
+	case reservable <= item.ReorderPoint/2:
+		return event.SeverityCritical
+	default:
+		return event.SeverityWarning
+	}
+}
+
+// toEventMovementType maps a domain movement type onto its published representation
+func toEventMovementType(mt entity.MovementType) event.MovementType {
+	switch mt {
+	case entity.MovementTypeFulfillment:
+		return event.MovementTypeDecrement
+	default:
+		return event.MovementType(mt)
+	}
+}
@@ -0,0 +1,101 @@
+// file: internal/application/usecase/kit_stock.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// kitStock derives the stock of kits from the stock of their components. A kit is
+// stocked in a warehouse that stocks all its components, as many kits as the component
+// quantities there make up, so a movement of a component can take a kit low on stock.
+type kitStock struct {
+	kits       repository.KitRepository
+	stockItems repository.StockItemRepository
+}
+
+func newKitStock(kits repository.KitRepository, stockItems repository.StockItemRepository) *kitStock {
+	return &kitStock{kits: kits, stockItems: stockItems}
+}
+
+// kit returns the composition of a kit product
+func (s *kitStock) kit(ctx context.Context, productID string) (*entity.Kit, error) {
+	kit, err := s.kits.GetByProductID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kit %s: %w", productID, err)
+	}
+	return kit, nil
+}
+
+// inWarehouse returns the stock of a kit in the warehouse of item, a stock item of one of
+// its components, reading the other components' stock items; false when the warehouse
+// does not stock every component
+func (s *kitStock) inWarehouse(ctx context.Context, kit *entity.Kit, item *entity.StockItem) (*entity.StockItem, map[string]*entity.StockItem, bool, error) {
+	items := make(map[string]*entity.StockItem, len(kit.Components))
+	for _, c := range kit.Components {
+		if c.ProductID == item.ProductID {
+			items[c.ProductID] = item
+			continue
+		}
+		other, err := s.stockItems.GetByProductAndWarehouse(ctx, c.ProductID, item.WarehouseID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, false, nil
+		}
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to get stock item for product %s: %w", c.ProductID, err)
+		}
+		items[c.ProductID] = other
+	}
+	stock, ok := kit.Stock(items)
+	return stock, items, ok, nil
+}
+
+// publishLowStockAlerts publishes a LowStockAlertEvent for every kit the movement of a
+// component from before to item took to or below the kit's minimum stock in the warehouse
+func (s *kitStock) publishLowStockAlerts(
+	ctx context.Context,
+	loader *referenceLoader,
+	publisher port.EventPublisher,
+	item *StockItemDetails,
+	before stockSnapshot,
+	correlationID string,
+) error {
+	kits, err := s.kits.ListByComponent(ctx, item.ProductID)
+	if err != nil {
+		return fmt.Errorf("failed to list kits of product %s: %w", item.ProductID, err)
+	}
+	for _, kit := range kits {
+		after, items, ok, err := s.inWarehouse(ctx, kit, item.StockItem)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		prior := *item.StockItem
+		prior.QuantityOnHand, prior.QuantityReserved, prior.QuantityExpired = before.onHand, before.reserved, before.expired
+		items[item.ProductID] = &prior
+		was, _ := kit.Stock(items)
+
+		product, err := loader.product(ctx, kit.ProductID)
+		if err != nil {
+			return err
+		}
+		after.ReorderPoint = product.MinStock
+		details := &StockItemDetails{
+			StockItem:     after,
+			SKU:           product.SKU,
+			ProductName:   product.Name,
+			WarehouseName: item.WarehouseName,
+		}
+		if err := publishLowStockAlert(ctx, publisher, details, snapshotOf(was), product.MinStock, correlationID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// file: internal/application/usecase/location_usecase.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// CreateLocationInput carries the data required to add a location to a warehouse
+type CreateLocationInput struct {
+	WarehouseID string
+	ParentID    string // Empty for zones
+	Type        entity.LocationType
+	Code        string
+	Capacity    int // Bins only; 0 means unlimited
+}
+
+// MoveBinStockInput carries the data required to move stock of an item between bins
+type MoveBinStockInput struct {
+	StockItemID    string
+	FromLocationID string // Empty to put away stock that is in no bin yet
+	ToLocationID   string
+	Quantity       int
+	Reason         string
+	PerformedBy    string
+}
+
+// LocationDetails is a location together with the stock balances held in it
+type LocationDetails struct {
+	*entity.Location
+	Contents []*entity.BinStock // Set for bins by GetByID only
+}
+
+// BinStockDetails is the stock of a stock item split across its bins
+type BinStockDetails struct {
+	*entity.StockItem
+	Bins             []*entity.BinStock // In pick order
+	QuantityUnbinned int                // On-hand stock that is in no bin
+}
+
+// LocationUseCase manages the storage hierarchy of warehouses and moves stock between
+// bins. Bin balances otherwise follow the stock movements through the bin ledger.
+type LocationUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	movements  repository.StockMovementRepository
+	locations  repository.LocationRepository
+	bins       *binLedger
+	publisher  port.EventPublisher
+	retry      RetryPolicy
+}
+
+// NewLocationUseCase creates a new LocationUseCase
+func NewLocationUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	locations repository.LocationRepository,
+	publisher port.EventPublisher,
+	retry RetryPolicy,
+) *LocationUseCase {
+	return &LocationUseCase{
+		tx:         tx,
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		movements:  movements,
+		locations:  locations,
+		bins:       newBinLedger(locations),
+		publisher:  publisher,
+		retry:      retry,
+	}
+}
+
+// Create adds a zone to a warehouse, or an aisle, rack or bin under the location of the
+// level above it. Paths are unique within a warehouse.
+func (uc *LocationUseCase) Create(ctx context.Context, in CreateLocationInput) (*LocationDetails, error) {
+	warehouse, err := uc.warehouses.GetByID(ctx, in.WarehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouse %s: %w", in.WarehouseID, err)
+	}
+	if warehouse.IsDeleted() || !warehouse.IsActive {
+		return nil, ErrWarehouseInactive
+	}
+
+	var parent *entity.Location
+	if in.ParentID != "" {
+		if parent, err = uc.locations.GetByID(ctx, in.ParentID); err != nil {
+			return nil, fmt.Errorf("failed to get location %s: %w", in.ParentID, err)
+		}
+	}
+
+	location, err := entity.NewLocation(uuid.NewString(), warehouse.ID, parent, in.Type, in.Code, in.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.locations.Create(ctx, location); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("location %s: %w", location.Path, ErrLocationPathExists)
+		}
+		return nil, fmt.Errorf("failed to create location: %w", err)
+	}
+	return &LocationDetails{Location: location}, nil
+}
+
+// GetByID retrieves a location, with the stock held in it when it is a bin
+func (uc *LocationUseCase) GetByID(ctx context.Context, id string) (*LocationDetails, error) {
+	location, err := uc.locations.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location %s: %w", id, err)
+	}
+	details := &LocationDetails{Location: location}
+	if location.IsBin() {
+		if details.Contents, err = uc.locations.GetBinContents(ctx, id); err != nil {
+			return nil, fmt.Errorf("failed to get bin contents: %w", err)
+		}
+	}
+	return details, nil
+}
+
+// List retrieves locations matching the filter, ordered by path, along with the total
+// match count
+func (uc *LocationUseCase) List(ctx context.Context, filter repository.LocationFilter) ([]*entity.Location, int, error) {
+	locations, total, err := uc.locations.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list locations: %w", err)
+	}
+	return locations, total, nil
+}
+
+// GetMovements retrieves the movements of a bin, newest first, along with the total count
+func (uc *LocationUseCase) GetMovements(ctx context.Context, locationID string, limit, offset int) ([]*entity.BinMovement, int, error) {
+	if _, err := uc.locations.GetByID(ctx, locationID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get location %s: %w", locationID, err)
+	}
+	movements, total, err := uc.locations.GetMovements(ctx, locationID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list bin movements: %w", err)
+	}
+	return movements, total, nil
+}
+
+// ListByStockItem retrieves the bins holding stock of a stock item, in pick order
+func (uc *LocationUseCase) ListByStockItem(ctx context.Context, stockItemID string) (*BinStockDetails, error) {
+	item, err := uc.stockItems.GetByID(ctx, stockItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock item %s: %w", stockItemID, err)
+	}
+	bins, err := uc.locations.GetBinStock(ctx, stockItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bin stock: %w", err)
+	}
+	return &BinStockDetails{StockItem: item, Bins: bins, QuantityUnbinned: unbinned(item.QuantityOnHand, bins)}, nil
+}
+
+// Move moves stock of an item from one bin to another, or puts away stock that is in no
+// bin, and records it as a BIN_MOVE movement. On-hand and reserved stock are unchanged;
+// the item is still updated so the move is ordered with its other stock movements.
+func (uc *LocationUseCase) Move(ctx context.Context, in MoveBinStockInput) (*StockMovementDetails, error) {
+	if in.Quantity <= 0 {
+		return nil, entity.ErrMovementQuantityZero
+	}
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *StockMovementDetails
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		item, err := uc.stockItems.GetByID(ctx, in.StockItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get stock item %s: %w", in.StockItemID, err)
+		}
+		details, err := loader.stockItemDetails(ctx, item)
+		if err != nil {
+			return err
+		}
+
+		before := snapshotOf(item)
+		if err := uc.stockItems.UpdateWithLock(ctx, item, item.Version); err != nil {
+			return fmt.Errorf("failed to update stock item: %w", err)
+		}
+		movement, err := newMovement(item, before, entity.MovementTypeBinMove, in.Quantity,
+			in.ToLocationID, ReferenceTypeLocation, in.Reason, in.PerformedBy)
+		if err != nil {
+			return err
+		}
+		if err := uc.movements.Create(ctx, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+		if err := uc.bins.move(ctx, item, movement, in.FromLocationID, in.ToLocationID, in.Quantity); err != nil {
+			return err
+		}
+		if err := publishMovementRecorded(ctx, uc.publisher, movement, details, correlationID); err != nil {
+			return err
+		}
+
+		result = movementDetails(movement, details)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,188 @@
+// file: internal/application/usecase/lot_ledger.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// LotInput identifies the lot received stock belongs to
+type LotInput struct {
+	LotNumber      string
+	ManufacturedAt *time.Time
+	ExpiresAt      *time.Time
+}
+
+// lotLedger keeps the lot sub-balances of a stock item in step with its stock movements.
+// Stock in no lot is untracked. Reservations hold the first expiring lots that have not
+// expired, then untracked stock; releases give back untracked stock first and then the
+// last expiring lots; fulfillments and withdrawals take the first expiring lots first.
+// Stock credited by anything but a lot receipt (a transfer receipt, a positive
+// adjustment or count variance) is untracked.
+type lotLedger struct {
+	lots repository.LotRepository
+}
+
+func newLotLedger(lots repository.LotRepository) *lotLedger {
+	return &lotLedger{lots: lots}
+}
+
+// receive returns the stock item's lot with the given number, creating it when it is new.
+// A lot number that is already recorded must not come with different dates.
+func (l *lotLedger) receive(ctx context.Context, stockItemID string, in LotInput) (*entity.Lot, error) {
+	lot, err := l.lots.GetByLotNumber(ctx, stockItemID, in.LotNumber)
+	if err == nil {
+		if !lot.HasDates(in.ManufacturedAt, in.ExpiresAt) {
+			return nil, fmt.Errorf("lot %s: %w", in.LotNumber, entity.ErrLotDatesMismatch)
+		}
+		return lot, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get lot %s: %w", in.LotNumber, err)
+	}
+
+	lot, err = entity.NewLot(uuid.NewString(), stockItemID, in.LotNumber, in.ManufacturedAt, in.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.lots.Create(ctx, lot); err != nil {
+		return nil, fmt.Errorf("failed to create lot %s: %w", in.LotNumber, err)
+	}
+	return lot, nil
+}
+
+// post splits a recorded stock movement across the stock item's lots. Stock added by the
+// movement goes to received when it is set.
+func (l *lotLedger) post(ctx context.Context, movement *entity.StockMovement, received *entity.Lot) error {
+	lots, err := l.lots.GetByStockItem(ctx, movement.StockItemID)
+	if err != nil {
+		return fmt.Errorf("failed to get lots: %w", err)
+	}
+	if len(lots) == 0 && received == nil {
+		return nil
+	}
+
+	untrackedOnHand, untrackedReserved := movement.PreviousOnHand, movement.PreviousReserved
+	for _, lot := range lots {
+		untrackedOnHand -= lot.QuantityOnHand
+		untrackedReserved -= lot.QuantityReserved
+	}
+	untrackedAvailable := untrackedOnHand - untrackedReserved
+
+	onHandDelta := movement.NewOnHand - movement.PreviousOnHand
+	reservedDelta := movement.NewReserved - movement.PreviousReserved
+	now := time.Now()
+
+	// Each case picks the lots that take their share of the movement in order, how much
+	// each can take and how much untracked stock covers what the lots do not
+	var (
+		candidates []*entity.Lot
+		capacity   func(lot *entity.Lot) int
+		apply      func(lot *entity.Lot) func(quantity int) error
+		remaining  int
+		untracked  int
+	)
+	switch {
+	case onHandDelta > 0 && reservedDelta == 0:
+		if received == nil {
+			return nil
+		}
+		return l.record(ctx, movement, received, onHandDelta, received.Receive)
+	case reservedDelta > 0 && onHandDelta == 0:
+		for _, lot := range lots {
+			if !lot.IsExpired(now) {
+				candidates = append(candidates, lot)
+			}
+		}
+		capacity = (*entity.Lot).AvailableQuantity
+		apply = func(lot *entity.Lot) func(int) error { return lot.Reserve }
+		remaining, untracked = reservedDelta, untrackedAvailable
+	case reservedDelta < 0 && onHandDelta == 0:
+		// Untracked stock is released first so the first expiring lots stay reserved
+		remaining = -reservedDelta - min(max(untrackedReserved, 0), -reservedDelta)
+		for i := len(lots) - 1; i >= 0; i-- {
+			candidates = append(candidates, lots[i])
+		}
+		capacity = func(lot *entity.Lot) int { return lot.QuantityReserved }
+		apply = func(lot *entity.Lot) func(int) error { return lot.Release }
+	case reservedDelta < 0 && onHandDelta == reservedDelta:
+		candidates = lots
+		capacity = func(lot *entity.Lot) int { return lot.QuantityReserved }
+		apply = func(lot *entity.Lot) func(int) error { return lot.Fulfill }
+		remaining, untracked = -reservedDelta, untrackedReserved
+	case onHandDelta < 0 && reservedDelta == 0:
+		candidates = lots
+		capacity = (*entity.Lot).AvailableQuantity
+		apply = func(lot *entity.Lot) func(int) error { return lot.Withdraw }
+		remaining, untracked = -onHandDelta, untrackedAvailable
+	default:
+		return nil
+	}
+
+	for _, lot := range candidates {
+		if remaining == 0 {
+			break
+		}
+		take := min(capacity(lot), remaining)
+		if take <= 0 {
+			continue
+		}
+		if err := l.record(ctx, movement, lot, take, apply(lot)); err != nil {
+			return err
+		}
+		remaining -= take
+	}
+	if remaining > untracked {
+		return fmt.Errorf("lots of stock item %s: %w", movement.StockItemID, entity.ErrInsufficientStock)
+	}
+	return nil
+}
+
+// record applies one lot's share of a movement and records the lot movement
+func (l *lotLedger) record(
+	ctx context.Context,
+	movement *entity.StockMovement,
+	lot *entity.Lot,
+	quantity int,
+	apply func(quantity int) error,
+) error {
+	previousOnHand, previousReserved := lot.QuantityOnHand, lot.QuantityReserved
+	if err := apply(quantity); err != nil {
+		return fmt.Errorf("lot %s: %w", lot.LotNumber, err)
+	}
+	if err := l.lots.Update(ctx, lot); err != nil {
+		return fmt.Errorf("failed to update lot %s: %w", lot.LotNumber, err)
+	}
+
+	signed := quantity
+	if movement.Quantity < 0 {
+		signed = -quantity
+	}
+	err := l.lots.CreateMovement(ctx, &entity.LotMovement{
+		ID:               uuid.NewString(),
+		LotID:            lot.ID,
+		StockItemID:      lot.StockItemID,
+		MovementID:       movement.ID,
+		MovementType:     movement.MovementType,
+		Quantity:         signed,
+		ReferenceID:      movement.ReferenceID,
+		ReferenceType:    movement.ReferenceType,
+		PreviousOnHand:   previousOnHand,
+		NewOnHand:        lot.QuantityOnHand,
+		PreviousReserved: previousReserved,
+		NewReserved:      lot.QuantityReserved,
+		CreatedBy:        movement.CreatedBy,
+		CreatedAt:        time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record lot movement: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// file: internal/application/usecase/lot_ledger_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// memLots keeps lots and their movements in memory. Reads return copies, as rows read
+// from the database would be.
+type memLots struct {
+	repository.LotRepository
+	lots      map[string]*entity.Lot // By ID
+	movements []*entity.LotMovement
+}
+
+func (r *memLots) Create(_ context.Context, lot *entity.Lot) error {
+	written := *lot
+	r.lots[lot.ID] = &written
+	return nil
+}
+
+func (r *memLots) GetByLotNumber(_ context.Context, stockItemID, lotNumber string) (*entity.Lot, error) {
+	for _, lot := range r.lots {
+		if lot.StockItemID == stockItemID && lot.LotNumber == lotNumber {
+			read := *lot
+			return &read, nil
+		}
+	}
+	return nil, fmt.Errorf("lot %s: %w", lotNumber, repository.ErrNotFound)
+}
+
+func (r *memLots) GetByStockItem(_ context.Context, stockItemID string) ([]*entity.Lot, error) {
+	var lots []*entity.Lot
+	for _, lot := range r.lots {
+		if lot.StockItemID == stockItemID && lot.QuantityOnHand > 0 {
+			read := *lot
+			lots = append(lots, &read)
+		}
+	}
+	// First expiring first, lots that do not expire last
+	slices.SortFunc(lots, func(a, b *entity.Lot) int {
+		switch {
+		case a.ExpiresAt == nil || b.ExpiresAt == nil:
+			return boolCompare(a.ExpiresAt == nil, b.ExpiresAt == nil)
+		default:
+			return a.ExpiresAt.Compare(*b.ExpiresAt)
+		}
+	})
+	return lots, nil
+}
+
+func (r *memLots) Update(_ context.Context, lot *entity.Lot) error {
+	written := *lot
+	r.lots[lot.ID] = &written
+	return nil
+}
+
+func (r *memLots) CreateMovement(_ context.Context, movement *entity.LotMovement) error {
+	r.movements = append(r.movements, movement)
+	return nil
+}
+
+func boolCompare(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func TestLotLedgerPost(t *testing.T) {
+	const untrackedOnHand = 4
+
+	tests := []struct {
+		name              string
+		reserved          map[string]int // Reserved of each lot before the movement
+		untrackedReserved int
+		onHandDelta       int
+		reservedDelta     int
+		received          string // Lot number the movement receives into
+		wantOnHand        map[string]int
+		wantReserved      map[string]int
+		wantMoved         map[string]int // Signed quantity of each lot movement
+		wantErr           error
+	}{
+		{
+			name:         "receipt into a lot",
+			onHandDelta:  6,
+			received:     "LOT-2",
+			wantOnHand:   map[string]int{"LOT-0": 3, "LOT-1": 5, "LOT-2": 11},
+			wantReserved: map[string]int{},
+			wantMoved:    map[string]int{"LOT-2": 6},
+		},
+		{
+			name:         "receipt into no lot",
+			onHandDelta:  6,
+			wantOnHand:   map[string]int{"LOT-0": 3, "LOT-1": 5, "LOT-2": 5},
+			wantReserved: map[string]int{},
+			wantMoved:    map[string]int{},
+		},
+		{
+			name:          "reservation skips expired lots",
+			reservedDelta: 7,
+			wantOnHand:    map[string]int{"LOT-0": 3, "LOT-1": 5, "LOT-2": 5},
+			wantReserved:  map[string]int{"LOT-1": 5, "LOT-2": 2},
+			wantMoved:     map[string]int{"LOT-1": 5, "LOT-2": 2},
+		},
+		{
+			name:          "reservation beyond the lots takes untracked stock",
+			reservedDelta: 12,
+			wantOnHand:    map[string]int{"LOT-0": 3, "LOT-1": 5, "LOT-2": 5},
+			wantReserved:  map[string]int{"LOT-1": 5, "LOT-2": 5},
+			wantMoved:     map[string]int{"LOT-1": 5, "LOT-2": 5},
+		},
+		{
+			name:          "reservation that would need an expired lot",
+			reservedDelta: 15,
+			wantErr:       entity.ErrInsufficientStock,
+		},
+		{
+			name:              "release gives back untracked stock, then the last expiring lots",
+			reserved:          map[string]int{"LOT-1": 5, "LOT-2": 2},
+			untrackedReserved: 1,
+			reservedDelta:     -4,
+			wantOnHand:        map[string]int{"LOT-0": 3, "LOT-1": 5, "LOT-2": 5},
+			wantReserved:      map[string]int{"LOT-1": 4},
+			wantMoved:         map[string]int{"LOT-2": -2, "LOT-1": -1},
+		},
+		{
+			name:              "fulfillment takes the first expiring lots",
+			reserved:          map[string]int{"LOT-1": 2, "LOT-2": 3},
+			untrackedReserved: 1,
+			onHandDelta:       -4,
+			reservedDelta:     -4,
+			wantOnHand:        map[string]int{"LOT-0": 3, "LOT-1": 3, "LOT-2": 3},
+			wantReserved:      map[string]int{"LOT-2": 1},
+			wantMoved:         map[string]int{"LOT-1": -2, "LOT-2": -2},
+		},
+		{
+			name:              "fulfillment beyond the lots takes untracked stock",
+			reserved:          map[string]int{"LOT-1": 2},
+			untrackedReserved: 3,
+			onHandDelta:       -5,
+			reservedDelta:     -5,
+			wantOnHand:        map[string]int{"LOT-0": 3, "LOT-1": 3, "LOT-2": 5},
+			wantReserved:      map[string]int{},
+			wantMoved:         map[string]int{"LOT-1": -2},
+		},
+		{
+			name:         "withdrawal takes expired lots first",
+			onHandDelta:  -7,
+			wantOnHand:   map[string]int{"LOT-0": 0, "LOT-1": 1, "LOT-2": 5},
+			wantReserved: map[string]int{},
+			wantMoved:    map[string]int{"LOT-0": -3, "LOT-1": -4},
+		},
+		{
+			name:         "withdrawal skips reserved stock",
+			reserved:     map[string]int{"LOT-0": 3, "LOT-1": 4},
+			onHandDelta:  -3,
+			wantOnHand:   map[string]int{"LOT-0": 3, "LOT-1": 4, "LOT-2": 3},
+			wantReserved: map[string]int{"LOT-0": 3, "LOT-1": 4},
+			wantMoved:    map[string]int{"LOT-1": -1, "LOT-2": -2},
+		},
+		{
+			name:        "withdrawal beyond the stock",
+			onHandDelta: -18,
+			wantErr:     entity.ErrInsufficientStock,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			lots := &memLots{lots: make(map[string]*entity.Lot)}
+			previousOnHand, previousReserved := untrackedOnHand, tt.untrackedReserved
+			for i, days := range []int{-1, 10, 20} {
+				number := fmt.Sprintf("LOT-%d", i)
+				expiresAt := now.AddDate(0, 0, days)
+				lot, err := entity.NewLot(number, "item-1", number, nil, &expiresAt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				lot.QuantityOnHand = []int{3, 5, 5}[i]
+				lot.QuantityReserved = tt.reserved[number]
+				lots.lots[lot.ID] = lot
+				previousOnHand += lot.QuantityOnHand
+				previousReserved += lot.QuantityReserved
+			}
+			var received *entity.Lot
+			if tt.received != "" {
+				received = lots.lots[tt.received]
+			}
+
+			// Signed as the use cases sign it: by the reserved quantity when that changes
+			quantity := tt.onHandDelta
+			if tt.reservedDelta != 0 {
+				quantity = tt.reservedDelta
+			}
+			movement := &entity.StockMovement{
+				ID:               "movement-1",
+				StockItemID:      "item-1",
+				Quantity:         quantity,
+				PreviousOnHand:   previousOnHand,
+				NewOnHand:        previousOnHand + tt.onHandDelta,
+				PreviousReserved: previousReserved,
+				NewReserved:      previousReserved + tt.reservedDelta,
+			}
+			err := newLotLedger(lots).post(context.Background(), movement, received)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			onHand, reserved := make(map[string]int), make(map[string]int)
+			for _, lot := range lots.lots {
+				onHand[lot.LotNumber] = lot.QuantityOnHand
+				if lot.QuantityReserved != 0 {
+					reserved[lot.LotNumber] = lot.QuantityReserved
+				}
+			}
+			if !maps.Equal(onHand, tt.wantOnHand) {
+				t.Errorf("on hand: got %v, want %v", onHand, tt.wantOnHand)
+			}
+			if !maps.Equal(reserved, tt.wantReserved) {
+				t.Errorf("reserved: got %v, want %v", reserved, tt.wantReserved)
+			}
+			moved := make(map[string]int)
+			for _, m := range lots.movements {
+				moved[m.LotID] += m.Quantity
+			}
+			if !maps.Equal(moved, tt.wantMoved) {
+				t.Errorf("lot movements: got %v, want %v", moved, tt.wantMoved)
+			}
+		})
+	}
+}
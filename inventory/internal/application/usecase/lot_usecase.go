@@ -0,0 +1,62 @@
+// file: internal/application/usecase/lot_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// LotUseCase exposes lot balances and lot movements. Lots are created and moved by the
+// stock use cases through the lot ledger.
+type LotUseCase struct {
+	stockItems repository.StockItemRepository
+	lots       repository.LotRepository
+}
+
+// NewLotUseCase creates a new LotUseCase
+func NewLotUseCase(stockItems repository.StockItemRepository, lots repository.LotRepository) *LotUseCase {
+	return &LotUseCase{stockItems: stockItems, lots: lots}
+}
+
+// GetByID retrieves a lot by its ID
+func (uc *LotUseCase) GetByID(ctx context.Context, id string) (*entity.Lot, error) {
+	lot, err := uc.lots.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lot %s: %w", id, err)
+	}
+	return lot, nil
+}
+
+// List retrieves lots matching the filter, first expiring first, along with the total
+// match count
+func (uc *LotUseCase) List(ctx context.Context, filter repository.LotFilter) ([]*entity.Lot, int, error) {
+	lots, total, err := uc.lots.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list lots: %w", err)
+	}
+	return lots, total, nil
+}
+
+// ListByStockItem retrieves the lots of a stock item, first expiring first
+func (uc *LotUseCase) ListByStockItem(ctx context.Context, stockItemID string, filter repository.LotFilter) ([]*entity.Lot, int, error) {
+	if _, err := uc.stockItems.GetByID(ctx, stockItemID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get stock item %s: %w", stockItemID, err)
+	}
+	filter.StockItemID = &stockItemID
+	return uc.List(ctx, filter)
+}
+
+// GetMovements retrieves the movements of a lot, newest first, along with the total count
+func (uc *LotUseCase) GetMovements(ctx context.Context, lotID string, limit, offset int) ([]*entity.LotMovement, int, error) {
+	if _, err := uc.lots.GetByID(ctx, lotID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get lot %s: %w", lotID, err)
+	}
+	movements, total, err := uc.lots.GetMovements(ctx, lotID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list lot movements: %w", err)
+	}
+	return movements, total, nil
+}
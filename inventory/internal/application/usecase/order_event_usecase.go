@@ -0,0 +1,295 @@
+// file: internal/application/usecase/order_event_usecase.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// OrderServiceActor is recorded as the performer of stock changes driven by order events
+const OrderServiceActor = "order-service"
+
+// reservationRejections maps errors that reject an order's reservation, as opposed to
+// failing to process it, onto the failure reason published for them
+var reservationRejections = []struct {
+	err    error
+	reason string
+}{
+	{entity.ErrInsufficientStock, FailureReasonInsufficientStock},
+	{ErrProductNotStocked, FailureReasonProductNotStocked},
+	{ErrProductInactive, FailureReasonProductInactive},
+	{entity.ErrProductDeleted, FailureReasonProductInactive},
+	{repository.ErrNotFound, FailureReasonProductUnknown},
+	{entity.ErrReservationOrderRequired, FailureReasonInvalidOrder},
+	{entity.ErrReservationItemsRequired, FailureReasonInvalidOrder},
+	{entity.ErrReservationItemQuantity, FailureReasonInvalidOrder},
+	{ErrVariantRequired, FailureReasonInvalidOrder},
+}
+
+// OrderEventUseCase reacts to order lifecycle events from the Order Service:
+// stock is reserved when an order is created, released when it is cancelled and
+// permanently decremented when it is fulfilled.
+type OrderEventUseCase struct {
+	tx           port.TransactionManager
+	products     repository.ProductRepository
+	warehouses   repository.WarehouseRepository
+	reservations *ReservationUseCase
+	publisher    port.EventPublisher
+}
+
+// NewOrderEventUseCase creates a new OrderEventUseCase
+func NewOrderEventUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	reservations *ReservationUseCase,
+	publisher port.EventPublisher,
+) *OrderEventUseCase {
+	return &OrderEventUseCase{
+		tx:           tx,
+		products:     products,
+		warehouses:   warehouses,
+		reservations: reservations,
+		publisher:    publisher,
+	}
+}
+
+// HandleOrderCreated reserves stock for a new order and returns the outcome it published:
+// a StockReservedEvent, or a StockReservationFailedEvent when the order cannot be reserved,
+// in which case the event still counts as handled.
+func (uc *OrderEventUseCase) HandleOrderCreated(ctx context.Context, evt event.OrderCreatedEvent) ([]port.OutboxEntry, error) {
+	var outcome []port.OutboxEntry
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		active, err := uc.activeReservations(ctx, evt.OrderID)
+		if err != nil {
+			return err
+		}
+		if len(active) > 0 {
+			// Already reserved by an earlier delivery of this order
+			return nil
+		}
+
+		items, err := uc.reserveItems(ctx, evt)
+		if err == nil {
+			_, outcome, err = uc.reservations.reserve(ctx, ReserveInput{
+				OrderID:     evt.OrderID,
+				Items:       items,
+				PerformedBy: OrderServiceActor,
+			})
+		}
+		var unreserved *ReservationFailedError
+		if err == nil || errors.As(err, &unreserved) {
+			// reserve has published the lines it could not reserve
+			return nil
+		}
+
+		reason, rejected := rejectionReason(err)
+		if !rejected {
+			return err
+		}
+		failed, err := uc.publishReservationFailed(ctx, evt, reason)
+		if err != nil {
+			return err
+		}
+		outcome = []port.OutboxEntry{failed}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// HandleOrderCancelled releases every active reservation of the order, cancels its open
+// backorders and returns the StockReleasedEvents and BackorderCancelledEvents it published
+func (uc *OrderEventUseCase) HandleOrderCancelled(ctx context.Context, evt event.OrderCancelledEvent) ([]port.OutboxEntry, error) {
+	var outcome []port.OutboxEntry
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		active, err := uc.activeReservations(ctx, evt.OrderID)
+		if err != nil {
+			return err
+		}
+		reason := evt.CancellationReason
+		if reason == "" {
+			reason = "order cancelled"
+		}
+		for _, reservation := range active {
+			_, released, err := uc.reservations.release(ctx, reservation.ID, ReleaseInput{
+				Reason:      reason,
+				PerformedBy: OrderServiceActor,
+			})
+			if err != nil {
+				return err
+			}
+			outcome = append(outcome, released)
+		}
+		cancelled, err := uc.reservations.cancelBackorders(ctx, evt.OrderID)
+		outcome = append(outcome, cancelled...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// HandleOrderFulfilled fulfills every active reservation of the order and returns the
+// StockDecrementedEvents it published
+func (uc *OrderEventUseCase) HandleOrderFulfilled(ctx context.Context, evt event.OrderFulfilledEvent) ([]port.OutboxEntry, error) {
+	var outcome []port.OutboxEntry
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		active, err := uc.activeReservations(ctx, evt.OrderID)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range active {
+			_, fulfilled, err := uc.reservations.fulfill(ctx, reservation.ID, FulfillInput{
+				FulfilledBy: OrderServiceActor,
+				Notes:       "order fulfilled",
+			})
+			if err != nil {
+				return err
+			}
+			outcome = append(outcome, fulfilled)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// ReplayOutcome publishes the outcome of an already handled order event again, with the
+// original event IDs and payloads, so a redelivered event yields exactly the events the
+// first delivery did. Entries still in the outbox are not duplicated.
+func (uc *OrderEventUseCase) ReplayOutcome(ctx context.Context, outcome []port.OutboxEntry) error {
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, entry := range outcome {
+			// Each insert runs in its own savepoint so a conflict does not abort the rest
+			err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+				return uc.publisher.PublishToOutbox(ctx, entry)
+			})
+			if err != nil && !errors.Is(err, repository.ErrAlreadyExists) {
+				return fmt.Errorf("failed to replay %s to outbox: %w", entry.EventType, err)
+			}
+		}
+		return nil
+	})
+}
+
+// activeReservations returns the reservations of an order that still hold stock
+func (uc *OrderEventUseCase) activeReservations(ctx context.Context, orderID string) ([]*ReservationDetails, error) {
+	reservations, err := uc.reservations.ListByOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	var active []*ReservationDetails
+	for _, r := range reservations {
+		if r.IsActive() {
+			active = append(active, r)
+		}
+	}
+	return active, nil
+}
+
+// reserveItems converts order lines into reservation lines, resolving products by SKU
+// when the order does not carry product IDs
+func (uc *OrderEventUseCase) reserveItems(ctx context.Context, evt event.OrderCreatedEvent) ([]ReserveItemInput, error) {
+	items := make([]ReserveItemInput, 0, len(evt.Items))
+	for _, line := range evt.Items {
+		productID := line.ProductID
+		if productID == "" {
+			product, err := uc.products.GetBySKU(ctx, line.SKU)
+			if err != nil {
+				return nil, fmt.Errorf("product %s: %w", line.SKU, err)
+			}
+			productID = product.ID
+		}
+		items = append(items, ReserveItemInput{
+			ProductID:            productID,
+			Quantity:             line.Quantity,
+			PreferredWarehouseID: evt.WarehouseID,
+		})
+	}
+	return items, nil
+}
+
+// publishReservationFailed reports which order lines the active warehouses cannot cover
+func (uc *OrderEventUseCase) publishReservationFailed(ctx context.Context, evt event.OrderCreatedEvent, reason string) (port.OutboxEntry, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	meta := newEventMetadata(CorrelationIDFromContext(ctx))
+	failed := event.StockReservationFailedEvent{
+		EventID:       meta.EventID,
+		CorrelationID: meta.CorrelationID,
+		Timestamp:     meta.Timestamp,
+		Version:       meta.Version,
+		OrderID:       evt.OrderID,
+		FailureReason: reason,
+	}
+
+	for _, line := range evt.Items {
+		detail := event.StockReservationFailedDetail{
+			ProductID:         line.ProductID,
+			SKU:               line.SKU,
+			RequestedQuantity: line.Quantity,
+		}
+		product, err := uc.lookupProduct(ctx, loader, line)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			failed.FailedItems = append(failed.FailedItems, detail)
+			continue
+		case err != nil:
+			return port.OutboxEntry{}, err
+		}
+		detail.ProductID, detail.SKU = product.ID, product.SKU
+
+		available, err := uc.totalAvailable(ctx, loader, product.ID)
+		if err != nil {
+			return port.OutboxEntry{}, err
+		}
+		detail.AvailableQuantity = available
+		if available < line.Quantity || line.Quantity <= 0 {
+			failed.FailedItems = append(failed.FailedItems, detail)
+		}
+	}
+
+	return publishOutcome(ctx, uc.publisher, AggregateTypeOrder, failed, meta)
+}
+
+func (uc *OrderEventUseCase) lookupProduct(ctx context.Context, loader *referenceLoader, line event.OrderItemDetail) (*entity.Product, error) {
+	if line.ProductID != "" {
+		return loader.product(ctx, line.ProductID)
+	}
+	return uc.products.GetBySKU(ctx, line.SKU)
+}
+
+// totalAvailable returns the quantity of a product the active warehouses can reserve
+// together, which is what a reservation line split across them is limited to. For a
+// kit it is the kits the component stock of each warehouse makes up.
+func (uc *OrderEventUseCase) totalAvailable(ctx context.Context, loader *referenceLoader, productID string) (int, error) {
+	candidates, err := uc.reservations.allocator.candidates(ctx, loader, productID)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, c := range candidates {
+		total += c.Item.ReservableQuantity()
+	}
+	return total, nil
+}
+
+func rejectionReason(err error) (string, bool) {
+	for _, r := range reservationRejections {
+		if errors.Is(err, r.err) {
+			return r.reason, true
+		}
+	}
+	return "", false
+}
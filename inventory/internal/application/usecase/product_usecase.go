@@ -0,0 +1,415 @@
+// file: internal/application/usecase/product_usecase.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// CreateProductInput carries the data required to create a product
+type CreateProductInput struct {
+	SKU         string
+	Name        string
+	Description string
+	Category    string
+	Variants    []CreateVariantInput  // Created along with the product; optional
+	Components  []entity.KitComponent // Makes the product a kit of these products; optional
+	BaseUnit    string                // Unit of measure the product is stocked in; entity.DefaultBaseUnit when empty
+	MinStock    int
+	Serialized  bool
+}
+
+// CreateVariantInput carries the data required to add a variant to a base product
+type CreateVariantInput struct {
+	SKU     string
+	Variant entity.ProductVariant
+}
+
+// UpdateProductInput carries a partial product update; nil fields are left unchanged
+type UpdateProductInput struct {
+	Name        *string
+	Description *string
+	Category    *string
+	MinStock    *int
+}
+
+// ProductDetails is a product together with its stock totals across all warehouses
+type ProductDetails struct {
+	*entity.Product
+	Variants       []*entity.Product     // Live variants of a base product
+	Components     []entity.KitComponent // Composition of a kit
+	TotalOnHand    int
+	TotalReserved  int
+	TotalAvailable int
+}
+
+// ProductUseCase orchestrates product catalog operations
+type ProductUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	stockItems repository.StockItemRepository
+	kits       repository.KitRepository
+	units      repository.UnitOfMeasureRepository
+}
+
+// NewProductUseCase creates a new ProductUseCase
+func NewProductUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	stockItems repository.StockItemRepository,
+	kits repository.KitRepository,
+	units repository.UnitOfMeasureRepository,
+) *ProductUseCase {
+	return &ProductUseCase{
+		tx:         tx,
+		products:   products,
+		stockItems: stockItems,
+		kits:       kits,
+		units:      units,
+	}
+}
+
+// Create registers a new product and its variants, or a kit of the given components.
+// SKUs are unique across all live products and variants. Kit components are active
+// products stocked as they are: no kits, and variants rather than their base products.
+func (uc *ProductUseCase) Create(ctx context.Context, in CreateProductInput) (*ProductDetails, error) {
+	product, err := entity.NewProduct(uuid.NewString(), in.SKU, in.Name, in.Description, in.Category, entity.ProductVariant{}, in.MinStock)
+	if err != nil {
+		return nil, err
+	}
+	product.Serialized = in.Serialized
+	if in.BaseUnit != "" {
+		if product.BaseUnit, err = uc.catalogUnit(ctx, in.BaseUnit); err != nil {
+			return nil, err
+		}
+	}
+
+	var kit *entity.Kit
+	if len(in.Components) > 0 {
+		if len(in.Variants) > 0 {
+			return nil, entity.ErrKitVariants
+		}
+		if kit, err = entity.NewKit(product, in.Components); err != nil {
+			return nil, err
+		}
+		if err := uc.checkComponents(ctx, kit); err != nil {
+			return nil, err
+		}
+	}
+
+	variants := make([]*entity.Product, 0, len(in.Variants))
+	for _, v := range in.Variants {
+		variant, err := entity.NewProductVariant(uuid.NewString(), product, v.SKU, v.Variant)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+	if err := uc.checkUnique(ctx, nil, append([]*entity.Product{product}, variants...)); err != nil {
+		return nil, err
+	}
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.products.Create(ctx, product); err != nil {
+			return fmt.Errorf("failed to create product: %w", err)
+		}
+		for _, variant := range variants {
+			if err := uc.products.Create(ctx, variant); err != nil {
+				return fmt.Errorf("failed to create variant %s: %w", variant.SKU, err)
+			}
+		}
+		if kit != nil {
+			if err := uc.kits.Create(ctx, kit); err != nil {
+				return fmt.Errorf("failed to create kit components: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details := &ProductDetails{Product: product, Variants: variants}
+	if kit != nil {
+		details.Components = kit.Components
+	}
+	return details, nil
+}
+
+// AddVariant adds a variant to a base product and returns the base product. A product
+// stocked as is cannot take variants, as its stock would belong to none of them.
+func (uc *ProductUseCase) AddVariant(ctx context.Context, productID string, in CreateVariantInput) (*ProductDetails, error) {
+	base, err := uc.getActive(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if base.IsKit {
+		return nil, entity.ErrKitVariants
+	}
+	variant, err := entity.NewProductVariant(uuid.NewString(), base, in.SKU, in.Variant)
+	if err != nil {
+		return nil, err
+	}
+
+	_, stocked, err := uc.stockItems.List(ctx, repository.StockItemFilter{ProductID: &base.ID, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stock items for product %s: %w", base.ID, err)
+	}
+	if stocked > 0 {
+		return nil, ErrBaseProductStocked
+	}
+	siblings, err := uc.products.ListVariants(ctx, base.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variants of product %s: %w", base.ID, err)
+	}
+	if err := uc.checkUnique(ctx, siblings, []*entity.Product{variant}); err != nil {
+		return nil, err
+	}
+
+	if err := uc.products.Create(ctx, variant); err != nil {
+		return nil, fmt.Errorf("failed to create variant %s: %w", variant.SKU, err)
+	}
+	return uc.withStock(ctx, base)
+}
+
+// GetByID retrieves a product that has not been deleted
+func (uc *ProductUseCase) GetByID(ctx context.Context, id string) (*ProductDetails, error) {
+	product, err := uc.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withStock(ctx, product)
+}
+
+// List retrieves base products matching the filter along with the total match count
+func (uc *ProductUseCase) List(ctx context.Context, filter repository.ProductFilter) ([]*ProductDetails, int, error) {
+	products, total, err := uc.products.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list products: %w", err)
+	}
+
+	details := make([]*ProductDetails, 0, len(products))
+	for _, product := range products {
+		d, err := uc.withStock(ctx, product)
+		if err != nil {
+			return nil, 0, err
+		}
+		details = append(details, d)
+	}
+	return details, total, nil
+}
+
+// Update applies a partial update to a product. Name, description and category changes
+// of a base product carry over to its variants; a variant's own low-stock threshold is
+// the only detail that can be changed on it.
+func (uc *ProductUseCase) Update(ctx context.Context, id string, in UpdateProductInput) (*ProductDetails, error) {
+	product, err := uc.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, description, category, minStock := product.Name, product.Description, product.Category, product.MinStock
+	if in.Name != nil {
+		name = *in.Name
+	}
+	if in.Description != nil {
+		description = *in.Description
+	}
+	if in.Category != nil {
+		category = *in.Category
+	}
+	if in.MinStock != nil {
+		minStock = *in.MinStock
+	}
+
+	if err := product.Update(name, description, category, product.Variant, minStock); err != nil {
+		return nil, err
+	}
+	variants, err := uc.products.ListVariants(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variants of product %s: %w", product.ID, err)
+	}
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.products.Update(ctx, product); err != nil {
+			return fmt.Errorf("failed to update product: %w", err)
+		}
+		for _, variant := range variants {
+			variant.Inherit(product)
+			if err := uc.products.Update(ctx, variant); err != nil {
+				return fmt.Errorf("failed to update variant %s: %w", variant.SKU, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withStock(ctx, product)
+}
+
+// Delete soft deletes a product along with its variants
+func (uc *ProductUseCase) Delete(ctx context.Context, id string) error {
+	product, err := uc.getActive(ctx, id)
+	if err != nil {
+		return err
+	}
+	variants, err := uc.products.ListVariants(ctx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variants of product %s: %w", product.ID, err)
+	}
+
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, p := range append(variants, product) {
+			if err := p.SoftDelete(); err != nil {
+				return err
+			}
+			if err := uc.products.Delete(ctx, p.ID); err != nil {
+				return fmt.Errorf("failed to delete product: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (uc *ProductUseCase) getActive(ctx context.Context, id string) (*entity.Product, error) {
+	product, err := uc.products.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %s: %w", id, err)
+	}
+	if product.IsDeleted() {
+		return nil, fmt.Errorf("product %s: %w", id, repository.ErrNotFound)
+	}
+	return product, nil
+}
+
+// catalogUnit returns the code of a unit of measure in the catalog
+func (uc *ProductUseCase) catalogUnit(ctx context.Context, code string) (string, error) {
+	code, err := entity.NormalizeUnitCode(code)
+	if err != nil {
+		return "", err
+	}
+	if _, err := uc.units.GetByCode(ctx, code); err != nil {
+		return "", fmt.Errorf("failed to get unit of measure %s: %w", code, err)
+	}
+	return code, nil
+}
+
+// checkComponents verifies that the components of a new kit can be stocked and reserved
+func (uc *ProductUseCase) checkComponents(ctx context.Context, kit *entity.Kit) error {
+	for _, c := range kit.Components {
+		component, err := uc.getActive(ctx, c.ProductID)
+		if err != nil {
+			return err
+		}
+		if !component.IsActive {
+			return fmt.Errorf("component %s: %w", component.SKU, ErrProductInactive)
+		}
+		if component.IsKit {
+			return fmt.Errorf("component %s: %w", component.SKU, entity.ErrKitComponentKit)
+		}
+		if _, err := stockedProduct(ctx, uc.products, component, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkUnique verifies that new products use SKUs no live product or other new product
+// uses, and that new variants differ from their siblings in size or color
+func (uc *ProductUseCase) checkUnique(ctx context.Context, siblings, products []*entity.Product) error {
+	skus := make(map[string]bool)
+	attributes := make(map[entity.ProductVariant]bool)
+	for _, sibling := range siblings {
+		attributes[sibling.Variant] = true
+	}
+
+	for _, p := range products {
+		if skus[p.SKU] {
+			return fmt.Errorf("SKU %s: %w", p.SKU, ErrProductSKUExists)
+		}
+		skus[p.SKU] = true
+		exists, err := uc.products.ExistsBySKU(ctx, p.SKU)
+		if err != nil {
+			return fmt.Errorf("failed to check SKU uniqueness: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("SKU %s: %w", p.SKU, ErrProductSKUExists)
+		}
+
+		if p.IsVariant() {
+			if attributes[p.Variant] {
+				return fmt.Errorf("variant %s: %w", p.SKU, ErrVariantExists)
+			}
+			attributes[p.Variant] = true
+		}
+	}
+	return nil
+}
+
+func (uc *ProductUseCase) withStock(ctx context.Context, product *entity.Product) (*ProductDetails, error) {
+	details := &ProductDetails{Product: product}
+
+	variants, err := uc.products.ListVariants(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variants of product %s: %w", product.ID, err)
+	}
+	details.Variants = variants
+
+	if product.IsKit {
+		kit, err := uc.kits.GetByProductID(ctx, product.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kit %s: %w", product.ID, err)
+		}
+		details.Components = kit.Components
+	}
+
+	stock, err := uc.stockItems.GetAggregatedStock(ctx, product.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return details, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate stock for product %s: %w", product.ID, err)
+	}
+
+	details.TotalOnHand = stock.TotalOnHand
+	details.TotalReserved = stock.TotalReserved
+	details.TotalAvailable = stock.TotalAvailable
+	return details, nil
+}
+
+// stockedProduct returns the product whose stock is kept for product: the variant named
+// by variantSKU, or else product itself. A base product with variants is only stocked
+// and reserved through its variants.
+func stockedProduct(ctx context.Context, products repository.ProductRepository, product *entity.Product, variantSKU string) (*entity.Product, error) {
+	if variantSKU == "" {
+		if product.IsVariant() {
+			return product, nil
+		}
+		variants, err := products.ListVariants(ctx, product.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list variants of product %s: %w", product.ID, err)
+		}
+		if len(variants) > 0 {
+			return nil, fmt.Errorf("product %s: %w", product.SKU, ErrVariantRequired)
+		}
+		return product, nil
+	}
+
+	variant, err := products.GetBySKU(ctx, variantSKU)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variant %s: %w", variantSKU, err)
+	}
+	if variant.ID != product.ID && variant.ParentID != product.ID {
+		return nil, fmt.Errorf("variant %s: %w", variantSKU, ErrVariantNotOfProduct)
+	}
+	return variant, nil
+}
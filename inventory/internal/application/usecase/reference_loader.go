@@ -0,0 +1,79 @@
+// file: internal/application/usecase/reference_loader.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// referenceLoader memoizes product and warehouse lookups for the duration of one use case call
+type referenceLoader struct {
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+
+	productCache   map[string]*entity.Product
+	warehouseCache map[string]*entity.Warehouse
+}
+
+func newReferenceLoader(products repository.ProductRepository, warehouses repository.WarehouseRepository) *referenceLoader {
+	return &referenceLoader{
+		products:       products,
+		warehouses:     warehouses,
+		productCache:   make(map[string]*entity.Product),
+		warehouseCache: make(map[string]*entity.Warehouse),
+	}
+}
+
+func (l *referenceLoader) product(ctx context.Context, id string) (*entity.Product, error) {
+	if p, ok := l.productCache[id]; ok {
+		return p, nil
+	}
+	p, err := l.products.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product %s: %w", id, err)
+	}
+	l.productCache[id] = p
+	return p, nil
+}
+
+func (l *referenceLoader) warehouse(ctx context.Context, id string) (*entity.Warehouse, error) {
+	if w, ok := l.warehouseCache[id]; ok {
+		return w, nil
+	}
+	w, err := l.warehouses.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load warehouse %s: %w", id, err)
+	}
+	l.warehouseCache[id] = w
+	return w, nil
+}
+
+// stockItemDetails enriches a stock item with its product and warehouse names
+func (l *referenceLoader) stockItemDetails(ctx context.Context, item *entity.StockItem) (*StockItemDetails, error) {
+	product, err := l.product(ctx, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	warehouse, err := l.warehouse(ctx, item.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	return &StockItemDetails{
+		StockItem:     item,
+		SKU:           product.SKU,
+		VariantSKU:    variantSKU(product),
+		ProductName:   product.Name,
+		WarehouseName: warehouse.Name,
+	}, nil
+}
+
+// variantSKU returns the SKU of a product that is a variant, and an empty string otherwise
+func variantSKU(product *entity.Product) string {
+	if product.IsVariant() {
+		return product.SKU
+	}
+	return ""
+}
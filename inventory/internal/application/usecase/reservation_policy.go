@@ -0,0 +1,130 @@
+// file: internal/application/usecase/reservation_policy.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+)
+
+// ReservationPolicy decides what happens when only some requested lines can be reserved
+type ReservationPolicy string
+
+const (
+	// PolicyAllOrNothing reserves every line in full or nothing at all
+	PolicyAllOrNothing ReservationPolicy = "ALL_OR_NOTHING"
+	// PolicyPartialAllowed reserves what is available and drops the rest
+	PolicyPartialAllowed ReservationPolicy = "PARTIAL_ALLOWED"
+	// PolicyBackorderRemainder reserves what is available and backorders the rest
+	PolicyBackorderRemainder ReservationPolicy = "BACKORDER_REMAINDER"
+)
+
+// Reservation failure reasons published on StockReservationFailedEvent
+const (
+	FailureReasonInsufficientStock = "INSUFFICIENT_STOCK"
+	FailureReasonProductNotStocked = "PRODUCT_NOT_STOCKED"
+	FailureReasonProductUnknown    = "PRODUCT_NOT_FOUND"
+	FailureReasonProductInactive   = "PRODUCT_INACTIVE"
+	FailureReasonInvalidOrder      = "INVALID_ORDER"
+	FailureReasonPartiallyReserved = "PARTIALLY_RESERVED"
+	FailureReasonBackordered       = "BACKORDERED"
+)
+
+// ReservationShortfall is a requested line that could not be reserved in full
+type ReservationShortfall struct {
+	Line      int // Index of the line in the request
+	ProductID string
+	SKU       string
+	Requested int
+	Reserved  int
+	Available int
+	Cause     error // entity.ErrInsufficientStock or ErrProductNotStocked
+}
+
+// ReservationFailedError reports the lines that kept a reservation from being made.
+// It matches the causes of its lines with errors.Is.
+type ReservationFailedError struct {
+	OrderID    string
+	Shortfalls []ReservationShortfall
+}
+
+func (e *ReservationFailedError) Error() string {
+	parts := make([]string, 0, len(e.Shortfalls))
+	for _, s := range e.Shortfalls {
+		parts = append(parts, fmt.Sprintf("product %s: requested %d, available %d", s.ProductID, s.Requested, s.Available))
+	}
+	return "reservation failed: " + strings.Join(parts, "; ")
+}
+
+// Unwrap returns the distinct causes of the failed lines
+func (e *ReservationFailedError) Unwrap() []error {
+	var causes []error
+	for _, s := range e.Shortfalls {
+		if !containsError(causes, s.Cause) {
+			causes = append(causes, s.Cause)
+		}
+	}
+	return causes
+}
+
+// reason returns the failure reason published for the reservation
+func (e *ReservationFailedError) reason() string {
+	if errors.Is(e, ErrProductNotStocked) && !errors.Is(e, entity.ErrInsufficientStock) {
+		return FailureReasonProductNotStocked
+	}
+	return FailureReasonInsufficientStock
+}
+
+func containsError(errs []error, err error) bool {
+	for _, e := range errs {
+		if e == err {
+			return true
+		}
+	}
+	return false
+}
+
+// parseReservationPolicy validates a policy, defaulting to PolicyAllOrNothing
+func parseReservationPolicy(policy ReservationPolicy) (ReservationPolicy, error) {
+	switch policy {
+	case "":
+		return PolicyAllOrNothing, nil
+	case PolicyAllOrNothing, PolicyPartialAllowed, PolicyBackorderRemainder:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownReservationPolicy, policy)
+	}
+}
+
+// publishShortfalls publishes a StockReservationFailedEvent listing the lines of an
+// order that were not reserved in full
+func publishShortfalls(
+	ctx context.Context,
+	publisher port.EventPublisher,
+	orderID, reason string,
+	shortfalls []ReservationShortfall,
+) (port.OutboxEntry, error) {
+	meta := newEventMetadata(CorrelationIDFromContext(ctx))
+	evt := event.StockReservationFailedEvent{
+		EventID:       meta.EventID,
+		CorrelationID: meta.CorrelationID,
+		Timestamp:     meta.Timestamp,
+		Version:       meta.Version,
+		OrderID:       orderID,
+		FailureReason: reason,
+	}
+	for _, s := range shortfalls {
+		evt.FailedItems = append(evt.FailedItems, event.StockReservationFailedDetail{
+			ProductID:         s.ProductID,
+			SKU:               s.SKU,
+			RequestedQuantity: s.Requested,
+			AvailableQuantity: s.Available,
+		})
+	}
+	return publishOutcome(ctx, publisher, AggregateTypeOrder, evt, meta)
+}
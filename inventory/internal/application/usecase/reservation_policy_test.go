@@ -0,0 +1,208 @@
+// file: internal/application/usecase/reservation_policy_test.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+type fakeBackorders struct {
+	repository.BackorderRepository
+	backorders []*entity.Backorder
+}
+
+func (r *fakeBackorders) Create(_ context.Context, b *entity.Backorder) error {
+	r.backorders = append(r.backorders, b)
+	return nil
+}
+
+func TestReservePolicies(t *testing.T) {
+	var (
+		reserved    = event.StockReservedEvent{}.EventName()
+		failed      = event.StockReservationFailedEvent{}.EventName()
+		backordered = event.BackorderCreatedEvent{}.EventName()
+	)
+	// product-1 has 5 in stock, product-2 has 10 and product-3 is stocked nowhere
+	short := []ReserveItemInput{{ProductID: "product-1", Quantity: 8}, {ProductID: "product-2", Quantity: 4}}
+	notStocked := []ReserveItemInput{{ProductID: "product-3", Quantity: 2}}
+
+	tests := []struct {
+		name           string
+		policy         ReservationPolicy
+		items          []ReserveItemInput
+		wantFailed     bool // Reserve returns a *ReservationFailedError
+		wantShortfalls []ReservationShortfall
+		wantReserved   map[string]int // Reserved of each stock item; noTx does not roll failures back
+		wantBackorders []string       // Product and quantity of each backorder
+		wantEvents     []string
+		wantReason     string // Of the StockReservationFailedEvent
+	}{
+		{
+			name:         "all or nothing, every line available",
+			policy:       PolicyAllOrNothing,
+			items:        []ReserveItemInput{{ProductID: "product-1", Quantity: 5}, {ProductID: "product-2", Quantity: 4}},
+			wantReserved: map[string]int{"item-1": 5, "item-2": 4},
+			wantEvents:   []string{reserved},
+		},
+		{
+			name:       "all or nothing, a line short",
+			items:      short,
+			wantFailed: true,
+			wantShortfalls: []ReservationShortfall{
+				{Line: 0, ProductID: "product-1", Requested: 8, Reserved: 0, Available: 5, Cause: entity.ErrInsufficientStock},
+			},
+			wantEvents: []string{failed},
+			wantReason: FailureReasonInsufficientStock,
+		},
+		{
+			name:   "partial, a line short",
+			policy: PolicyPartialAllowed,
+			items:  short,
+			wantShortfalls: []ReservationShortfall{
+				{Line: 0, ProductID: "product-1", Requested: 8, Reserved: 5, Available: 5, Cause: entity.ErrInsufficientStock},
+			},
+			wantReserved: map[string]int{"item-1": 5, "item-2": 4},
+			wantEvents:   []string{reserved, failed},
+			wantReason:   FailureReasonPartiallyReserved,
+		},
+		{
+			name:       "partial, nothing reservable",
+			policy:     PolicyPartialAllowed,
+			items:      notStocked,
+			wantFailed: true,
+			wantShortfalls: []ReservationShortfall{
+				{Line: 0, ProductID: "product-3", Requested: 2, Cause: ErrProductNotStocked},
+			},
+			wantEvents: []string{failed},
+			wantReason: FailureReasonProductNotStocked,
+		},
+		{
+			name:   "backorder remainder, a line short",
+			policy: PolicyBackorderRemainder,
+			items:  short,
+			wantShortfalls: []ReservationShortfall{
+				{Line: 0, ProductID: "product-1", Requested: 8, Reserved: 5, Available: 5, Cause: entity.ErrInsufficientStock},
+			},
+			wantReserved:   map[string]int{"item-1": 5, "item-2": 4},
+			wantBackorders: []string{"product-1:3"},
+			wantEvents:     []string{reserved, backordered, failed},
+			wantReason:     FailureReasonBackordered,
+		},
+		{
+			name:   "backorder remainder, nothing reservable",
+			policy: PolicyBackorderRemainder,
+			items:  notStocked,
+			wantShortfalls: []ReservationShortfall{
+				{Line: 0, ProductID: "product-3", Requested: 2, Cause: ErrProductNotStocked},
+			},
+			wantReserved:   map[string]int{"item-1": 0, "item-2": 0},
+			wantBackorders: []string{"product-3:2"},
+			wantEvents:     []string{backordered, failed},
+			wantReason:     FailureReasonBackordered,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := &fakeProducts{products: map[string]*entity.Product{
+				"product-1": {ID: "product-1", SKU: "SKU-1"},
+				"product-2": {ID: "product-2", SKU: "SKU-2"},
+				"product-3": {ID: "product-3", SKU: "SKU-3"},
+			}}
+			warehouses := &fakeWarehouses{warehouses: map[string]*entity.Warehouse{
+				"wh-1": {ID: "wh-1", Code: "WH-1", IsActive: true},
+			}}
+			stockItems := &casStockItems{items: map[string]*entity.StockItem{
+				"item-1": {ID: "item-1", ProductID: "product-1", WarehouseID: "wh-1", QuantityOnHand: 5},
+				"item-2": {ID: "item-2", ProductID: "product-2", WarehouseID: "wh-1", QuantityOnHand: 10},
+			}}
+			reservations := &fakeReservations{}
+			backorders := &fakeBackorders{}
+			kits := fakeKits{}
+			uc := NewReservationUseCase(noTx{}, products, warehouses, stockItems, fakeMovements{}, fakeLots{}, nil, nil,
+				kits, nil, reservations, backorders, discardPublisher{}, NewAllocator(products, warehouses, stockItems, kits),
+				DefaultReservationTTLPolicy(), RetryPolicy{MaxAttempts: 1})
+
+			result, outcome, err := uc.reserve(context.Background(), ReserveInput{
+				OrderID: "order-1",
+				Items:   tt.items,
+				Policy:  tt.policy,
+			})
+			var shortfalls []ReservationShortfall
+			var failedErr *ReservationFailedError
+			switch {
+			case tt.wantFailed:
+				if !errors.As(err, &failedErr) {
+					t.Fatalf("error: got %v, want a *ReservationFailedError", err)
+				}
+				shortfalls = failedErr.Shortfalls
+				if len(reservations.reservations) != 0 {
+					t.Errorf("stored reservations: got %d, want none", len(reservations.reservations))
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			default:
+				shortfalls = result.Shortfalls
+				wantReservation := tt.wantReserved["item-1"]+tt.wantReserved["item-2"] > 0
+				if (result.Reservation != nil) != wantReservation {
+					t.Errorf("reservation: got %v, want one: %v", result.Reservation, wantReservation)
+				}
+			}
+
+			if len(shortfalls) != len(tt.wantShortfalls) {
+				t.Fatalf("shortfalls: got %+v, want %+v", shortfalls, tt.wantShortfalls)
+			}
+			for i, want := range tt.wantShortfalls {
+				got := shortfalls[i]
+				if got.Line != want.Line || got.ProductID != want.ProductID || got.Requested != want.Requested ||
+					got.Reserved != want.Reserved || got.Available != want.Available || !errors.Is(got.Cause, want.Cause) {
+					t.Errorf("shortfall %d: got %+v, want %+v", i, got, want)
+				}
+			}
+
+			for id, want := range tt.wantReserved {
+				item, err := stockItems.GetByID(context.Background(), id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if item.QuantityReserved != want {
+					t.Errorf("reserved of %s: got %d, want %d", id, item.QuantityReserved, want)
+				}
+			}
+
+			var gotBackorders []string
+			for _, b := range backorders.backorders {
+				gotBackorders = append(gotBackorders, fmt.Sprintf("%s:%d", b.ProductID, b.Quantity))
+			}
+			if !slices.Equal(gotBackorders, tt.wantBackorders) {
+				t.Errorf("backorders: got %v, want %v", gotBackorders, tt.wantBackorders)
+			}
+
+			var gotEvents []string
+			var reason string
+			for _, entry := range outcome {
+				gotEvents = append(gotEvents, entry.EventType)
+				if entry.EventType == failed {
+					var evt event.StockReservationFailedEvent
+					if err := json.Unmarshal(entry.Payload, &evt); err != nil {
+						t.Fatal(err)
+					}
+					reason = evt.FailureReason
+				}
+			}
+			if !slices.Equal(gotEvents, tt.wantEvents) {
+				t.Errorf("events: got %v, want %v", gotEvents, tt.wantEvents)
+			}
+			if reason != tt.wantReason {
+				t.Errorf("failure reason: got %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// file: internal/application/usecase/reservation_ttl.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inventory-service/internal/domain/event"
+)
+
+// ReservationTTLPolicy bounds how long reservations hold stock. A reservation's
+// lifetime is measured from its creation; extending it beyond the limit of every role
+// of the caller fails with ErrReservationLifetimeExceeded.
+type ReservationTTLPolicy struct {
+	DefaultTTL         time.Duration            // Hold when no expiry is requested, and on renewal
+	MaxLifetime        map[string]time.Duration // Lifetime limit per role
+	DefaultMaxLifetime time.Duration            // Limit for callers none of whose roles is listed
+}
+
+// DefaultReservationTTLPolicy returns the TTL policy used when none is configured
+func DefaultReservationTTLPolicy() ReservationTTLPolicy {
+	return ReservationTTLPolicy{
+		DefaultTTL:         DefaultReservationTTL,
+		DefaultMaxLifetime: time.Hour,
+	}
+}
+
+// maxLifetime returns the most generous lifetime limit among roles
+func (p ReservationTTLPolicy) maxLifetime(roles []string) time.Duration {
+	limit, listed := time.Duration(0), false
+	for _, role := range roles {
+		if l, ok := p.MaxLifetime[role]; ok {
+			limit, listed = max(limit, l), true
+		}
+	}
+	if !listed {
+		return p.DefaultMaxLifetime
+	}
+	return limit
+}
+
+// ExtendInput carries the data required to extend a reservation. ExpiresAt takes
+// precedence over ExtendBy; with neither the hold is renewed for the default TTL
+// from now.
+type ExtendInput struct {
+	ExpiresAt   *time.Time
+	ExtendBy    time.Duration // Added to the current expiry
+	Roles       []string      // Roles of the caller, which bound the reservation's lifetime
+	PerformedBy string
+}
+
+// Extend moves the expiry of an active reservation that has not expired yet to a later
+// time and publishes a StockReservationExtendedEvent
+func (uc *ReservationUseCase) Extend(ctx context.Context, id string, in ExtendInput) (*ReservationDetails, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *ReservationDetails
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Locked so an expiry that releases the stock in the meantime is seen, not overwritten
+		reservation, err := uc.reservations.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get reservation %s: %w", id, err)
+		}
+
+		previous := reservation.ExpiresAt
+		expiresAt := time.Now().UTC().Add(uc.ttl.DefaultTTL)
+		switch {
+		case in.ExpiresAt != nil:
+			expiresAt = in.ExpiresAt.UTC()
+		case in.ExtendBy > 0:
+			expiresAt = previous.Add(in.ExtendBy)
+		}
+		if limit := uc.ttl.maxLifetime(in.Roles); expiresAt.Sub(reservation.CreatedAt) > limit {
+			return fmt.Errorf("%w: reservations may be held for at most %s", ErrReservationLifetimeExceeded, limit)
+		}
+		if err := reservation.Extend(expiresAt); err != nil {
+			return err
+		}
+		if err := uc.reservations.Update(ctx, reservation); err != nil {
+			return fmt.Errorf("failed to update reservation: %w", err)
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.StockReservationExtendedEvent{
+			EventID:           meta.EventID,
+			CorrelationID:     meta.CorrelationID,
+			Timestamp:         meta.Timestamp,
+			Version:           meta.Version,
+			ReservationID:     reservation.ID,
+			OrderID:           reservation.OrderID,
+			PreviousExpiresAt: previous,
+			ExpiresAt:         reservation.ExpiresAt,
+			ExtendedBy:        in.PerformedBy,
+		}
+		if _, err := publishOutcome(ctx, uc.publisher, AggregateTypeReservation, evt, meta); err != nil {
+			return err
+		}
+
+		result, err = uc.withDetails(ctx, loader, reservation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
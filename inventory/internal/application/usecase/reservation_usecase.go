@@ -0,0 +1,1016 @@
+// file: internal/application/usecase/reservation_usecase.go
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// DefaultReservationTTL is how long a reservation holds stock when no expiry is requested
+// and no TTL is configured
+const DefaultReservationTTL = 15 * time.Minute
+
+// SystemActor is recorded as the performer of stock changes the service makes on its own
+const SystemActor = "system"
+
+// ReleaseReasonExpired is the release reason recorded when a reservation expires
+const ReleaseReasonExpired = "reservation expired"
+
+// ReserveItemInput describes one product line to reserve
+type ReserveItemInput struct {
+	ProductID            string
+	VariantSKU           string // Variant of the product to reserve; required for products with variants
+	Quantity             int
+	Unit                 string // Unit of measure of Quantity; the product's base unit when empty
+	PreferredWarehouseID string
+}
+
+// ReserveInput carries the data required to reserve stock for an order.
+// Strategy, Destination and NoSplit are passed on to the Allocator for every line.
+type ReserveInput struct {
+	OrderID     string
+	Items       []ReserveItemInput
+	ExpiresAt   *time.Time
+	PerformedBy string
+	Policy      ReservationPolicy // Empty means PolicyAllOrNothing
+	Priority    int               // Queue priority of backorders made under PolicyBackorderRemainder
+	Strategy    string
+	Destination *entity.WarehouseAddress
+	NoSplit     bool
+}
+
+// ReleaseInput carries the data required to release a reservation
+type ReleaseInput struct {
+	Reason      string
+	PerformedBy string
+	Items       []ReleaseItemInput // Empty releases everything the reservation still holds
+}
+
+// ReleaseItemInput is a quantity of one product to release from a reservation
+type ReleaseItemInput struct {
+	ProductID string
+	Quantity  int
+}
+
+// FulfillInput carries the data required to fulfill a reservation. With a ShipmentID
+// it records one shipment of Items, or of everything still held when Items is empty;
+// without one it fulfills everything still held.
+type FulfillInput struct {
+	ShipmentID  string
+	FulfilledBy string
+	Notes       string
+	Items       []ShipmentItemInput
+}
+
+// ShipmentItemInput is a quantity of one product shipped from a reservation
+type ShipmentItemInput struct {
+	ProductID     string
+	Quantity      int
+	SerialNumbers []string // Units shipped; required for serialized products
+}
+
+// ReservationItemDetails is a reservation line enriched with product and warehouse names
+type ReservationItemDetails struct {
+	entity.ReservationItem
+	SKU              string
+	VariantSKU       string // SKU of the variant reserved; empty for products without variants
+	ProductName      string
+	WarehouseName    string
+	AllocationReason string // Why the line was drawn from its warehouse; set by Reserve only
+}
+
+// ReservationDetails is a reservation together with its enriched lines.
+// Allocations, Shortfalls and Backorders are only set by Reserve; Reservation is nil
+// when the backorder-remainder policy could reserve nothing and backordered every line.
+type ReservationDetails struct {
+	*entity.Reservation
+	ItemDetails []ReservationItemDetails
+	Allocations []AllocationPlan // One plan per requested line
+	Shortfalls  []ReservationShortfall
+	Backorders  []*entity.Backorder
+	Picks       []BinPick // Bins the stock is picked from; set by Fulfill for what it shipped and by Picks
+}
+
+// ReservationUseCase orchestrates the reservation lifecycle: reserve, release and fulfill
+type ReservationUseCase struct {
+	tx           port.TransactionManager
+	products     repository.ProductRepository
+	warehouses   repository.WarehouseRepository
+	stockItems   repository.StockItemRepository
+	movements    repository.StockMovementRepository
+	lots         *lotLedger
+	serials      *serialLedger
+	bins         *binLedger
+	kits         *kitStock
+	units        *unitConverter
+	reservations repository.ReservationRepository
+	backorders   repository.BackorderRepository
+	publisher    port.EventPublisher
+	allocator    *Allocator
+	ttl          ReservationTTLPolicy
+	retry        RetryPolicy
+}
+
+// NewReservationUseCase creates a new ReservationUseCase
+func NewReservationUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	lots repository.LotRepository,
+	serials repository.SerialUnitRepository,
+	locations repository.LocationRepository,
+	kits repository.KitRepository,
+	units repository.UnitOfMeasureRepository,
+	reservations repository.ReservationRepository,
+	backorders repository.BackorderRepository,
+	publisher port.EventPublisher,
+	allocator *Allocator,
+	ttl ReservationTTLPolicy,
+	retry RetryPolicy,
+) *ReservationUseCase {
+	return &ReservationUseCase{
+		tx:           tx,
+		products:     products,
+		warehouses:   warehouses,
+		stockItems:   stockItems,
+		movements:    movements,
+		lots:         newLotLedger(lots),
+		serials:      newSerialLedger(serials),
+		bins:         newBinLedger(locations),
+		kits:         newKitStock(kits, stockItems),
+		units:        newUnitConverter(units),
+		reservations: reservations,
+		backorders:   backorders,
+		publisher:    publisher,
+		allocator:    allocator,
+		ttl:          ttl,
+		retry:        retry,
+	}
+}
+
+// Reserve holds stock for the requested lines of an order, splitting a line across
+// warehouses as the allocation strategy decides. The policy decides what happens to
+// lines that cannot be reserved in full: with all-or-nothing no line is reserved, with
+// partial-allowed the rest is dropped and with backorder-remainder it is backordered.
+// Lines not reserved in full are published on a StockReservationFailedEvent; when
+// nothing is reserved the error is a *ReservationFailedError.
+func (uc *ReservationUseCase) Reserve(ctx context.Context, in ReserveInput) (*ReservationDetails, error) {
+	result, _, err := uc.reserve(ctx, in)
+	return result, err
+}
+
+// reserve implements Reserve and also returns the events it published: a
+// StockReservedEvent when anything was reserved and a StockReservationFailedEvent when
+// any line was not reserved in full
+func (uc *ReservationUseCase) reserve(ctx context.Context, in ReserveInput) (*ReservationDetails, []port.OutboxEntry, error) {
+	policy, err := parseReservationPolicy(in.Policy)
+	if err != nil {
+		return nil, nil, err
+	}
+	expiresAt := time.Now().UTC().Add(uc.ttl.DefaultTTL)
+	if in.ExpiresAt != nil {
+		if !in.ExpiresAt.After(time.Now().UTC()) {
+			return nil, nil, ErrExpiryInPast
+		}
+		expiresAt = in.ExpiresAt.UTC()
+	}
+
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+	reservationID := uuid.NewString()
+
+	// A line naming a variant reserves the variant, and a line in another unit the base
+	// units its quantity converts to
+	in.Items = slices.Clone(in.Items)
+	for i, line := range in.Items {
+		product, err := loader.product(ctx, line.ProductID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if product, err = stockedProduct(ctx, uc.products, product, line.VariantSKU); err != nil {
+			return nil, nil, err
+		}
+		quantity, err := uc.units.toBase(ctx, product, line.Quantity, line.Unit)
+		if err != nil {
+			return nil, nil, err
+		}
+		in.Items[i].ProductID, in.Items[i].VariantSKU = product.ID, ""
+		in.Items[i].Quantity, in.Items[i].Unit = quantity, ""
+	}
+
+	var result *ReservationDetails
+	var outcome []port.OutboxEntry
+	err = withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		outcome = nil
+		items := make([]entity.ReservationItem, 0, len(in.Items))
+		details := make([]ReservationItemDetails, 0, len(in.Items))
+		plans := make([]AllocationPlan, 0, len(in.Items))
+		var shortfalls []ReservationShortfall
+
+		for i, line := range in.Items {
+			plan, stocked, err := uc.plan(ctx, loader, in, line)
+			if err != nil {
+				return err
+			}
+			plans = append(plans, *plan)
+
+			if plan.Shortfall() > 0 {
+				product, err := loader.product(ctx, line.ProductID)
+				if err != nil {
+					return err
+				}
+				shortfall := ReservationShortfall{
+					Line:      i,
+					ProductID: line.ProductID,
+					SKU:       product.SKU,
+					Requested: line.Quantity,
+					Reserved:  plan.Allocated(),
+					Available: plan.Available,
+					Cause:     entity.ErrInsufficientStock,
+				}
+				if !stocked {
+					shortfall.Cause = ErrProductNotStocked
+				}
+				if policy == PolicyAllOrNothing {
+					// Keep checking the remaining lines so all failures are reported
+					shortfall.Reserved = 0
+					shortfalls = append(shortfalls, shortfall)
+					continue
+				}
+				shortfalls = append(shortfalls, shortfall)
+			}
+
+			for _, alloc := range plan.Lines {
+				// A kit reserves each of its components in the warehouse allocated
+				for _, part := range alloc.parts() {
+					itemDetails, err := loader.stockItemDetails(ctx, part.item)
+					if err != nil {
+						return err
+					}
+					quantity := alloc.Quantity * part.quantity
+					if err := uc.applyMovement(ctx, loader, itemDetails, entity.MovementTypeReservation, quantity,
+						reservationID, alloc.Reason, in.PerformedBy, correlationID, nil); err != nil {
+						return err
+					}
+
+					reservationItem := entity.ReservationItem{
+						StockItemID: part.item.ID,
+						ProductID:   part.item.ProductID,
+						WarehouseID: alloc.WarehouseID,
+						Quantity:    quantity,
+					}
+					if part.item.ProductID != line.ProductID {
+						reservationItem.KitID = line.ProductID
+					}
+					items = append(items, reservationItem)
+					d := itemDetailsFor(reservationItem, itemDetails)
+					d.AllocationReason = alloc.Reason
+					details = append(details, d)
+				}
+			}
+		}
+
+		if len(shortfalls) > 0 && (policy == PolicyAllOrNothing || (policy == PolicyPartialAllowed && len(items) == 0)) {
+			return &ReservationFailedError{OrderID: in.OrderID, Shortfalls: shortfalls}
+		}
+
+		result = &ReservationDetails{Allocations: plans, Shortfalls: shortfalls}
+		if len(items) > 0 {
+			reservation, err := entity.NewReservation(reservationID, in.OrderID, items, expiresAt)
+			if err != nil {
+				return err
+			}
+			if err := uc.reservations.Create(ctx, reservation); err != nil {
+				return fmt.Errorf("failed to create reservation: %w", err)
+			}
+			reserved, err := uc.publishReserved(ctx, reservation, details, correlationID)
+			if err != nil {
+				return err
+			}
+			outcome = append(outcome, reserved)
+			result.Reservation, result.ItemDetails = reservation, details
+		}
+
+		if len(shortfalls) == 0 {
+			return nil
+		}
+		reason := FailureReasonPartiallyReserved
+		if policy == PolicyBackorderRemainder {
+			reason = FailureReasonBackordered
+			var queued []port.OutboxEntry
+			if result.Backorders, queued, err = uc.backorder(ctx, loader, in, shortfalls); err != nil {
+				return err
+			}
+			outcome = append(outcome, queued...)
+		}
+		failed, err := publishShortfalls(ctx, uc.publisher, in.OrderID, reason, shortfalls)
+		if err != nil {
+			return err
+		}
+		outcome = append(outcome, failed)
+		return nil
+	})
+
+	var failed *ReservationFailedError
+	if errors.As(err, &failed) {
+		// Nothing was reserved; report which lines could not be
+		pubErr := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			entry, err := publishShortfalls(ctx, uc.publisher, in.OrderID, failed.reason(), failed.Shortfalls)
+			outcome = []port.OutboxEntry{entry}
+			return err
+		})
+		if pubErr != nil {
+			return nil, nil, errors.Join(err, pubErr)
+		}
+		return nil, outcome, err
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, outcome, nil
+}
+
+// plan allocates one requested line. A product no active warehouse stocks yields an
+// empty plan and stocked false.
+func (uc *ReservationUseCase) plan(ctx context.Context, loader *referenceLoader, in ReserveInput, line ReserveItemInput) (*AllocationPlan, bool, error) {
+	plan, err := uc.allocator.allocate(ctx, loader, AllocationRequest{
+		ProductID:            line.ProductID,
+		Quantity:             line.Quantity,
+		PreferredWarehouseID: line.PreferredWarehouseID,
+		Destination:          in.Destination,
+		Strategy:             in.Strategy,
+		NoSplit:              in.NoSplit,
+	})
+	if errors.Is(err, ErrProductNotStocked) {
+		if _, err := loader.product(ctx, line.ProductID); err != nil {
+			return nil, false, err
+		}
+		return &AllocationPlan{
+			ProductID:   line.ProductID,
+			Requested:   line.Quantity,
+			Strategy:    cmp.Or(in.Strategy, DefaultAllocationStrategy),
+			Explanation: "no active warehouse stocks the product",
+		}, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return plan, true, nil
+}
+
+// backorder queues the unreserved remainder of each short line and publishes a
+// BackorderCreatedEvent for each. The remainder of a kit is backordered as its
+// components, as only components are ever replenished.
+func (uc *ReservationUseCase) backorder(
+	ctx context.Context,
+	loader *referenceLoader,
+	in ReserveInput,
+	shortfalls []ReservationShortfall,
+) ([]*entity.Backorder, []port.OutboxEntry, error) {
+	backorders := make([]*entity.Backorder, 0, len(shortfalls))
+	outcome := make([]port.OutboxEntry, 0, len(shortfalls))
+	for _, s := range shortfalls {
+		warehouseID := in.Items[s.Line].PreferredWarehouseID
+		if warehouseID != "" {
+			if _, err := loader.warehouse(ctx, warehouseID); err != nil {
+				if !errors.Is(err, repository.ErrNotFound) {
+					return nil, nil, err
+				}
+				// An unknown preferred warehouse is ignored, as it is by allocation
+				warehouseID = ""
+			}
+		}
+		remainders, err := uc.backorderRemainders(ctx, loader, s)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, remainder := range remainders {
+			backorder, err := entity.NewBackorder(uuid.NewString(), in.OrderID, remainder.ProductID, warehouseID,
+				remainder.Quantity, in.Priority)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := uc.backorders.Create(ctx, backorder); err != nil {
+				return nil, nil, fmt.Errorf("failed to create backorder: %w", err)
+			}
+			backorders = append(backorders, backorder)
+
+			product, err := loader.product(ctx, backorder.ProductID)
+			if err != nil {
+				return nil, nil, err
+			}
+			meta := newEventMetadata(CorrelationIDFromContext(ctx))
+			created, err := publishOutcome(ctx, uc.publisher, AggregateTypeBackorder, event.BackorderCreatedEvent{
+				EventID:       meta.EventID,
+				CorrelationID: meta.CorrelationID,
+				Timestamp:     meta.Timestamp,
+				Version:       meta.Version,
+				BackorderID:   backorder.ID,
+				OrderID:       backorder.OrderID,
+				ProductID:     backorder.ProductID,
+				SKU:           product.SKU,
+				WarehouseID:   backorder.WarehouseID,
+				Quantity:      backorder.Quantity,
+				Priority:      backorder.Priority,
+			}, meta)
+			if err != nil {
+				return nil, nil, err
+			}
+			outcome = append(outcome, created)
+		}
+	}
+	return backorders, outcome, nil
+}
+
+// backorderRemainders returns the product quantities the unreserved remainder of a short
+// line is backordered as
+func (uc *ReservationUseCase) backorderRemainders(ctx context.Context, loader *referenceLoader, s ReservationShortfall) ([]entity.ProductQuantity, error) {
+	remainder := s.Requested - s.Reserved
+	product, err := loader.product(ctx, s.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if !product.IsKit {
+		return []entity.ProductQuantity{{ProductID: s.ProductID, Quantity: remainder}}, nil
+	}
+
+	kit, err := uc.kits.kit(ctx, s.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	remainders := make([]entity.ProductQuantity, 0, len(kit.Components))
+	for _, c := range kit.Components {
+		remainders = append(remainders, entity.ProductQuantity{ProductID: c.ProductID, Quantity: remainder * c.Quantity})
+	}
+	return remainders, nil
+}
+
+// cancelBackorders cancels the open backorders of an order and returns the
+// BackorderCancelledEvents it published
+func (uc *ReservationUseCase) cancelBackorders(ctx context.Context, orderID string) ([]port.OutboxEntry, error) {
+	backorders, err := uc.backorders.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backorders for order %s: %w", orderID, err)
+	}
+
+	var outcome []port.OutboxEntry
+	for _, backorder := range backorders {
+		if backorder.Status != entity.BackorderStatusOpen {
+			continue
+		}
+		if err := backorder.Cancel(); err != nil {
+			return nil, err
+		}
+		if err := uc.backorders.Update(ctx, backorder); err != nil {
+			return nil, fmt.Errorf("failed to update backorder: %w", err)
+		}
+
+		meta := newEventMetadata(CorrelationIDFromContext(ctx))
+		cancelled, err := publishOutcome(ctx, uc.publisher, AggregateTypeBackorder, event.BackorderCancelledEvent{
+			EventID:           meta.EventID,
+			CorrelationID:     meta.CorrelationID,
+			Timestamp:         meta.Timestamp,
+			Version:           meta.Version,
+			BackorderID:       backorder.ID,
+			OrderID:           backorder.OrderID,
+			ProductID:         backorder.ProductID,
+			QuantityCancelled: backorder.RemainingQuantity(),
+		}, meta)
+		if err != nil {
+			return nil, err
+		}
+		outcome = append(outcome, cancelled)
+	}
+	return outcome, nil
+}
+
+func (uc *ReservationUseCase) publishReserved(
+	ctx context.Context,
+	reservation *entity.Reservation,
+	details []ReservationItemDetails,
+	correlationID string,
+) (port.OutboxEntry, error) {
+	meta := newEventMetadata(correlationID)
+	evt := event.StockReservedEvent{
+		EventID:       meta.EventID,
+		CorrelationID: meta.CorrelationID,
+		Timestamp:     meta.Timestamp,
+		Version:       meta.Version,
+		ReservationID: reservation.ID,
+		OrderID:       reservation.OrderID,
+		WarehouseID:   commonWarehouseID(reservation.Items),
+		ExpiresAt:     reservation.ExpiresAt,
+	}
+	for _, d := range details {
+		evt.Items = append(evt.Items, event.StockReservedItemDetail{
+			ProductID:        d.ProductID,
+			SKU:              d.SKU,
+			QuantityReserved: d.Quantity,
+		})
+	}
+	return publishOutcome(ctx, uc.publisher, AggregateTypeReservation, evt, meta)
+}
+
+// GetByID retrieves a reservation by its ID
+func (uc *ReservationUseCase) GetByID(ctx context.Context, id string) (*ReservationDetails, error) {
+	reservation, err := uc.reservations.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation %s: %w", id, err)
+	}
+	return uc.withDetails(ctx, newReferenceLoader(uc.products, uc.warehouses), reservation)
+}
+
+// Picks suggests the bins to pick the stock a reservation still holds from: the bins of
+// each line's stock item in path order, then stock not put away. Fulfillment picks from
+// them in the same order.
+func (uc *ReservationUseCase) Picks(ctx context.Context, id string) (*ReservationDetails, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	reservation, err := uc.reservations.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation %s: %w", id, err)
+	}
+	details, err := uc.withDetails(ctx, loader, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range reservation.Items {
+		outstanding := line.OutstandingQuantity()
+		if outstanding == 0 {
+			continue
+		}
+		item, err := uc.stockItems.GetByID(ctx, line.StockItemID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stock item %s: %w", line.StockItemID, err)
+		}
+		picks, err := uc.bins.picks(ctx, item, outstanding)
+		if err != nil {
+			return nil, err
+		}
+		details.Picks = append(details.Picks, picks...)
+	}
+	return details, nil
+}
+
+// ListByOrder retrieves all reservations made for an order
+func (uc *ReservationUseCase) ListByOrder(ctx context.Context, orderID string) ([]*ReservationDetails, error) {
+	reservations, err := uc.reservations.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations for order %s: %w", orderID, err)
+	}
+
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	result := make([]*ReservationDetails, 0, len(reservations))
+	for _, reservation := range reservations {
+		d, err := uc.withDetails(ctx, loader, reservation)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// Release returns the reserved stock of a reservation to available: the listed
+// quantities when in.Items is set, otherwise everything the reservation still holds
+func (uc *ReservationUseCase) Release(ctx context.Context, id string, in ReleaseInput) (*ReservationDetails, error) {
+	result, _, err := uc.release(ctx, id, in)
+	return result, err
+}
+
+// release implements Release and also returns the published StockReleasedEvent
+func (uc *ReservationUseCase) release(ctx context.Context, id string, in ReleaseInput) (*ReservationDetails, port.OutboxEntry, error) {
+	if len(in.Items) == 0 {
+		return uc.returnStock(ctx, id, in, (*entity.Reservation).Release)
+	}
+	return uc.returnStock(ctx, id, in, func(r *entity.Reservation) error {
+		for _, item := range in.Items {
+			if err := r.ReleaseProduct(item.ProductID, item.Quantity); err != nil {
+				return fmt.Errorf("product %s: %w", item.ProductID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Expire returns the reserved stock of a reservation whose expiry has passed to
+// available and marks the reservation expired
+func (uc *ReservationUseCase) Expire(ctx context.Context, id string) (*ReservationDetails, error) {
+	in := ReleaseInput{Reason: ReleaseReasonExpired, PerformedBy: SystemActor}
+	result, _, err := uc.returnStock(ctx, id, in, func(r *entity.Reservation) error {
+		if !r.IsExpired() {
+			return ErrReservationNotExpired
+		}
+		return r.Expire()
+	})
+	return result, err
+}
+
+// ExpireOverdue expires every reservation still holding stock whose expiry has passed,
+// each in its own transaction, and returns how many were expired. Each reservation is
+// locked and checked again before it is expired, so one released, fulfilled or extended
+// since it was listed is skipped, and an Extend waiting on the lock finds it expired.
+// Other failures are collected and do not stop the remaining reservations from being
+// expired.
+func (uc *ReservationUseCase) ExpireOverdue(ctx context.Context) (int, error) {
+	overdue, err := uc.reservations.GetExpiredReservations(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired reservations: %w", err)
+	}
+
+	expired := 0
+	var errs []error
+	for _, reservation := range overdue {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		_, err := uc.Expire(ctx, reservation.ID)
+		switch {
+		case err == nil:
+			expired++
+		case errors.Is(err, ErrReservationNotExpired), errors.Is(err, entity.ErrReservationNotPending):
+		default:
+			errs = append(errs, fmt.Errorf("reservation %s: %w", reservation.ID, err))
+		}
+	}
+	return expired, errors.Join(errs...)
+}
+
+// returnStock applies transition to a reservation, returns the quantity it released from
+// each line to available and publishes a StockReleasedEvent for those quantities
+func (uc *ReservationUseCase) returnStock(
+	ctx context.Context,
+	id string,
+	in ReleaseInput,
+	transition func(*entity.Reservation) error,
+) (*ReservationDetails, port.OutboxEntry, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *ReservationDetails
+	var outcome port.OutboxEntry
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		reservation, err := uc.reservations.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get reservation %s: %w", id, err)
+		}
+		before := slices.Clone(reservation.Items)
+		if err := transition(reservation); err != nil {
+			return err
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.StockReleasedEvent{
+			EventID:       meta.EventID,
+			CorrelationID: meta.CorrelationID,
+			Timestamp:     meta.Timestamp,
+			Version:       meta.Version,
+			ReservationID: reservation.ID,
+			OrderID:       reservation.OrderID,
+			WarehouseID:   commonWarehouseID(reservation.Items),
+			ReleaseReason: in.Reason,
+		}
+		for i, line := range reservation.Items {
+			released := line.ReleasedQuantity - before[i].ReleasedQuantity
+			if released == 0 {
+				continue
+			}
+			itemDetails, err := uc.loadStockItem(ctx, loader, line.StockItemID)
+			if err != nil {
+				return err
+			}
+			if err := uc.applyMovement(ctx, loader, itemDetails, entity.MovementTypeRelease, released,
+				reservation.ID, in.Reason, in.PerformedBy, correlationID, nil); err != nil {
+				return err
+			}
+			evt.Items = append(evt.Items, event.StockReleasedItemDetail{
+				ProductID:        line.ProductID,
+				SKU:              itemDetails.SKU,
+				QuantityReleased: released,
+			})
+		}
+
+		if err := uc.reservations.Update(ctx, reservation); err != nil {
+			return fmt.Errorf("failed to update reservation: %w", err)
+		}
+		if outcome, err = publishOutcome(ctx, uc.publisher, AggregateTypeReservation, evt, meta); err != nil {
+			return err
+		}
+
+		result, err = uc.withDetails(ctx, loader, reservation)
+		return err
+	})
+	if err != nil {
+		return nil, port.OutboxEntry{}, err
+	}
+
+	return result, outcome, nil
+}
+
+// Fulfill permanently decrements the shipped stock of a reservation. Repeating a
+// shipment ID returns the reservation unchanged.
+func (uc *ReservationUseCase) Fulfill(ctx context.Context, id string, in FulfillInput) (*ReservationDetails, error) {
+	result, _, err := uc.fulfill(ctx, id, in)
+	return result, err
+}
+
+// fulfill implements Fulfill and also returns the published StockDecrementedEvent
+func (uc *ReservationUseCase) fulfill(ctx context.Context, id string, in FulfillInput) (*ReservationDetails, port.OutboxEntry, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *ReservationDetails
+	var outcome port.OutboxEntry
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		reservation, err := uc.reservations.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get reservation %s: %w", id, err)
+		}
+		if in.ShipmentID != "" && reservation.Shipment(in.ShipmentID) != nil {
+			// Already decremented by an earlier delivery of this shipment
+			result, err = uc.withDetails(ctx, loader, reservation)
+			return err
+		}
+
+		before := slices.Clone(reservation.Items)
+		if err := ship(reservation, in); err != nil {
+			return err
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.StockDecrementedEvent{
+			EventID:       meta.EventID,
+			CorrelationID: meta.CorrelationID,
+			Timestamp:     meta.Timestamp,
+			Version:       meta.Version,
+			ReservationID: reservation.ID,
+			OrderID:       reservation.OrderID,
+		}
+
+		serials, err := uc.shipmentSerials(ctx, loader, reservation, before, in)
+		if err != nil {
+			return err
+		}
+		var picks []BinPick
+		var shipped []entity.ReservationItem
+		for i, line := range reservation.Items {
+			fulfilled := line.FulfilledQuantity - before[i].FulfilledQuantity
+			if fulfilled == 0 {
+				continue
+			}
+			shipped = append(shipped, line)
+			itemDetails, err := uc.loadStockItem(ctx, loader, line.StockItemID)
+			if err != nil {
+				return err
+			}
+			linePicks, err := uc.bins.picks(ctx, itemDetails.StockItem, fulfilled)
+			if err != nil {
+				return err
+			}
+			picks = append(picks, linePicks...)
+			if err := uc.applyMovement(ctx, loader, itemDetails, entity.MovementTypeFulfillment, fulfilled,
+				reservation.ID, in.Notes, in.FulfilledBy, correlationID, serials[i]); err != nil {
+				return err
+			}
+			evt.Items = append(evt.Items, event.StockDecrementedItemDetail{
+				ProductID:           line.ProductID,
+				SKU:                 itemDetails.SKU,
+				QuantityDecremented: fulfilled,
+				RemainingStock:      itemDetails.QuantityOnHand,
+			})
+		}
+
+		if err := uc.reservations.Update(ctx, reservation); err != nil {
+			return fmt.Errorf("failed to update reservation: %w", err)
+		}
+
+		evt.WarehouseID = commonWarehouseID(shipped)
+		evt.MovementID = in.ShipmentID
+		if evt.MovementID == "" {
+			evt.MovementID = reservation.ID
+		}
+		if outcome, err = publishOutcome(ctx, uc.publisher, AggregateTypeReservation, evt, meta); err != nil {
+			return err
+		}
+
+		if result, err = uc.withDetails(ctx, loader, reservation); err != nil {
+			return err
+		}
+		result.Picks = picks
+		return nil
+	})
+	if err != nil {
+		return nil, port.OutboxEntry{}, err
+	}
+
+	return result, outcome, nil
+}
+
+// shipmentSerialNumbers returns the serial numbers of the shipment by product. A shipment
+// of listed items names the units of every listed product, so serialized products
+// without serial numbers are refused; without items, the reserved units are shipped.
+func shipmentSerialNumbers(in FulfillInput) map[string][]string {
+	if len(in.Items) == 0 {
+		return nil
+	}
+	serials := make(map[string][]string, len(in.Items))
+	for _, item := range in.Items {
+		serials[item.ProductID] = append(serials[item.ProductID], item.SerialNumbers...)
+		if serials[item.ProductID] == nil {
+			serials[item.ProductID] = []string{}
+		}
+	}
+	return serials
+}
+
+// shipmentSerials splits the serial numbers a shipment names among the reservation lines
+// it shipped, by line index. Each line gets as many of the units of its stock item as it
+// shipped; lines of products without named units are left out and ship the units they hold.
+func (uc *ReservationUseCase) shipmentSerials(
+	ctx context.Context,
+	loader *referenceLoader,
+	reservation *entity.Reservation,
+	before []entity.ReservationItem,
+	in FulfillInput,
+) (map[int][]string, error) {
+	lines := make(map[int][]string)
+	for productID, serialNumbers := range shipmentSerialNumbers(in) {
+		shipped := make(map[string]int)
+		for i, line := range reservation.Items {
+			if line.ProductID == productID {
+				shipped[line.StockItemID] += line.FulfilledQuantity - before[i].FulfilledQuantity
+			}
+		}
+		product, err := loader.product(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+		allotted, err := uc.serials.allot(ctx, product, shipped, serialNumbers)
+		if err != nil {
+			return nil, err
+		}
+		if allotted == nil {
+			continue
+		}
+
+		for i, line := range reservation.Items {
+			fulfilled := line.FulfilledQuantity - before[i].FulfilledQuantity
+			if line.ProductID != productID || fulfilled == 0 {
+				continue
+			}
+			units := allotted[line.StockItemID]
+			lines[i], allotted[line.StockItemID] = units[:fulfilled:fulfilled], units[fulfilled:]
+		}
+	}
+	return lines, nil
+}
+
+// ship applies a fulfillment to a reservation: a recorded shipment when a shipment ID
+// is given, otherwise fulfillment of everything still held
+func ship(reservation *entity.Reservation, in FulfillInput) error {
+	if in.ShipmentID == "" && len(in.Items) == 0 {
+		return reservation.Fulfill()
+	}
+	items := make([]entity.ProductQuantity, 0, len(in.Items))
+	for _, item := range in.Items {
+		items = append(items, entity.ProductQuantity{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+	_, err := reservation.Ship(in.ShipmentID, items, time.Now())
+	return err
+}
+
+// applyMovement mutates a stock item for one reservation line, persists it and records the
+// movement. serialNumbers names the units of a serialized product a fulfillment ships.
+func (uc *ReservationUseCase) applyMovement(
+	ctx context.Context,
+	loader *referenceLoader,
+	item *StockItemDetails,
+	movementType entity.MovementType,
+	quantity int,
+	reservationID, reason, performedBy, correlationID string,
+	serialNumbers []string,
+) error {
+	before := snapshotOf(item.StockItem)
+
+	var signed int
+	switch movementType {
+	case entity.MovementTypeReservation:
+		if err := item.Reserve(quantity); err != nil {
+			return fmt.Errorf("product %s: %w", item.ProductID, err)
+		}
+		signed = quantity
+	case entity.MovementTypeRelease:
+		if err := item.ReleaseReservation(quantity); err != nil {
+			return fmt.Errorf("product %s: %w", item.ProductID, err)
+		}
+		signed = -quantity
+	case entity.MovementTypeFulfillment:
+		if err := item.Fulfill(quantity); err != nil {
+			return fmt.Errorf("product %s: %w", item.ProductID, err)
+		}
+		signed = -quantity
+	default:
+		return entity.ErrMovementTypeInvalid
+	}
+
+	if err := uc.stockItems.UpdateWithLock(ctx, item.StockItem, item.Version); err != nil {
+		return fmt.Errorf("failed to update stock item: %w", err)
+	}
+
+	movement, err := newMovement(item.StockItem, before, movementType, signed,
+		reservationID, ReferenceTypeReservation, reason, performedBy)
+	if err != nil {
+		return err
+	}
+	if err := uc.movements.Create(ctx, movement); err != nil {
+		return fmt.Errorf("failed to record stock movement: %w", err)
+	}
+	if err := uc.lots.post(ctx, movement, nil); err != nil {
+		return fmt.Errorf("failed to post lot movements: %w", err)
+	}
+	product, err := loader.product(ctx, item.ProductID)
+	if err != nil {
+		return err
+	}
+	if err := uc.serials.post(ctx, product, movement, serialNumbers); err != nil {
+		return err
+	}
+	if _, err := uc.bins.post(ctx, item.StockItem, movement, ""); err != nil {
+		return fmt.Errorf("failed to post bin movements: %w", err)
+	}
+	if err := publishMovementRecorded(ctx, uc.publisher, movement, item, correlationID); err != nil {
+		return err
+	}
+	if err := publishLowStockAlert(ctx, uc.publisher, item, before, product.MinStock, correlationID); err != nil {
+		return err
+	}
+	return uc.kits.publishLowStockAlerts(ctx, loader, uc.publisher, item, before, correlationID)
+}
+
+func (uc *ReservationUseCase) loadStockItem(ctx context.Context, loader *referenceLoader, id string) (*StockItemDetails, error) {
+	item, err := uc.stockItems.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock item %s: %w", id, err)
+	}
+	return loader.stockItemDetails(ctx, item)
+}
+
+func (uc *ReservationUseCase) withDetails(ctx context.Context, loader *referenceLoader, reservation *entity.Reservation) (*ReservationDetails, error) {
+	details := make([]ReservationItemDetails, 0, len(reservation.Items))
+	for _, line := range reservation.Items {
+		product, err := loader.product(ctx, line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		warehouse, err := loader.warehouse(ctx, line.WarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		details = append(details, ReservationItemDetails{
+			ReservationItem: line,
+			SKU:             product.SKU,
+			VariantSKU:      variantSKU(product),
+			ProductName:     product.Name,
+			WarehouseName:   warehouse.Name,
+		})
+	}
+	return &ReservationDetails{Reservation: reservation, ItemDetails: details}, nil
+}
+
+func itemDetailsFor(line entity.ReservationItem, item *StockItemDetails) ReservationItemDetails {
+	return ReservationItemDetails{
+		ReservationItem: line,
+		SKU:             item.SKU,
+		VariantSKU:      item.VariantSKU,
+		ProductName:     item.ProductName,
+		WarehouseName:   item.WarehouseName,
+	}
+}
+
+// commonWarehouseID returns the warehouse shared by all items, or empty when they span several
+func commonWarehouseID(items []entity.ReservationItem) string {
+	if len(items) == 0 {
+		return ""
+	}
+	id := items[0].WarehouseID
+	for _, item := range items[1:] {
+		if item.WarehouseID != id {
+			return ""
+		}
+	}
+	return id
+}
@@ -0,0 +1,243 @@
+// file: internal/application/usecase/reservation_usecase_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// noTx runs fn without a transaction. The fakes below write nothing before the
+// stock item's compare-and-swap, so a lost race leaves nothing to roll back.
+type noTx struct{}
+
+func (noTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// casStockItems keeps stock items in memory and updates them with the same
+// compare-and-swap on Version as the PostgreSQL repository. Reads return copies, as
+// rows read from the database would be.
+type casStockItems struct {
+	repository.StockItemRepository
+
+	mu        sync.Mutex
+	items     map[string]*entity.StockItem
+	conflicts atomic.Int64
+}
+
+func (r *casStockItems) GetByID(_ context.Context, id string) (*entity.StockItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[id]
+	if !ok {
+		return nil, fmt.Errorf("stock item %s: %w", id, repository.ErrNotFound)
+	}
+	read := *item
+	return &read, nil
+}
+
+func (r *casStockItems) List(_ context.Context, filter repository.StockItemFilter) ([]*entity.StockItem, int, error) {
+	r.mu.Lock()
+	var items []*entity.StockItem
+	for _, item := range r.items {
+		if filter.ProductID == nil || item.ProductID == *filter.ProductID {
+			read := *item
+			items = append(items, &read)
+		}
+	}
+	r.mu.Unlock()
+	// Let other writers in between the read and the write, as a database round trip would
+	runtime.Gosched()
+	return items, len(items), nil
+}
+
+func (r *casStockItems) UpdateWithLock(_ context.Context, s *entity.StockItem, expectedVersion int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.items[s.ID]
+	if !ok {
+		return fmt.Errorf("stock item %s: %w", s.ID, repository.ErrNotFound)
+	}
+	if stored.Version != expectedVersion {
+		r.conflicts.Add(1)
+		return &repository.ConcurrentModificationError{Entity: "stock item", ID: s.ID, ExpectedVersion: expectedVersion}
+	}
+	s.Version = expectedVersion + 1
+	written := *s
+	r.items[s.ID] = &written
+	return nil
+}
+
+type fakeProducts struct {
+	repository.ProductRepository
+	products map[string]*entity.Product
+}
+
+func (r *fakeProducts) GetByID(_ context.Context, id string) (*entity.Product, error) {
+	p, ok := r.products[id]
+	if !ok {
+		return nil, fmt.Errorf("product %s: %w", id, repository.ErrNotFound)
+	}
+	read := *p
+	return &read, nil
+}
+
+func (r *fakeProducts) ListVariants(context.Context, string) ([]*entity.Product, error) {
+	return nil, nil
+}
+
+type fakeWarehouses struct {
+	repository.WarehouseRepository
+	warehouses map[string]*entity.Warehouse
+}
+
+func (r *fakeWarehouses) GetByID(_ context.Context, id string) (*entity.Warehouse, error) {
+	w, ok := r.warehouses[id]
+	if !ok {
+		return nil, fmt.Errorf("warehouse %s: %w", id, repository.ErrNotFound)
+	}
+	read := *w
+	return &read, nil
+}
+
+type fakeMovements struct {
+	repository.StockMovementRepository
+}
+
+func (fakeMovements) Create(context.Context, *entity.StockMovement) error { return nil }
+
+type fakeLots struct {
+	repository.LotRepository
+}
+
+func (fakeLots) GetByStockItem(context.Context, string) ([]*entity.Lot, error) { return nil, nil }
+
+type fakeKits struct {
+	repository.KitRepository
+}
+
+func (fakeKits) ListByComponent(context.Context, string) ([]*entity.Kit, error) { return nil, nil }
+
+type fakeReservations struct {
+	repository.ReservationRepository
+
+	mu           sync.Mutex
+	reservations []*entity.Reservation
+}
+
+func (r *fakeReservations) Create(_ context.Context, res *entity.Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reservations = append(r.reservations, res)
+	return nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) PublishToOutbox(context.Context, port.OutboxEntry) error { return nil }
+
+// TestReserveConcurrentNoOversell reserves one unit per goroutine from a stock item
+// holding far fewer units than there are goroutines. The compare-and-swap must make
+// every reservation that lost a race retry against fresh stock, so exactly the stock
+// on hand is reserved and never more.
+func TestReserveConcurrentNoOversell(t *testing.T) {
+	const (
+		goroutines = 500
+		stock      = 120
+	)
+	ctx := context.Background()
+
+	product, err := entity.NewProduct(uuid.NewString(), "SKU-STRESS", "Widget", "", "", entity.ProductVariant{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	warehouse, err := entity.NewWarehouse(uuid.NewString(), "WH-1", "Main", entity.WarehouseAddress{}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item, err := entity.NewStockItem(uuid.NewString(), product.ID, warehouse.ID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item.QuantityOnHand = stock
+
+	products := &fakeProducts{products: map[string]*entity.Product{product.ID: product}}
+	warehouses := &fakeWarehouses{warehouses: map[string]*entity.Warehouse{warehouse.ID: warehouse}}
+	stockItems := &casStockItems{items: map[string]*entity.StockItem{item.ID: item}}
+	reservations := &fakeReservations{}
+	kits := fakeKits{}
+
+	// Every conflict means another reservation succeeded in between, so a line can
+	// lose at most stock races before the stock runs out
+	retry := RetryPolicy{MaxAttempts: stock + 1, BaseDelay: 50 * time.Microsecond, MaxDelay: time.Millisecond}
+	uc := NewReservationUseCase(noTx{}, products, warehouses, stockItems, fakeMovements{}, fakeLots{}, nil, nil,
+		kits, nil, reservations, nil, discardPublisher{}, NewAllocator(products, warehouses, stockItems, kits),
+		DefaultReservationTTLPolicy(), retry)
+
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int64
+		rejected  atomic.Int64
+		start     = make(chan struct{})
+		errs      = make(chan error, goroutines)
+	)
+	for i := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := uc.Reserve(ctx, ReserveInput{
+				OrderID: fmt.Sprintf("order-%d", i),
+				Items:   []ReserveItemInput{{ProductID: product.ID, Quantity: 1}},
+			})
+			var failed *ReservationFailedError
+			switch {
+			case err == nil:
+				succeeded.Add(1)
+			case errors.As(err, &failed):
+				rejected.Add(1)
+			default:
+				errs <- err
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected reservation error: %v", err)
+	}
+	final, err := stockItems.GetByID(ctx, item.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if final.QuantityReserved > final.QuantityOnHand {
+		t.Fatalf("oversold: reserved %d of %d on hand", final.QuantityReserved, final.QuantityOnHand)
+	}
+	if got := succeeded.Load(); got != stock {
+		t.Fatalf("successful reservations: got %d, want %d", got, stock)
+	}
+	if got := rejected.Load(); got != goroutines-stock {
+		t.Fatalf("rejected reservations: got %d, want %d", got, goroutines-stock)
+	}
+	if final.QuantityReserved != stock || final.QuantityOnHand != stock {
+		t.Fatalf("final stock: reserved %d, on hand %d; want %d, %d", final.QuantityReserved, final.QuantityOnHand, stock, stock)
+	}
+	if got := len(reservations.reservations); got != stock {
+		t.Fatalf("stored reservations: got %d, want %d", got, stock)
+	}
+	t.Logf("%d compare-and-swap conflicts retried", stockItems.conflicts.Load())
+}
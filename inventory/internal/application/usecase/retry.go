@@ -0,0 +1,68 @@
+// file: internal/application/usecase/retry.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// RetryPolicy bounds how often a stock mutation is retried after losing an
+// optimistic-locking race. Delays grow exponentially from BaseDelay up to MaxDelay,
+// and each delay is drawn uniformly from [0, delay) so competing writers spread out.
+type RetryPolicy struct {
+	MaxAttempts int // Total attempts including the first; values below 1 mean 1
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   10 * time.Millisecond,
+		MaxDelay:    200 * time.Millisecond,
+	}
+}
+
+// retryOnConflict runs fn until it succeeds, fails with an error other than
+// repository.ErrConcurrentModification, or the policy's attempts are exhausted.
+// fn must re-read everything it writes, since each attempt starts from scratch.
+func retryOnConflict(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	delay := policy.BaseDelay
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !errors.Is(err, repository.ErrConcurrentModification) || attempt >= policy.MaxAttempts {
+			return err
+		}
+
+		var wait time.Duration
+		if delay > 0 {
+			wait = rand.N(delay)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+
+		delay *= 2
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
+
+// withinRetriedTransaction runs fn in a transaction that is rolled back and retried
+// from scratch when a record changed between being read and written
+func withinRetriedTransaction(ctx context.Context, tx port.TransactionManager, policy RetryPolicy, fn func(ctx context.Context) error) error {
+	return retryOnConflict(ctx, policy, func() error {
+		return tx.WithinTransaction(ctx, fn)
+	})
+}
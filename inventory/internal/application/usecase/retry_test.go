@@ -0,0 +1,50 @@
+// file: internal/application/usecase/retry_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+func TestWithinRetriedTransaction(t *testing.T) {
+	conflict := &repository.ConcurrentModificationError{Entity: "stock item", ID: "item-1", ExpectedVersion: 1}
+	other := errors.New("boom")
+
+	tests := []struct {
+		name      string
+		failures  []error // Returned by the first attempts, in order; later attempts succeed
+		attempts  int
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "succeeds first time", attempts: 3, wantCalls: 1},
+		{name: "retries conflicts", failures: []error{conflict, conflict}, attempts: 3, wantCalls: 3},
+		{name: "gives up after max attempts", failures: []error{conflict, conflict, conflict}, attempts: 3, wantCalls: 3,
+			wantErr: repository.ErrConcurrentModification},
+		{name: "does not retry other errors", failures: []error{other}, attempts: 3, wantCalls: 1, wantErr: other},
+		{name: "at least one attempt", failures: []error{conflict}, attempts: 0, wantCalls: 1,
+			wantErr: repository.ErrConcurrentModification},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := withinRetriedTransaction(context.Background(), noTx{}, RetryPolicy{MaxAttempts: tt.attempts},
+				func(context.Context) error {
+					calls++
+					if calls <= len(tt.failures) {
+						return tt.failures[calls-1]
+					}
+					return nil
+				})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("attempts: got %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+// file: internal/application/usecase/serial_ledger.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// serialLedger moves the serial units of serialized products along with their stock
+// movements. Received units are named by serial number; reservations hold the oldest
+// available units of the stock item and releases give them back; shipments name the
+// units shipped, or ship the units the reservation holds when none are named.
+type serialLedger struct {
+	serials repository.SerialUnitRepository
+}
+
+func newSerialLedger(serials repository.SerialUnitRepository) *serialLedger {
+	return &serialLedger{serials: serials}
+}
+
+// post applies a recorded stock movement of product to its serial units. Replenishments
+// need one serial number per unit. For fulfillments a nil serialNumbers ships the units
+// reserved for the reservation, while a non-nil one names the shipped units of the
+// movement's stock item, as split by allot.
+func (l *serialLedger) post(
+	ctx context.Context,
+	product *entity.Product,
+	movement *entity.StockMovement,
+	serialNumbers []string,
+) error {
+	if !product.Serialized {
+		if len(serialNumbers) > 0 {
+			return fmt.Errorf("product %s: %w", product.ID, ErrProductNotSerialized)
+		}
+		return nil
+	}
+
+	quantity := movement.Quantity
+	if quantity < 0 {
+		quantity = -quantity
+	}
+	switch movement.MovementType {
+	case entity.MovementTypeReplenishment:
+		return l.receive(ctx, product, movement, serialNumbers, quantity)
+	case entity.MovementTypeReservation:
+		units, err := l.unitsAt(ctx, movement.StockItemID, nil, quantity,
+			entity.SerialStatusAvailable, entity.SerialStatusReturned)
+		if err != nil {
+			return err
+		}
+		return l.apply(ctx, movement, units, func(u *entity.SerialUnit) error { return u.Reserve(movement.ReferenceID) })
+	case entity.MovementTypeRelease:
+		units, err := l.unitsAt(ctx, movement.StockItemID, &movement.ReferenceID, quantity, entity.SerialStatusReserved)
+		if err != nil {
+			return err
+		}
+		return l.apply(ctx, movement, units, (*entity.SerialUnit).Release)
+	case entity.MovementTypeFulfillment:
+		return l.ship(ctx, product, movement, serialNumbers, quantity)
+	}
+	return fmt.Errorf("product %s: %w", product.ID, ErrSerializedMovement)
+}
+
+// receive creates the units received for the first time and brings shipped ones back
+func (l *serialLedger) receive(
+	ctx context.Context,
+	product *entity.Product,
+	movement *entity.StockMovement,
+	serialNumbers []string,
+	quantity int,
+) error {
+	if len(serialNumbers) == 0 {
+		return fmt.Errorf("product %s: %w", product.ID, ErrSerialNumbersRequired)
+	}
+	if len(serialNumbers) != quantity {
+		return fmt.Errorf("%w: %d serial numbers for %d units", ErrSerialNumberCount, len(serialNumbers), quantity)
+	}
+
+	for _, serialNumber := range serialNumbers {
+		unit, err := l.serials.GetBySerialNumber(ctx, product.ID, serialNumber)
+		if errors.Is(err, repository.ErrNotFound) {
+			unit, err = entity.NewSerialUnit(uuid.NewString(), product.ID, serialNumber, movement.StockItemID)
+			if err != nil {
+				return err
+			}
+			if err := l.serials.Create(ctx, unit); err != nil {
+				return fmt.Errorf("failed to create serial unit %s: %w", serialNumber, err)
+			}
+			if err := l.record(ctx, movement, unit, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get serial unit %s: %w", serialNumber, err)
+		}
+		if err := l.change(ctx, movement, unit, func(u *entity.SerialUnit) error { return u.Return(movement.StockItemID) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ship marks the named units, or the units reserved for the reservation, as shipped. A
+// named unit that is available takes the place of one the reservation holds.
+func (l *serialLedger) ship(
+	ctx context.Context,
+	product *entity.Product,
+	movement *entity.StockMovement,
+	serialNumbers []string,
+	quantity int,
+) error {
+	reservationID := movement.ReferenceID
+	shipUnit := func(u *entity.SerialUnit) error {
+		if u.IsAvailable() {
+			if err := u.Reserve(reservationID); err != nil {
+				return err
+			}
+		}
+		return u.Ship(reservationID)
+	}
+	if serialNumbers == nil {
+		units, err := l.unitsAt(ctx, movement.StockItemID, &reservationID, quantity, entity.SerialStatusReserved)
+		if err != nil {
+			return err
+		}
+		return l.apply(ctx, movement, units, shipUnit)
+	}
+
+	var named []*entity.SerialUnit
+	for _, serialNumber := range serialNumbers {
+		unit, err := l.serials.GetBySerialNumber(ctx, product.ID, serialNumber)
+		if err != nil {
+			return fmt.Errorf("failed to get serial unit %s: %w", serialNumber, err)
+		}
+		if unit.StockItemID != movement.StockItemID {
+			return fmt.Errorf("serial unit %s: %w", serialNumber, ErrSerialUnitNotInShipment)
+		}
+		if !unit.IsAvailable() && (unit.Status != entity.SerialStatusReserved || unit.ReservationID != reservationID) {
+			return fmt.Errorf("serial unit %s: %w", serialNumber, entity.ErrSerialUnitNotAvailable)
+		}
+		named = append(named, unit)
+	}
+	if len(named) != quantity {
+		if len(named) == 0 {
+			return fmt.Errorf("product %s: %w", product.ID, ErrSerialNumbersRequired)
+		}
+		return fmt.Errorf("product %s: %w: %d serial numbers for %d units", product.ID, ErrSerialNumberCount,
+			len(named), quantity)
+	}
+
+	// Named units that are available replace reserved units that are not named
+	reserved, _, err := l.serials.List(ctx, repository.SerialUnitFilter{
+		StockItemID:   &movement.StockItemID,
+		ReservationID: &reservationID,
+		Statuses:      []entity.SerialStatus{entity.SerialStatusReserved},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list reserved serial units: %w", err)
+	}
+	replaced := slices.DeleteFunc(reserved, func(u *entity.SerialUnit) bool {
+		return slices.ContainsFunc(named, func(n *entity.SerialUnit) bool { return n.ID == u.ID })
+	})
+	for _, unit := range named {
+		if !unit.IsAvailable() {
+			continue
+		}
+		if len(replaced) == 0 {
+			return fmt.Errorf("serial unit %s: %w", unit.SerialNumber, entity.ErrSerialUnitNotReserved)
+		}
+		if err := l.change(ctx, movement, replaced[0], (*entity.SerialUnit).Release); err != nil {
+			return err
+		}
+		replaced = replaced[1:]
+	}
+	return l.apply(ctx, movement, named, shipUnit)
+}
+
+// allot splits the serial numbers a shipment names for a product among the stock items
+// it ships the product from, given as the quantity shipped from each. Every named unit
+// must be held by one of those stock items and be named once, and the units named for a
+// stock item must exactly cover the quantity shipped from it. A product that is not
+// serialized gets nil.
+func (l *serialLedger) allot(
+	ctx context.Context,
+	product *entity.Product,
+	shipped map[string]int,
+	serialNumbers []string,
+) (map[string][]string, error) {
+	if !product.Serialized {
+		if len(serialNumbers) > 0 {
+			return nil, fmt.Errorf("product %s: %w", product.ID, ErrProductNotSerialized)
+		}
+		return nil, nil
+	}
+	if len(serialNumbers) == 0 {
+		return nil, fmt.Errorf("product %s: %w", product.ID, ErrSerialNumbersRequired)
+	}
+
+	allotted := make(map[string][]string, len(shipped))
+	named := make(map[string]bool, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		if named[serialNumber] {
+			return nil, fmt.Errorf("serial number %s: %w", serialNumber, ErrSerialNumberDuplicate)
+		}
+		named[serialNumber] = true
+
+		unit, err := l.serials.GetBySerialNumber(ctx, product.ID, serialNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get serial unit %s: %w", serialNumber, err)
+		}
+		if shipped[unit.StockItemID] == 0 {
+			return nil, fmt.Errorf("serial unit %s: %w", serialNumber, ErrSerialUnitNotInShipment)
+		}
+		allotted[unit.StockItemID] = append(allotted[unit.StockItemID], serialNumber)
+	}
+	for stockItemID, quantity := range shipped {
+		if n := len(allotted[stockItemID]); n != quantity {
+			return nil, fmt.Errorf("product %s: %w: %d serial numbers for %d units shipped from stock item %s",
+				product.ID, ErrSerialNumberCount, n, quantity, stockItemID)
+		}
+	}
+	return allotted, nil
+}
+
+// unitsAt returns the oldest quantity units of a stock item in the given statuses,
+// reserved for reservationID when it is set
+func (l *serialLedger) unitsAt(
+	ctx context.Context,
+	stockItemID string,
+	reservationID *string,
+	quantity int,
+	statuses ...entity.SerialStatus,
+) ([]*entity.SerialUnit, error) {
+	units, _, err := l.serials.List(ctx, repository.SerialUnitFilter{
+		StockItemID:   &stockItemID,
+		ReservationID: reservationID,
+		Statuses:      statuses,
+		Limit:         quantity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list serial units: %w", err)
+	}
+	if len(units) < quantity {
+		return nil, fmt.Errorf("serial units of stock item %s: %w", stockItemID, entity.ErrInsufficientStock)
+	}
+	return units, nil
+}
+
+// apply changes each unit and records the change
+func (l *serialLedger) apply(
+	ctx context.Context,
+	movement *entity.StockMovement,
+	units []*entity.SerialUnit,
+	change func(u *entity.SerialUnit) error,
+) error {
+	for _, unit := range units {
+		if err := l.change(ctx, movement, unit, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// change applies a status change to a unit, persists it and records it
+func (l *serialLedger) change(
+	ctx context.Context,
+	movement *entity.StockMovement,
+	unit *entity.SerialUnit,
+	change func(u *entity.SerialUnit) error,
+) error {
+	from := unit.Status
+	if err := change(unit); err != nil {
+		return fmt.Errorf("serial unit %s: %w", unit.SerialNumber, err)
+	}
+	if err := l.serials.Update(ctx, unit); err != nil {
+		return fmt.Errorf("failed to update serial unit %s: %w", unit.SerialNumber, err)
+	}
+	return l.record(ctx, movement, unit, from)
+}
+
+// record appends a status change of a unit to its history
+func (l *serialLedger) record(ctx context.Context, movement *entity.StockMovement, unit *entity.SerialUnit, from entity.SerialStatus) error {
+	err := l.serials.CreateMovement(ctx, &entity.SerialMovement{
+		ID:            uuid.NewString(),
+		SerialUnitID:  unit.ID,
+		StockItemID:   unit.StockItemID,
+		MovementID:    movement.ID,
+		MovementType:  movement.MovementType,
+		FromStatus:    from,
+		ToStatus:      unit.Status,
+		ReferenceID:   movement.ReferenceID,
+		ReferenceType: movement.ReferenceType,
+		CreatedBy:     movement.CreatedBy,
+		CreatedAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record serial movement: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// file: internal/application/usecase/serial_ledger_test.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+type fakeSerials struct {
+	repository.SerialUnitRepository
+	units map[string]*entity.SerialUnit // By serial number
+}
+
+func (r *fakeSerials) GetBySerialNumber(_ context.Context, productID, serialNumber string) (*entity.SerialUnit, error) {
+	unit, ok := r.units[serialNumber]
+	if !ok || unit.ProductID != productID {
+		return nil, fmt.Errorf("serial unit %s: %w", serialNumber, repository.ErrNotFound)
+	}
+	read := *unit
+	return &read, nil
+}
+
+// newFakeSerials creates available units of product, named by serial number, at the
+// stock item given for each
+func newFakeSerials(t *testing.T, productID string, stockItems map[string]string) *fakeSerials {
+	t.Helper()
+	units := make(map[string]*entity.SerialUnit, len(stockItems))
+	for serialNumber, stockItemID := range stockItems {
+		unit, err := entity.NewSerialUnit("unit-"+serialNumber, productID, serialNumber, stockItemID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		units[serialNumber] = unit
+	}
+	return &fakeSerials{units: units}
+}
+
+func TestSerialLedgerAllot(t *testing.T) {
+	serialized := &entity.Product{ID: "product-1", Serialized: true}
+	serials := newFakeSerials(t, serialized.ID, map[string]string{
+		"SN-1": "item-a", "SN-2": "item-a", "SN-3": "item-b", "SN-4": "item-c",
+	})
+	ledger := newSerialLedger(serials)
+
+	tests := []struct {
+		name    string
+		product *entity.Product
+		shipped map[string]int
+		named   []string
+		want    map[string][]string
+		wantErr error
+	}{
+		{
+			name:    "split across stock items",
+			product: serialized,
+			shipped: map[string]int{"item-a": 2, "item-b": 1},
+			named:   []string{"SN-3", "SN-1", "SN-2"},
+			want:    map[string][]string{"item-a": {"SN-1", "SN-2"}, "item-b": {"SN-3"}},
+		},
+		{
+			name:    "more units named than shipped",
+			product: serialized,
+			shipped: map[string]int{"item-a": 1},
+			named:   []string{"SN-1", "SN-2"},
+			wantErr: ErrSerialNumberCount,
+		},
+		{
+			name:    "fewer units named than shipped",
+			product: serialized,
+			shipped: map[string]int{"item-a": 2, "item-b": 1},
+			named:   []string{"SN-1", "SN-3"},
+			wantErr: ErrSerialNumberCount,
+		},
+		{
+			name:    "unit of a stock item not shipped from",
+			product: serialized,
+			shipped: map[string]int{"item-a": 2, "item-b": 0},
+			named:   []string{"SN-1", "SN-4"},
+			wantErr: ErrSerialUnitNotInShipment,
+		},
+		{
+			name:    "unit of a stock item with nothing shipped",
+			product: serialized,
+			shipped: map[string]int{"item-a": 1, "item-b": 0},
+			named:   []string{"SN-1", "SN-3"},
+			wantErr: ErrSerialUnitNotInShipment,
+		},
+		{
+			name:    "unit named twice",
+			product: serialized,
+			shipped: map[string]int{"item-a": 2},
+			named:   []string{"SN-1", "SN-1"},
+			wantErr: ErrSerialNumberDuplicate,
+		},
+		{
+			name:    "unknown unit",
+			product: serialized,
+			shipped: map[string]int{"item-a": 1},
+			named:   []string{"SN-9"},
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name:    "no units named",
+			product: serialized,
+			shipped: map[string]int{"item-a": 1},
+			named:   []string{},
+			wantErr: ErrSerialNumbersRequired,
+		},
+		{
+			name:    "units named for a product that is not serialized",
+			product: &entity.Product{ID: "product-2"},
+			shipped: map[string]int{"item-a": 1},
+			named:   []string{"SN-1"},
+			wantErr: ErrProductNotSerialized,
+		},
+		{
+			name:    "product that is not serialized",
+			product: &entity.Product{ID: "product-2"},
+			shipped: map[string]int{"item-a": 1},
+			named:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ledger.allot(context.Background(), tt.product, tt.shipped, tt.named)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("allotted: got %v, want %v", got, tt.want)
+			}
+			for stockItemID, want := range tt.want {
+				if !slices.Equal(got[stockItemID], want) {
+					t.Errorf("stock item %s: got %v, want %v", stockItemID, got[stockItemID], want)
+				}
+			}
+		})
+	}
+}
+
+// TestShipmentSerialsSplitsLinesOfOneStockItem ships two lines of the same stock item:
+// each takes its own share of the named units rather than both taking the first ones
+func TestShipmentSerialsSplitsLinesOfOneStockItem(t *testing.T) {
+	product := &entity.Product{ID: "product-1", Serialized: true}
+	products := &fakeProducts{products: map[string]*entity.Product{product.ID: product}}
+	serials := newFakeSerials(t, product.ID, map[string]string{
+		"SN-1": "item-a", "SN-2": "item-a", "SN-3": "item-a", "SN-4": "item-b",
+	})
+	uc := &ReservationUseCase{products: products, serials: newSerialLedger(serials)}
+
+	before := []entity.ReservationItem{
+		{StockItemID: "item-a", ProductID: product.ID, Quantity: 2},
+		{StockItemID: "item-b", ProductID: product.ID, Quantity: 1},
+		{StockItemID: "item-a", ProductID: product.ID, Quantity: 2},
+	}
+	reservation := &entity.Reservation{ID: "res-1", Items: slices.Clone(before)}
+	reservation.Items[0].FulfilledQuantity = 2
+	reservation.Items[1].FulfilledQuantity = 1
+	reservation.Items[2].FulfilledQuantity = 1
+
+	in := FulfillInput{ShipmentID: "shipment-1", Items: []ShipmentItemInput{
+		{ProductID: product.ID, Quantity: 4, SerialNumbers: []string{"SN-1", "SN-2", "SN-3", "SN-4"}},
+	}}
+	got, err := uc.shipmentSerials(context.Background(), newReferenceLoader(products, nil), reservation, before, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int][]string{0: {"SN-1", "SN-2"}, 1: {"SN-4"}, 2: {"SN-3"}}
+	for line, units := range want {
+		if !slices.Equal(got[line], units) {
+			t.Errorf("line %d: got %v, want %v", line, got[line], units)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// file: internal/application/usecase/serial_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// ScrapSerialUnitInput carries the data required to write a serial unit off
+type ScrapSerialUnitInput struct {
+	SerialUnitID string
+	Reason       string
+	PerformedBy  string
+}
+
+// SerialUnitDetails is a serial unit together with its full history, oldest first
+type SerialUnitDetails struct {
+	*entity.SerialUnit
+	Movements []*entity.SerialMovement
+}
+
+// SerialUseCase looks serial units up and writes them off. Units are received, reserved
+// and shipped by the stock use cases through the serial ledger.
+type SerialUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	movements  repository.StockMovementRepository
+	serials    repository.SerialUnitRepository
+	lots       *lotLedger
+	ledger     *serialLedger
+	bins       *binLedger
+	kits       *kitStock
+	publisher  port.EventPublisher
+	retry      RetryPolicy
+}
+
+// NewSerialUseCase creates a new SerialUseCase
+func NewSerialUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	lots repository.LotRepository,
+	serials repository.SerialUnitRepository,
+	locations repository.LocationRepository,
+	kits repository.KitRepository,
+	publisher port.EventPublisher,
+	retry RetryPolicy,
+) *SerialUseCase {
+	return &SerialUseCase{
+		tx:         tx,
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		movements:  movements,
+		serials:    serials,
+		lots:       newLotLedger(lots),
+		ledger:     newSerialLedger(serials),
+		bins:       newBinLedger(locations),
+		kits:       newKitStock(kits, stockItems),
+		publisher:  publisher,
+		retry:      retry,
+	}
+}
+
+// GetByID retrieves a serial unit with its full movement history
+func (uc *SerialUseCase) GetByID(ctx context.Context, id string) (*SerialUnitDetails, error) {
+	unit, err := uc.serials.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get serial unit %s: %w", id, err)
+	}
+	return uc.withHistory(ctx, unit)
+}
+
+// List retrieves serial units matching the filter along with the total match count
+func (uc *SerialUseCase) List(ctx context.Context, filter repository.SerialUnitFilter) ([]*entity.SerialUnit, int, error) {
+	units, total, err := uc.serials.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list serial units: %w", err)
+	}
+	return units, total, nil
+}
+
+// Scrap writes an unreserved unit in stock off, taking it out of its stock item with an
+// ADJUSTMENT movement that references the unit
+func (uc *SerialUseCase) Scrap(ctx context.Context, in ScrapSerialUnitInput) (*SerialUnitDetails, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var unit *entity.SerialUnit
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		var err error
+		unit, err = uc.serials.GetByID(ctx, in.SerialUnitID)
+		if err != nil {
+			return fmt.Errorf("failed to get serial unit %s: %w", in.SerialUnitID, err)
+		}
+		if unit.Status == entity.SerialStatusScrapped {
+			return fmt.Errorf("serial unit %s: %w", unit.SerialNumber, entity.ErrSerialUnitScrapped)
+		}
+		if !unit.IsAvailable() {
+			return fmt.Errorf("serial unit %s: %w", unit.SerialNumber, entity.ErrSerialUnitNotAvailable)
+		}
+
+		stockItem, err := uc.stockItems.GetByID(ctx, unit.StockItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get stock item %s: %w", unit.StockItemID, err)
+		}
+		item, err := loader.stockItemDetails(ctx, stockItem)
+		if err != nil {
+			return err
+		}
+
+		before := snapshotOf(stockItem)
+		if err := stockItem.Withdraw(1); err != nil {
+			return fmt.Errorf("product %s: %w", item.ProductID, err)
+		}
+		if err := uc.stockItems.UpdateWithLock(ctx, stockItem, stockItem.Version); err != nil {
+			return fmt.Errorf("failed to update stock item: %w", err)
+		}
+
+		reason := "scrapped serial " + unit.SerialNumber
+		if in.Reason != "" {
+			reason += ": " + in.Reason
+		}
+		movement, err := newMovement(stockItem, before, entity.MovementTypeAdjustment, -1,
+			unit.ID, ReferenceTypeSerial, reason, in.PerformedBy)
+		if err != nil {
+			return err
+		}
+		if err := uc.movements.Create(ctx, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+		if err := uc.lots.post(ctx, movement, nil); err != nil {
+			return fmt.Errorf("failed to post lot movements: %w", err)
+		}
+		if _, err := uc.bins.post(ctx, stockItem, movement, ""); err != nil {
+			return fmt.Errorf("failed to post bin movements: %w", err)
+		}
+		if err := uc.ledger.change(ctx, movement, unit, (*entity.SerialUnit).Scrap); err != nil {
+			return err
+		}
+		if err := publishMovementRecorded(ctx, uc.publisher, movement, item, correlationID); err != nil {
+			return err
+		}
+
+		product, err := loader.product(ctx, item.ProductID)
+		if err != nil {
+			return err
+		}
+		if err := publishLowStockAlert(ctx, uc.publisher, item, before, product.MinStock, correlationID); err != nil {
+			return err
+		}
+		return uc.kits.publishLowStockAlerts(ctx, loader, uc.publisher, item, before, correlationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withHistory(ctx, unit)
+}
+
+func (uc *SerialUseCase) withHistory(ctx context.Context, unit *entity.SerialUnit) (*SerialUnitDetails, error) {
+	movements, err := uc.serials.GetMovements(ctx, unit.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of serial unit %s: %w", unit.ID, err)
+	}
+	return &SerialUnitDetails{SerialUnit: unit, Movements: movements}, nil
+}
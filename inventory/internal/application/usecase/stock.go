@@ -0,0 +1,57 @@
+// file: internal/application/usecase/stock.go
+package usecase
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// Reference types recorded on stock movements
+const (
+	ReferenceTypeReservation = "RESERVATION"
+	ReferenceTypeOrder       = "ORDER"
+	ReferenceTypeManual      = "MANUAL"
+	ReferenceTypeInitial     = "INITIAL"
+	ReferenceTypeTransfer    = "TRANSFER"
+	ReferenceTypeAdjustment  = "ADJUSTMENT"
+	ReferenceTypeCycleCount  = "CYCLE_COUNT"
+	ReferenceTypeSerial      = "SERIAL"
+	ReferenceTypeLocation    = "LOCATION" // Bin moves; the reference is the bin moved to
+)
+
+// stockSnapshot captures stock item quantities before a mutation
+type stockSnapshot struct {
+	onHand   int
+	reserved int
+	expired  int
+}
+
+func snapshotOf(item *entity.StockItem) stockSnapshot {
+	return stockSnapshot{onHand: item.QuantityOnHand, reserved: item.QuantityReserved, expired: item.QuantityExpired}
+}
+
+// reservable returns the quantity that could be reserved before the mutation
+func (s stockSnapshot) reservable() int {
+	return s.onHand - s.reserved - s.expired
+}
+
+// newMovement builds the audit record for a mutation applied to item since before
+func newMovement(
+	item *entity.StockItem,
+	before stockSnapshot,
+	movementType entity.MovementType,
+	quantity int,
+	referenceID, referenceType string,
+	reason, createdBy string,
+) (*entity.StockMovement, error) {
+	return entity.NewStockMovement(
+		uuid.NewString(), item.ID,
+		movementType,
+		quantity,
+		referenceID, referenceType,
+		before.onHand, item.QuantityOnHand,
+		before.reserved, item.QuantityReserved,
+		reason, createdBy,
+	)
+}
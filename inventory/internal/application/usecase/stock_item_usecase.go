@@ -0,0 +1,212 @@
+// file: internal/application/usecase/stock_item_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// CreateStockItemInput carries the data required to stock a product in a warehouse
+type CreateStockItemInput struct {
+	ProductID       string
+	WarehouseID     string
+	InitialQuantity int
+	ReorderPoint    int
+	ReorderQuantity int
+	VariantSKU      string // Variant of the product to stock; required for products with variants
+	BinLocation     string // Path of the home bin in the warehouse; optional
+	PerformedBy     string
+}
+
+// StockItemDetails is a stock item enriched with its product and warehouse names
+type StockItemDetails struct {
+	*entity.StockItem
+	SKU           string
+	VariantSKU    string // SKU of the variant stocked; empty for products without variants
+	ProductName   string
+	WarehouseName string
+}
+
+// AggregatedStockDetails is the stock of a product rolled up across warehouses
+type AggregatedStockDetails struct {
+	*repository.AggregatedStock
+	ProductName string
+	IsLowStock  bool
+}
+
+// StockItemUseCase orchestrates stock item operations
+type StockItemUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	movements  repository.StockMovementRepository
+	bins       *binLedger
+	publisher  port.EventPublisher
+}
+
+// NewStockItemUseCase creates a new StockItemUseCase
+func NewStockItemUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	locations repository.LocationRepository,
+	publisher port.EventPublisher,
+) *StockItemUseCase {
+	return &StockItemUseCase{
+		tx:         tx,
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		movements:  movements,
+		bins:       newBinLedger(locations),
+		publisher:  publisher,
+	}
+}
+
+// Create stocks a product in a warehouse, recording the initial quantity as a replenishment.
+// A product with variants is stocked per variant, each in a stock item of its own; a kit
+// is not stocked, its components are.
+// A home bin given by its path is where replenishments are put away by default, the
+// initial quantity included.
+func (uc *StockItemUseCase) Create(ctx context.Context, in CreateStockItemInput) (*StockItemDetails, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+
+	product, err := loader.product(ctx, in.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product, err = stockedProduct(ctx, uc.products, product, in.VariantSKU); err != nil {
+		return nil, err
+	}
+	if product.IsDeleted() || !product.IsActive {
+		return nil, ErrProductInactive
+	}
+	if product.IsKit {
+		return nil, fmt.Errorf("product %s: %w", product.SKU, ErrKitNotStocked)
+	}
+	if product.Serialized && in.InitialQuantity > 0 {
+		// Serialized stock is received unit by unit through replenishment
+		return nil, fmt.Errorf("product %s: %w", product.ID, ErrSerialNumbersRequired)
+	}
+	warehouse, err := loader.warehouse(ctx, in.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if warehouse.IsDeleted() || !warehouse.IsActive {
+		return nil, ErrWarehouseInactive
+	}
+
+	item, err := entity.NewStockItem(uuid.NewString(), product.ID, warehouse.ID, in.ReorderPoint, in.ReorderQuantity)
+	if err != nil {
+		return nil, err
+	}
+	if in.BinLocation != "" {
+		bin, err := uc.bins.homeBin(ctx, warehouse.ID, in.BinLocation)
+		if err != nil {
+			return nil, err
+		}
+		if err := item.AssignBin(bin); err != nil {
+			return nil, err
+		}
+	}
+	details, err := loader.stockItemDetails(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	correlationID := CorrelationIDFromContext(ctx)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		exists, err := uc.stockItems.ExistsByProductAndWarehouse(ctx, product.ID, warehouse.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check stock item uniqueness: %w", err)
+		}
+		if exists {
+			return ErrStockItemExists
+		}
+
+		before := snapshotOf(item)
+		if err := item.Replenish(in.InitialQuantity); err != nil {
+			return err
+		}
+		if err := uc.stockItems.Create(ctx, item); err != nil {
+			return fmt.Errorf("failed to create stock item: %w", err)
+		}
+		if in.InitialQuantity == 0 {
+			return nil
+		}
+
+		movement, err := newMovement(item, before, entity.MovementTypeReplenishment, in.InitialQuantity,
+			item.ID, ReferenceTypeInitial, "initial stock", in.PerformedBy)
+		if err != nil {
+			return err
+		}
+		if err := uc.movements.Create(ctx, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+		if _, err := uc.bins.post(ctx, item, movement, ""); err != nil {
+			return fmt.Errorf("failed to put away stock: %w", err)
+		}
+		return publishMovementRecorded(ctx, uc.publisher, movement, details, correlationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// GetByID retrieves a stock item by its ID
+func (uc *StockItemUseCase) GetByID(ctx context.Context, id string) (*StockItemDetails, error) {
+	item, err := uc.stockItems.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock item %s: %w", id, err)
+	}
+	return newReferenceLoader(uc.products, uc.warehouses).stockItemDetails(ctx, item)
+}
+
+// List retrieves stock items matching the filter along with the total match count
+func (uc *StockItemUseCase) List(ctx context.Context, filter repository.StockItemFilter) ([]*StockItemDetails, int, error) {
+	items, total, err := uc.stockItems.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list stock items: %w", err)
+	}
+
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	details := make([]*StockItemDetails, 0, len(items))
+	for _, item := range items {
+		d, err := loader.stockItemDetails(ctx, item)
+		if err != nil {
+			return nil, 0, err
+		}
+		details = append(details, d)
+	}
+	return details, total, nil
+}
+
+// AggregateByProduct rolls up the stock of a product across all warehouses
+func (uc *StockItemUseCase) AggregateByProduct(ctx context.Context, productID string) (*AggregatedStockDetails, error) {
+	product, err := uc.products.GetByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %s: %w", productID, err)
+	}
+
+	stock, err := uc.stockItems.GetAggregatedStock(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate stock for product %s: %w", productID, err)
+	}
+
+	return &AggregatedStockDetails{
+		AggregatedStock: stock,
+		ProductName:     product.Name,
+		IsLowStock:      stock.TotalAvailable <= product.MinStock,
+	}, nil
+}
@@ -0,0 +1,257 @@
+// file: internal/application/usecase/stock_movement_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// ReplenishInput carries the data required to add stock to a stock item
+type ReplenishInput struct {
+	StockItemID   string
+	Quantity      int
+	Unit          string // Unit of measure of Quantity; the product's base unit when empty
+	ReferenceType string
+	ReferenceID   string
+	Notes         string
+	PerformedBy   string
+	Lot           *LotInput // Lot the stock was received in; untracked when nil
+	SerialNumbers []string  // One per unit received; required for serialized products
+	BinLocationID string    // Bin the stock is put away to; by default the home bin and bins holding the item
+}
+
+// StockMovementDetails is a stock movement enriched with the product and warehouse it affected
+type StockMovementDetails struct {
+	*entity.StockMovement
+	ProductID     string
+	SKU           string
+	VariantSKU    string // SKU of the variant moved; empty for products without variants
+	ProductName   string
+	WarehouseID   string
+	WarehouseName string
+
+	FilledBackorders []*entity.Backorder // Backorders the replenished stock was reserved for; set by Replenish only
+	Lot              *entity.Lot         // Lot the stock was received in; set by Replenish only
+	Putaway          []BinPick           // Bins the stock was put away to; set by Replenish only
+}
+
+// StockMovementUseCase orchestrates replenishment and the stock movement audit trail
+type StockMovementUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	movements  repository.StockMovementRepository
+	lots       *lotLedger
+	serials    *serialLedger
+	bins       *binLedger
+	units      *unitConverter
+	publisher  port.EventPublisher
+	backorders *BackorderUseCase
+	retry      RetryPolicy
+}
+
+// NewStockMovementUseCase creates a new StockMovementUseCase
+func NewStockMovementUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	lots repository.LotRepository,
+	serials repository.SerialUnitRepository,
+	locations repository.LocationRepository,
+	units repository.UnitOfMeasureRepository,
+	publisher port.EventPublisher,
+	backorders *BackorderUseCase,
+	retry RetryPolicy,
+) *StockMovementUseCase {
+	return &StockMovementUseCase{
+		tx:         tx,
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		movements:  movements,
+		lots:       newLotLedger(lots),
+		serials:    newSerialLedger(serials),
+		bins:       newBinLedger(locations),
+		units:      newUnitConverter(units),
+		publisher:  publisher,
+		backorders: backorders,
+		retry:      retry,
+	}
+}
+
+// Replenish adds received stock to a stock item, records the movement and reserves the
+// new stock for open backorders of the product. Stock received in a lot is added to the
+// lot, which is created on its first receipt. Serialized products are received unit by
+// unit: each serial number names a new unit or a shipped one coming back. The stock is
+// put away to the bin given, or else to the item's home bin and the bins already holding
+// it as far as their capacity goes; what does not fit is left to be put away later.
+// A quantity given in another unit, such as cases, is received as the base units it
+// converts to.
+func (uc *StockMovementUseCase) Replenish(ctx context.Context, in ReplenishInput) (*StockMovementDetails, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	correlationID := CorrelationIDFromContext(ctx)
+	referenceType := strings.ToUpper(in.ReferenceType)
+
+	var result *StockMovementDetails
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		item, err := uc.stockItems.GetByID(ctx, in.StockItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get stock item %s: %w", in.StockItemID, err)
+		}
+		details, err := loader.stockItemDetails(ctx, item)
+		if err != nil {
+			return err
+		}
+		product, err := loader.product(ctx, item.ProductID)
+		if err != nil {
+			return err
+		}
+		quantity, err := uc.units.toBase(ctx, product, in.Quantity, in.Unit)
+		if err != nil {
+			return err
+		}
+
+		var lot *entity.Lot
+		if in.Lot != nil {
+			if lot, err = uc.lots.receive(ctx, item.ID, *in.Lot); err != nil {
+				return err
+			}
+		}
+
+		before := snapshotOf(item)
+		if err := item.Replenish(quantity); err != nil {
+			return err
+		}
+		if err := uc.stockItems.UpdateWithLock(ctx, item, item.Version); err != nil {
+			return fmt.Errorf("failed to update stock item: %w", err)
+		}
+
+		movement, err := newMovement(item, before, entity.MovementTypeReplenishment, quantity,
+			in.ReferenceID, referenceType, in.Notes, in.PerformedBy)
+		if err != nil {
+			return err
+		}
+		if err := uc.movements.Create(ctx, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+		if err := uc.lots.post(ctx, movement, lot); err != nil {
+			return fmt.Errorf("failed to post lot movements: %w", err)
+		}
+		if err := uc.serials.post(ctx, product, movement, in.SerialNumbers); err != nil {
+			return err
+		}
+		putaway, err := uc.bins.post(ctx, item, movement, in.BinLocationID)
+		if err != nil {
+			return fmt.Errorf("failed to put away stock: %w", err)
+		}
+		if err := publishMovementRecorded(ctx, uc.publisher, movement, details, correlationID); err != nil {
+			return err
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.StockReplenishedEvent{
+			EventID:       meta.EventID,
+			CorrelationID: meta.CorrelationID,
+			Timestamp:     meta.Timestamp,
+			Version:       meta.Version,
+			MovementID:    movement.ID,
+			WarehouseID:   item.WarehouseID,
+			ReferenceNum:  in.ReferenceID,
+			Items: []event.StockReplenishedItemDetail{{
+				ProductID:           item.ProductID,
+				SKU:                 details.SKU,
+				QuantityReplenished: quantity,
+				NewStockLevel:       item.QuantityOnHand,
+			}},
+		}
+		if err := publishEvent(ctx, uc.publisher, AggregateTypeStockMovement, evt, meta); err != nil {
+			return err
+		}
+
+		result = movementDetails(movement, details)
+		result.Lot = lot
+		result.Putaway = putaway
+		result.FilledBackorders, err = uc.backorders.fill(ctx, item.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List retrieves stock movements matching the filter along with the total match count
+func (uc *StockMovementUseCase) List(ctx context.Context, filter repository.StockMovementFilter) ([]*StockMovementDetails, int, error) {
+	movements, total, err := uc.movements.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list stock movements: %w", err)
+	}
+
+	details, err := uc.enrich(ctx, movements)
+	if err != nil {
+		return nil, 0, err
+	}
+	return details, total, nil
+}
+
+// ListByStockItem retrieves the movement history of a single stock item
+func (uc *StockMovementUseCase) ListByStockItem(ctx context.Context, stockItemID string, limit, offset int) ([]*StockMovementDetails, int, error) {
+	if _, err := uc.stockItems.GetByID(ctx, stockItemID); err != nil {
+		return nil, 0, fmt.Errorf("failed to get stock item %s: %w", stockItemID, err)
+	}
+
+	movements, total, err := uc.movements.GetByStockItem(ctx, stockItemID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list stock movements: %w", err)
+	}
+
+	details, err := uc.enrich(ctx, movements)
+	if err != nil {
+		return nil, 0, err
+	}
+	return details, total, nil
+}
+
+func (uc *StockMovementUseCase) enrich(ctx context.Context, movements []*entity.StockMovement) ([]*StockMovementDetails, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	items := make(map[string]*StockItemDetails)
+
+	result := make([]*StockMovementDetails, 0, len(movements))
+	for _, movement := range movements {
+		item, ok := items[movement.StockItemID]
+		if !ok {
+			stockItem, err := uc.stockItems.GetByID(ctx, movement.StockItemID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get stock item %s: %w", movement.StockItemID, err)
+			}
+			if item, err = loader.stockItemDetails(ctx, stockItem); err != nil {
+				return nil, err
+			}
+			items[movement.StockItemID] = item
+		}
+		result = append(result, movementDetails(movement, item))
+	}
+	return result, nil
+}
+
+func movementDetails(movement *entity.StockMovement, item *StockItemDetails) *StockMovementDetails {
+	return &StockMovementDetails{
+		StockMovement: movement,
+		ProductID:     item.ProductID,
+		SKU:           item.SKU,
+		VariantSKU:    item.VariantSKU,
+		ProductName:   item.ProductName,
+		WarehouseID:   item.WarehouseID,
+		WarehouseName: item.WarehouseName,
+	}
+}
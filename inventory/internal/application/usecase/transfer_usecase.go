@@ -0,0 +1,409 @@
+// file: internal/application/usecase/transfer_usecase.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// TransferItemInput is the quantity of one product in a transfer request
+type TransferItemInput struct {
+	ProductID string
+	Quantity  int
+}
+
+// CreateTransferInput carries the data required to draft a transfer between two warehouses
+type CreateTransferInput struct {
+	SourceWarehouseID      string
+	DestinationWarehouseID string
+	Items                  []TransferItemInput
+	Notes                  string
+	PerformedBy            string
+}
+
+// ReceiveTransferInput carries the quantities the destination counted. Products left out
+// of Items arrived in full.
+type ReceiveTransferInput struct {
+	TransferID  string
+	Items       []TransferItemInput
+	PerformedBy string
+}
+
+// TransferDetails is a transfer together with the backorders its receipt reserved stock for
+type TransferDetails struct {
+	*entity.Transfer
+	FilledBackorders []*entity.Backorder // Set by Receive only
+}
+
+// TransferUseCase orchestrates stock transfers between warehouses. Dispatch takes the
+// stock out of the source stock items and receipt credits the destination stock items,
+// each recording TRANSFER movements that reference the transfer ID.
+type TransferUseCase struct {
+	tx         port.TransactionManager
+	products   repository.ProductRepository
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+	movements  repository.StockMovementRepository
+	lots       *lotLedger
+	bins       *binLedger
+	kits       *kitStock
+	transfers  repository.TransferRepository
+	publisher  port.EventPublisher
+	backorders *BackorderUseCase
+	retry      RetryPolicy
+}
+
+// NewTransferUseCase creates a new TransferUseCase
+func NewTransferUseCase(
+	tx port.TransactionManager,
+	products repository.ProductRepository,
+	warehouses repository.WarehouseRepository,
+	stockItems repository.StockItemRepository,
+	movements repository.StockMovementRepository,
+	lots repository.LotRepository,
+	locations repository.LocationRepository,
+	kits repository.KitRepository,
+	transfers repository.TransferRepository,
+	publisher port.EventPublisher,
+	backorders *BackorderUseCase,
+	retry RetryPolicy,
+) *TransferUseCase {
+	return &TransferUseCase{
+		tx:         tx,
+		products:   products,
+		warehouses: warehouses,
+		stockItems: stockItems,
+		movements:  movements,
+		lots:       newLotLedger(lots),
+		bins:       newBinLedger(locations),
+		kits:       newKitStock(kits, stockItems),
+		transfers:  transfers,
+		publisher:  publisher,
+		backorders: backorders,
+		retry:      retry,
+	}
+}
+
+// Create drafts a transfer. Both warehouses must be active and stock every product;
+// no stock moves until the transfer is dispatched.
+func (uc *TransferUseCase) Create(ctx context.Context, in CreateTransferInput) (*entity.Transfer, error) {
+	loader := newReferenceLoader(uc.products, uc.warehouses)
+	for _, id := range []string{in.SourceWarehouseID, in.DestinationWarehouseID} {
+		warehouse, err := loader.warehouse(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if warehouse.IsDeleted() || !warehouse.IsActive {
+			return nil, fmt.Errorf("warehouse %s: %w", id, ErrWarehouseInactive)
+		}
+	}
+
+	items := make([]entity.TransferItem, 0, len(in.Items))
+	for _, line := range in.Items {
+		product, err := loader.product(ctx, line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if product.IsDeleted() || !product.IsActive {
+			return nil, fmt.Errorf("product %s: %w", product.ID, ErrProductInactive)
+		}
+		if product.Serialized {
+			return nil, fmt.Errorf("product %s: %w", product.ID, ErrSerializedMovement)
+		}
+		source, err := uc.stockItemIn(ctx, product.ID, in.SourceWarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		destination, err := uc.stockItemIn(ctx, product.ID, in.DestinationWarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, entity.TransferItem{
+			ProductID:              product.ID,
+			SourceStockItemID:      source.ID,
+			DestinationStockItemID: destination.ID,
+			Quantity:               line.Quantity,
+		})
+	}
+
+	transfer, err := entity.NewTransfer(uuid.NewString(), in.SourceWarehouseID, in.DestinationWarehouseID,
+		items, in.Notes, in.PerformedBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.transfers.Create(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+// GetByID retrieves a transfer by its ID
+func (uc *TransferUseCase) GetByID(ctx context.Context, id string) (*entity.Transfer, error) {
+	transfer, err := uc.transfers.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer %s: %w", id, err)
+	}
+	return transfer, nil
+}
+
+// List retrieves transfers matching the filter along with the total match count
+func (uc *TransferUseCase) List(ctx context.Context, filter repository.TransferFilter) ([]*entity.Transfer, int, error) {
+	transfers, total, err := uc.transfers.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list transfers: %w", err)
+	}
+	return transfers, total, nil
+}
+
+// Dispatch takes the transfer's quantities out of the source stock items. They count as
+// in transit, available in neither warehouse, until the transfer is received.
+func (uc *TransferUseCase) Dispatch(ctx context.Context, id, performedBy string) (*entity.Transfer, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+
+	var result *entity.Transfer
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		transfer, err := uc.transfers.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get transfer %s: %w", id, err)
+		}
+		if err := transfer.Dispatch(); err != nil {
+			return err
+		}
+		destination, err := loader.warehouse(ctx, transfer.DestinationWarehouseID)
+		if err != nil {
+			return err
+		}
+
+		details := make([]event.TransferItemDetail, 0, len(transfer.Items))
+		for _, line := range transfer.Items {
+			item, err := uc.move(ctx, loader, transfer, line.SourceStockItemID, -line.Quantity,
+				"transfer to "+destination.Name, performedBy, correlationID)
+			if err != nil {
+				return err
+			}
+			details = append(details, event.TransferItemDetail{
+				ProductID: line.ProductID,
+				SKU:       item.SKU,
+				Quantity:  line.Quantity,
+			})
+		}
+		if err := uc.transfers.Update(ctx, transfer); err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.TransferDispatchedEvent{
+			EventID:                meta.EventID,
+			CorrelationID:          meta.CorrelationID,
+			Timestamp:              meta.Timestamp,
+			Version:                meta.Version,
+			TransferID:             transfer.ID,
+			SourceWarehouseID:      transfer.SourceWarehouseID,
+			DestinationWarehouseID: transfer.DestinationWarehouseID,
+			Items:                  details,
+			PerformedBy:            performedBy,
+		}
+		if err := publishEvent(ctx, uc.publisher, AggregateTypeTransfer, evt, meta); err != nil {
+			return err
+		}
+		result = transfer
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MarkInTransit records that the carrier picked a dispatched transfer up
+func (uc *TransferUseCase) MarkInTransit(ctx context.Context, id string) (*entity.Transfer, error) {
+	var result *entity.Transfer
+	err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		transfer, err := uc.transfers.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get transfer %s: %w", id, err)
+		}
+		if err := transfer.MarkInTransit(); err != nil {
+			return err
+		}
+		if err := uc.transfers.Update(ctx, transfer); err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+		result = transfer
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Receive credits the destination stock items with the quantities that arrived and
+// reserves them for open backorders there. A short receipt closes the transfer as a
+// DISCREPANCY; the missing quantity is not credited anywhere.
+func (uc *TransferUseCase) Receive(ctx context.Context, in ReceiveTransferInput) (*TransferDetails, error) {
+	correlationID := CorrelationIDFromContext(ctx)
+	received := make([]entity.ProductQuantity, 0, len(in.Items))
+	for _, item := range in.Items {
+		received = append(received, entity.ProductQuantity{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	var result *TransferDetails
+	err := withinRetriedTransaction(ctx, uc.tx, uc.retry, func(ctx context.Context) error {
+		loader := newReferenceLoader(uc.products, uc.warehouses)
+		transfer, err := uc.transfers.GetByIDForUpdate(ctx, in.TransferID)
+		if err != nil {
+			return fmt.Errorf("failed to get transfer %s: %w", in.TransferID, err)
+		}
+		if err := transfer.Receive(received); err != nil {
+			return err
+		}
+		source, err := loader.warehouse(ctx, transfer.SourceWarehouseID)
+		if err != nil {
+			return err
+		}
+
+		result = &TransferDetails{Transfer: transfer}
+		details := make([]event.TransferItemDetail, 0, len(transfer.Items))
+		for _, line := range transfer.Items {
+			detail := event.TransferItemDetail{
+				ProductID:        line.ProductID,
+				Quantity:         line.Quantity,
+				ReceivedQuantity: line.ReceivedQuantity,
+			}
+			if line.ReceivedQuantity > 0 {
+				item, err := uc.move(ctx, loader, transfer, line.DestinationStockItemID, line.ReceivedQuantity,
+					"transfer from "+source.Name, in.PerformedBy, correlationID)
+				if err != nil {
+					return err
+				}
+				detail.SKU = item.SKU
+			} else {
+				product, err := loader.product(ctx, line.ProductID)
+				if err != nil {
+					return err
+				}
+				detail.SKU = product.SKU
+			}
+			details = append(details, detail)
+		}
+		if err := uc.transfers.Update(ctx, transfer); err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+
+		meta := newEventMetadata(correlationID)
+		evt := event.TransferReceivedEvent{
+			EventID:                meta.EventID,
+			CorrelationID:          meta.CorrelationID,
+			Timestamp:              meta.Timestamp,
+			Version:                meta.Version,
+			TransferID:             transfer.ID,
+			SourceWarehouseID:      transfer.SourceWarehouseID,
+			DestinationWarehouseID: transfer.DestinationWarehouseID,
+			Items:                  details,
+			ShortQuantity:          transfer.ShortQuantity(),
+			Status:                 string(transfer.Status),
+			PerformedBy:            in.PerformedBy,
+		}
+		if err := publishEvent(ctx, uc.publisher, AggregateTypeTransfer, evt, meta); err != nil {
+			return err
+		}
+
+		for _, line := range transfer.Items {
+			if line.ReceivedQuantity == 0 {
+				continue
+			}
+			filled, err := uc.backorders.fill(ctx, line.DestinationStockItemID)
+			if err != nil {
+				return err
+			}
+			result.FilledBackorders = append(result.FilledBackorders, filled...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// move applies one side of a transfer to a stock item, withdrawing stock for a negative
+// quantity and crediting it for a positive one, and records the TRANSFER movement
+func (uc *TransferUseCase) move(
+	ctx context.Context,
+	loader *referenceLoader,
+	transfer *entity.Transfer,
+	stockItemID string,
+	quantity int,
+	reason, performedBy, correlationID string,
+) (*StockItemDetails, error) {
+	stockItem, err := uc.stockItems.GetByID(ctx, stockItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock item %s: %w", stockItemID, err)
+	}
+	item, err := loader.stockItemDetails(ctx, stockItem)
+	if err != nil {
+		return nil, err
+	}
+
+	before := snapshotOf(stockItem)
+	if quantity < 0 {
+		err = stockItem.Withdraw(-quantity)
+	} else {
+		err = stockItem.Replenish(quantity)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("product %s: %w", item.ProductID, err)
+	}
+	if err := uc.stockItems.UpdateWithLock(ctx, stockItem, stockItem.Version); err != nil {
+		return nil, fmt.Errorf("failed to update stock item: %w", err)
+	}
+
+	movement, err := newMovement(stockItem, before, entity.MovementTypeTransfer, quantity,
+		transfer.ID, ReferenceTypeTransfer, reason, performedBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.movements.Create(ctx, movement); err != nil {
+		return nil, fmt.Errorf("failed to record stock movement: %w", err)
+	}
+	if err := uc.lots.post(ctx, movement, nil); err != nil {
+		return nil, fmt.Errorf("failed to post lot movements: %w", err)
+	}
+	if _, err := uc.bins.post(ctx, stockItem, movement, ""); err != nil {
+		return nil, fmt.Errorf("failed to post bin movements: %w", err)
+	}
+	if err := publishMovementRecorded(ctx, uc.publisher, movement, item, correlationID); err != nil {
+		return nil, err
+	}
+
+	product, err := loader.product(ctx, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if err := publishLowStockAlert(ctx, uc.publisher, item, before, product.MinStock, correlationID); err != nil {
+		return nil, err
+	}
+	return item, uc.kits.publishLowStockAlerts(ctx, loader, uc.publisher, item, before, correlationID)
+}
+
+// stockItemIn looks up the stock item of a product in a warehouse
+func (uc *TransferUseCase) stockItemIn(ctx context.Context, productID, warehouseID string) (*entity.StockItem, error) {
+	item, err := uc.stockItems.GetByProductAndWarehouse(ctx, productID, warehouseID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("product %s in warehouse %s: %w", productID, warehouseID, ErrNotStockedInWarehouse)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock item: %w", err)
+	}
+	return item, nil
+}
@@ -0,0 +1,53 @@
+// file: internal/application/usecase/unit_converter.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// unitConverter normalizes quantities given in a unit of measure to the base unit of a
+// product, which is what stock items, movements and reservations are kept in
+type unitConverter struct {
+	units repository.UnitOfMeasureRepository
+}
+
+func newUnitConverter(units repository.UnitOfMeasureRepository) *unitConverter {
+	return &unitConverter{units: units}
+}
+
+// toBase converts quantity of unit into base units of product. No unit means the base
+// unit. A variant converts units it has no conversion of its own for as its base product
+// does. Quantities that do not make up whole base units are rejected.
+func (c *unitConverter) toBase(ctx context.Context, product *entity.Product, quantity int, unit string) (int, error) {
+	if unit == "" {
+		return quantity, nil
+	}
+	code, err := entity.NormalizeUnitCode(unit)
+	if err != nil {
+		return 0, err
+	}
+	if code == product.BaseUnit {
+		return quantity, nil
+	}
+
+	conversion, err := c.units.GetConversion(ctx, product.ID, code)
+	if errors.Is(err, repository.ErrNotFound) && product.IsVariant() {
+		conversion, err = c.units.GetConversion(ctx, product.ParentID, code)
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return 0, fmt.Errorf("unit %s for product %s: %w", code, product.SKU, ErrUnitNotConvertible)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get conversion of unit %s for product %s: %w", code, product.ID, err)
+	}
+	base, err := conversion.ToBase(quantity)
+	if err != nil {
+		return 0, fmt.Errorf("%d %s of product %s: %w", quantity, code, product.SKU, err)
+	}
+	return base, nil
+}
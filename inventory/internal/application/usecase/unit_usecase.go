@@ -0,0 +1,135 @@
+// file: internal/application/usecase/unit_usecase.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// CreateUnitInput carries the data required to add a unit of measure to the catalog
+type CreateUnitInput struct {
+	Code string
+	Name string
+}
+
+// SetConversionInput carries the conversion of a unit into the base unit of a product:
+// Quantity of the unit are BaseQuantity base units
+type SetConversionInput struct {
+	Quantity     int
+	BaseQuantity int
+}
+
+// UnitUseCase manages the unit of measure catalog and the conversions of units into the
+// base units of products
+type UnitUseCase struct {
+	products repository.ProductRepository
+	units    repository.UnitOfMeasureRepository
+}
+
+// NewUnitUseCase creates a new UnitUseCase
+func NewUnitUseCase(products repository.ProductRepository, units repository.UnitOfMeasureRepository) *UnitUseCase {
+	return &UnitUseCase{products: products, units: units}
+}
+
+// CreateUnit adds a unit of measure to the catalog. Codes are unique.
+func (uc *UnitUseCase) CreateUnit(ctx context.Context, in CreateUnitInput) (*entity.UnitOfMeasure, error) {
+	unit, err := entity.NewUnitOfMeasure(in.Code, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.units.Create(ctx, unit); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("unit %s: %w", unit.Code, ErrUnitExists)
+		}
+		return nil, fmt.Errorf("failed to create unit of measure: %w", err)
+	}
+	return unit, nil
+}
+
+// ListUnits retrieves the unit of measure catalog
+func (uc *UnitUseCase) ListUnits(ctx context.Context) ([]*entity.UnitOfMeasure, error) {
+	units, err := uc.units.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list units of measure: %w", err)
+	}
+	return units, nil
+}
+
+// SetConversion creates or replaces the conversion of a catalog unit into the base unit
+// of a live product. A conversion of a base product also applies to its variants unless
+// they have one of their own.
+func (uc *UnitUseCase) SetConversion(ctx context.Context, productID, unitCode string, in SetConversionInput) (*entity.UnitConversion, error) {
+	product, err := uc.getActive(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	conversion, err := entity.NewUnitConversion(product, unitCode, in.Quantity, in.BaseQuantity)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.units.GetByCode(ctx, conversion.UnitCode); err != nil {
+		return nil, fmt.Errorf("failed to get unit of measure %s: %w", conversion.UnitCode, err)
+	}
+
+	existing, err := uc.units.GetConversion(ctx, product.ID, conversion.UnitCode)
+	switch {
+	case err == nil:
+		conversion.CreatedAt = existing.CreatedAt
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, fmt.Errorf("failed to get conversion of unit %s: %w", conversion.UnitCode, err)
+	}
+	if err := uc.units.SaveConversion(ctx, conversion); err != nil {
+		return nil, fmt.Errorf("failed to save conversion of unit %s: %w", conversion.UnitCode, err)
+	}
+	return conversion, nil
+}
+
+// ListConversions retrieves the conversions that apply to a live product, ordered by
+// unit code: its own and, for a variant, those of its base product it does not override
+func (uc *UnitUseCase) ListConversions(ctx context.Context, productID string) ([]*entity.UnitConversion, error) {
+	product, err := uc.getActive(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	conversions, err := uc.units.ListConversions(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unit conversions of product %s: %w", product.ID, err)
+	}
+	if !product.IsVariant() {
+		return conversions, nil
+	}
+
+	inherited, err := uc.units.ListConversions(ctx, product.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unit conversions of product %s: %w", product.ParentID, err)
+	}
+	for _, c := range inherited {
+		overridden := slices.ContainsFunc(conversions, func(own *entity.UnitConversion) bool {
+			return own.UnitCode == c.UnitCode
+		})
+		if !overridden {
+			conversions = append(conversions, c)
+		}
+	}
+	slices.SortFunc(conversions, func(a, b *entity.UnitConversion) int {
+		return strings.Compare(a.UnitCode, b.UnitCode)
+	})
+	return conversions, nil
+}
+
+func (uc *UnitUseCase) getActive(ctx context.Context, id string) (*entity.Product, error) {
+	product, err := uc.products.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %s: %w", id, err)
+	}
+	if product.IsDeleted() {
+		return nil, fmt.Errorf("product %s: %w", id, repository.ErrNotFound)
+	}
+	return product, nil
+}
@@ -0,0 +1,157 @@
+// file: internal/application/usecase/warehouse_usecase.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// CreateWarehouseInput carries the data required to create a warehouse
+type CreateWarehouseInput struct {
+	Code     string
+	Name     string
+	Address  entity.WarehouseAddress
+	Priority int
+}
+
+// UpdateWarehouseInput carries a partial warehouse update; nil fields are left unchanged
+type UpdateWarehouseInput struct {
+	Name     *string
+	Address  *entity.WarehouseAddress
+	Priority *int
+}
+
+// WarehouseDetails is a warehouse together with the number of products it stocks
+type WarehouseDetails struct {
+	*entity.Warehouse
+	TotalProducts int
+}
+
+// WarehouseUseCase orchestrates warehouse management operations
+type WarehouseUseCase struct {
+	warehouses repository.WarehouseRepository
+	stockItems repository.StockItemRepository
+}
+
+// NewWarehouseUseCase creates a new WarehouseUseCase
+func NewWarehouseUseCase(warehouses repository.WarehouseRepository, stockItems repository.StockItemRepository) *WarehouseUseCase {
+	return &WarehouseUseCase{
+		warehouses: warehouses,
+		stockItems: stockItems,
+	}
+}
+
+// Create registers a new warehouse with a unique code
+func (uc *WarehouseUseCase) Create(ctx context.Context, in CreateWarehouseInput) (*WarehouseDetails, error) {
+	exists, err := uc.warehouses.ExistsByCode(ctx, in.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check warehouse code uniqueness: %w", err)
+	}
+	if exists {
+		return nil, ErrWarehouseCodeExists
+	}
+
+	warehouse, err := entity.NewWarehouse(uuid.NewString(), in.Code, in.Name, in.Address, in.Priority)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.warehouses.Create(ctx, warehouse); err != nil {
+		return nil, fmt.Errorf("failed to create warehouse: %w", err)
+	}
+
+	return &WarehouseDetails{Warehouse: warehouse}, nil
+}
+
+// GetByID retrieves a warehouse that has not been deleted
+func (uc *WarehouseUseCase) GetByID(ctx context.Context, id string) (*WarehouseDetails, error) {
+	warehouse, err := uc.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withProductCount(ctx, warehouse)
+}
+
+// List retrieves warehouses matching the filter along with the total match count
+func (uc *WarehouseUseCase) List(ctx context.Context, filter repository.WarehouseFilter) ([]*WarehouseDetails, int, error) {
+	warehouses, total, err := uc.warehouses.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list warehouses: %w", err)
+	}
+
+	details := make([]*WarehouseDetails, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		d, err := uc.withProductCount(ctx, warehouse)
+		if err != nil {
+			return nil, 0, err
+		}
+		details = append(details, d)
+	}
+	return details, total, nil
+}
+
+// Update applies a partial update to a warehouse
+func (uc *WarehouseUseCase) Update(ctx context.Context, id string, in UpdateWarehouseInput) (*WarehouseDetails, error) {
+	warehouse, err := uc.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name, address, priority := warehouse.Name, warehouse.Address, warehouse.Priority
+	if in.Name != nil {
+		name = *in.Name
+	}
+	if in.Address != nil {
+		address = *in.Address
+	}
+	if in.Priority != nil {
+		priority = *in.Priority
+	}
+
+	if err := warehouse.Update(name, address, priority); err != nil {
+		return nil, err
+	}
+	if err := uc.warehouses.Update(ctx, warehouse); err != nil {
+		return nil, fmt.Errorf("failed to update warehouse: %w", err)
+	}
+
+	return uc.withProductCount(ctx, warehouse)
+}
+
+// Delete soft deletes a warehouse
+func (uc *WarehouseUseCase) Delete(ctx context.Context, id string) error {
+	warehouse, err := uc.getActive(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := warehouse.SoftDelete(); err != nil {
+		return err
+	}
+	if err := uc.warehouses.Delete(ctx, warehouse.ID); err != nil {
+		return fmt.Errorf("failed to delete warehouse: %w", err)
+	}
+	return nil
+}
+
+func (uc *WarehouseUseCase) getActive(ctx context.Context, id string) (*entity.Warehouse, error) {
+	warehouse, err := uc.warehouses.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouse %s: %w", id, err)
+	}
+	if warehouse.IsDeleted() {
+		return nil, fmt.Errorf("warehouse %s: %w", id, repository.ErrNotFound)
+	}
+	return warehouse, nil
+}
+
+func (uc *WarehouseUseCase) withProductCount(ctx context.Context, warehouse *entity.Warehouse) (*WarehouseDetails, error) {
+	_, total, err := uc.stockItems.List(ctx, repository.StockItemFilter{WarehouseID: &warehouse.ID, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stock items for warehouse %s: %w", warehouse.ID, err)
+	}
+	return &WarehouseDetails{Warehouse: warehouse, TotalProducts: total}, nil
+}
@@ -0,0 +1,141 @@
+// file: internal/domain/entity/adjustment.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// AdjustmentStatus represents the current state of a stock adjustment
+type AdjustmentStatus string
+
+const (
+	AdjustmentStatusPendingApproval AdjustmentStatus = "PENDING_APPROVAL"
+	AdjustmentStatusApplied         AdjustmentStatus = "APPLIED"
+	AdjustmentStatusRejected        AdjustmentStatus = "REJECTED"
+)
+
+// Adjustment is a manual correction of a stock item's on-hand quantity, such as
+// shrinkage, damage or found stock. Adjustments start pending and are applied either
+// straight away or once a reviewer approves them.
+type Adjustment struct {
+	ID          string
+	StockItemID string
+	Quantity    int // Positive for found stock, negative for losses
+	ReasonCode  string
+	Notes       string
+	UnitCost    *float64 // Cost per unit, when the requester knows it
+	Status      AdjustmentStatus
+	MovementID  string // Movement that applied the adjustment
+	RequestedBy string
+	ReviewedBy  string
+	ReviewNotes string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ReviewedAt  *time.Time
+	AppliedAt   *time.Time
+}
+
+// Adjustment validation errors
+var (
+	ErrAdjustmentIDRequired        = errors.New("adjustment ID is required")
+	ErrAdjustmentStockItemRequired = errors.New("adjustment stock item ID is required")
+	ErrAdjustmentQuantityZero      = errors.New("adjustment quantity cannot be zero")
+	ErrAdjustmentReasonRequired    = errors.New("adjustment reason code is required")
+	ErrAdjustmentUnitCostNegative  = errors.New("adjustment unit cost cannot be negative")
+	ErrAdjustmentNotPending        = errors.New("adjustment is not pending approval")
+	ErrAdjustmentSelfApproval      = errors.New("an adjustment cannot be reviewed by its requester")
+)
+
+// NewAdjustment creates a new pending Adjustment with validation
+func NewAdjustment(id, stockItemID string, quantity int, reasonCode, notes string, unitCost *float64, requestedBy string) (*Adjustment, error) {
+	if id == "" {
+		return nil, ErrAdjustmentIDRequired
+	}
+	if stockItemID == "" {
+		return nil, ErrAdjustmentStockItemRequired
+	}
+	if quantity == 0 {
+		return nil, ErrAdjustmentQuantityZero
+	}
+	if reasonCode == "" {
+		return nil, ErrAdjustmentReasonRequired
+	}
+	if unitCost != nil && *unitCost < 0 {
+		return nil, ErrAdjustmentUnitCostNegative
+	}
+
+	now := time.Now().UTC()
+	return &Adjustment{
+		ID:          id,
+		StockItemID: stockItemID,
+		Quantity:    quantity,
+		ReasonCode:  reasonCode,
+		Notes:       notes,
+		UnitCost:    unitCost,
+		Status:      AdjustmentStatusPendingApproval,
+		RequestedBy: requestedBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Approve records the reviewer's approval of a pending adjustment. The adjustment
+// stays pending until MarkApplied records the movement that applied it.
+func (a *Adjustment) Approve(reviewer, notes string) error {
+	return a.review(reviewer, notes)
+}
+
+// Reject declines a pending adjustment; it is never applied
+func (a *Adjustment) Reject(reviewer, notes string) error {
+	if err := a.review(reviewer, notes); err != nil {
+		return err
+	}
+	a.Status = AdjustmentStatusRejected
+	return nil
+}
+
+func (a *Adjustment) review(reviewer, notes string) error {
+	if a.Status != AdjustmentStatusPendingApproval {
+		return ErrAdjustmentNotPending
+	}
+	if reviewer != "" && reviewer == a.RequestedBy {
+		return ErrAdjustmentSelfApproval
+	}
+
+	now := time.Now().UTC()
+	a.ReviewedBy = reviewer
+	a.ReviewNotes = notes
+	a.ReviewedAt = &now
+	a.UpdatedAt = now
+	return nil
+}
+
+// MarkApplied records the movement that applied a pending adjustment to its stock item
+func (a *Adjustment) MarkApplied(movementID string) error {
+	if a.Status != AdjustmentStatusPendingApproval {
+		return ErrAdjustmentNotPending
+	}
+
+	now := time.Now().UTC()
+	a.Status = AdjustmentStatusApplied
+	a.MovementID = movementID
+	a.AppliedAt = &now
+	a.UpdatedAt = now
+	return nil
+}
+
+// Value returns the absolute value of the adjusted stock and whether it is known
+func (a *Adjustment) Value() (float64, bool) {
+	if a.UnitCost == nil {
+		return 0, false
+	}
+	return float64(abs(a.Quantity)) * *a.UnitCost, true
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,114 @@
+// file: internal/domain/entity/backorder.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// BackorderStatus represents the current state of a backorder
+type BackorderStatus string
+
+const (
+	BackorderStatusOpen      BackorderStatus = "OPEN"
+	BackorderStatusFilled    BackorderStatus = "FILLED"
+	BackorderStatusCancelled BackorderStatus = "CANCELLED"
+)
+
+// Backorder is a quantity an order asked for that could not be reserved and is
+// waiting for stock. Arriving stock is reserved for it piece by piece until the whole
+// quantity is reserved and the backorder is FILLED.
+type Backorder struct {
+	ID               string
+	OrderID          string
+	ProductID        string
+	WarehouseID      string // Preferred warehouse; empty when any warehouse may serve it
+	Quantity         int
+	ReservedQuantity int // Part of Quantity already converted into reservations
+	Priority         int // Higher priorities are served first when the queue is priority ordered
+	Status           BackorderStatus
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Backorder validation errors
+var (
+	ErrBackorderIDRequired      = errors.New("backorder ID is required")
+	ErrBackorderOrderRequired   = errors.New("backorder order ID is required")
+	ErrBackorderProductRequired = errors.New("backorder product ID is required")
+	ErrBackorderQuantity        = errors.New("backorder quantity must be positive")
+	ErrBackorderNotOpen         = errors.New("backorder is not open")
+	ErrBackorderFillQuantity    = errors.New("fill quantity must be positive and at most the remaining backorder quantity")
+)
+
+// NewBackorder creates a new open Backorder with validation
+func NewBackorder(id, orderID, productID, warehouseID string, quantity, priority int) (*Backorder, error) {
+	if id == "" {
+		return nil, ErrBackorderIDRequired
+	}
+	if orderID == "" {
+		return nil, ErrBackorderOrderRequired
+	}
+	if productID == "" {
+		return nil, ErrBackorderProductRequired
+	}
+	if quantity <= 0 {
+		return nil, ErrBackorderQuantity
+	}
+
+	now := time.Now().UTC()
+	return &Backorder{
+		ID:          id,
+		OrderID:     orderID,
+		ProductID:   productID,
+		WarehouseID: warehouseID,
+		Quantity:    quantity,
+		Priority:    priority,
+		Status:      BackorderStatusOpen,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Cancel withdraws an open backorder
+func (b *Backorder) Cancel() error {
+	if b.Status != BackorderStatusOpen {
+		return ErrBackorderNotOpen
+	}
+	b.Status = BackorderStatusCancelled
+	b.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// RemainingQuantity returns the quantity still waiting for stock
+func (b *Backorder) RemainingQuantity() int {
+	return b.Quantity - b.ReservedQuantity
+}
+
+// Fill records that quantity of the backorder was reserved, completing it once
+// nothing remains
+func (b *Backorder) Fill(quantity int) error {
+	if b.Status != BackorderStatusOpen {
+		return ErrBackorderNotOpen
+	}
+	if quantity <= 0 || quantity > b.RemainingQuantity() {
+		return ErrBackorderFillQuantity
+	}
+
+	b.ReservedQuantity += quantity
+	if b.RemainingQuantity() == 0 {
+		b.Status = BackorderStatusFilled
+	}
+	b.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Reprioritize changes the queue priority of an open backorder
+func (b *Backorder) Reprioritize(priority int) error {
+	if b.Status != BackorderStatusOpen {
+		return ErrBackorderNotOpen
+	}
+	b.Priority = priority
+	b.UpdatedAt = time.Now().UTC()
+	return nil
+}
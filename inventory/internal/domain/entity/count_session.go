@@ -0,0 +1,247 @@
+// file: internal/domain/entity/count_session.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// CountSessionStatus represents the current state of a cycle count
+type CountSessionStatus string
+
+const (
+	CountSessionStatusOpen      CountSessionStatus = "OPEN"      // Counting in progress
+	CountSessionStatusSubmitted CountSessionStatus = "SUBMITTED" // Counted, variances await review
+	CountSessionStatusPosted    CountSessionStatus = "POSTED"    // Approved variances posted as adjustments
+	CountSessionStatusCancelled CountSessionStatus = "CANCELLED"
+)
+
+// CountLine is the count of one stock item within a count session
+type CountLine struct {
+	StockItemID      string
+	ProductID        string
+	ExpectedQuantity int  // On-hand quantity when the line was last counted, or when the session opened
+	CountedQuantity  *int // Nil until counted
+	CountedBy        string
+	CountedAt        *time.Time
+	Approved         bool   // Variance approved for posting
+	MovementID       string // ADJUSTMENT movement that posted the variance
+}
+
+// Counted returns true once a quantity has been recorded for the line
+func (l CountLine) Counted() bool {
+	return l.CountedQuantity != nil
+}
+
+// Variance returns the counted quantity minus the expected quantity; positive when
+// more stock was found than recorded. Uncounted lines have no variance.
+func (l CountLine) Variance() int {
+	if l.CountedQuantity == nil {
+		return 0
+	}
+	return *l.CountedQuantity - l.ExpectedQuantity
+}
+
+// CountSession is a physical count of stock items in one warehouse. Counters record
+// what they find on the shelf; once submitted, a reviewer approves the variances that
+// are posted as adjustments to the stock items' on-hand quantities.
+type CountSession struct {
+	ID          string
+	WarehouseID string
+	Blind       bool // Hide expected quantities from counters until the count is submitted
+	Freeze      bool // Stop on-hand changes to the counted stock items until the count ends
+	Lines       []CountLine
+	Status      CountSessionStatus
+	Notes       string
+	CreatedBy   string
+	ReviewedBy  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	SubmittedAt *time.Time
+	PostedAt    *time.Time
+}
+
+// Count session validation errors
+var (
+	ErrCountSessionIDRequired   = errors.New("count session ID is required")
+	ErrCountWarehouseRequired   = errors.New("count session warehouse ID is required")
+	ErrCountLinesRequired       = errors.New("count session must include at least one stock item")
+	ErrCountDuplicateStockItem  = errors.New("count session lists a stock item more than once")
+	ErrCountQuantityNegative    = errors.New("counted quantity cannot be negative")
+	ErrCountStockItemNotListed  = errors.New("stock item is not part of the count session")
+	ErrCountSessionNotOpen      = errors.New("count session is not open")
+	ErrCountSessionNotSubmitted = errors.New("count session is not submitted")
+	ErrCountSessionIncomplete   = errors.New("every stock item must be counted before submitting")
+	ErrCountSessionClosed       = errors.New("count session is already posted or cancelled")
+)
+
+// NewCountSession creates a new open CountSession with validation
+func NewCountSession(id, warehouseID string, lines []CountLine, blind, freeze bool, notes, createdBy string) (*CountSession, error) {
+	if id == "" {
+		return nil, ErrCountSessionIDRequired
+	}
+	if warehouseID == "" {
+		return nil, ErrCountWarehouseRequired
+	}
+	if len(lines) == 0 {
+		return nil, ErrCountLinesRequired
+	}
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if line.StockItemID == "" {
+			return nil, ErrStockItemIDRequired
+		}
+		if seen[line.StockItemID] {
+			return nil, ErrCountDuplicateStockItem
+		}
+		seen[line.StockItemID] = true
+	}
+
+	now := time.Now().UTC()
+	return &CountSession{
+		ID:          id,
+		WarehouseID: warehouseID,
+		Blind:       blind,
+		Freeze:      freeze,
+		Lines:       lines,
+		Status:      CountSessionStatusOpen,
+		Notes:       notes,
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Record captures the quantity counted for a stock item against the item's current
+// on-hand quantity. Recounting a line replaces the earlier count.
+func (c *CountSession) Record(stockItemID string, counted, onHand int, countedBy string) error {
+	if c.Status != CountSessionStatusOpen {
+		return ErrCountSessionNotOpen
+	}
+	if counted < 0 {
+		return ErrCountQuantityNegative
+	}
+	line := c.line(stockItemID)
+	if line == nil {
+		return ErrCountStockItemNotListed
+	}
+
+	now := time.Now().UTC()
+	line.ExpectedQuantity = onHand
+	line.CountedQuantity = &counted
+	line.CountedBy = countedBy
+	line.CountedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// Submit ends counting once every line has been counted and hands the variances to review
+func (c *CountSession) Submit() error {
+	if c.Status != CountSessionStatusOpen {
+		return ErrCountSessionNotOpen
+	}
+	for _, line := range c.Lines {
+		if !line.Counted() {
+			return ErrCountSessionIncomplete
+		}
+	}
+
+	now := time.Now().UTC()
+	c.Status = CountSessionStatusSubmitted
+	c.SubmittedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// Approve marks the variances of the given stock items for posting, or every variance
+// when none are given. Lines without a variance are never approved. The session stays
+// submitted until MarkPosted records the movements that posted the variances.
+func (c *CountSession) Approve(reviewer string, stockItemIDs []string) error {
+	if c.Status != CountSessionStatusSubmitted {
+		return ErrCountSessionNotSubmitted
+	}
+	approve := make(map[string]bool, len(stockItemIDs))
+	for _, id := range stockItemIDs {
+		if c.line(id) == nil {
+			return ErrCountStockItemNotListed
+		}
+		approve[id] = true
+	}
+
+	for i := range c.Lines {
+		line := &c.Lines[i]
+		line.Approved = line.Variance() != 0 && (len(approve) == 0 || approve[line.StockItemID])
+	}
+	c.ReviewedBy = reviewer
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// MarkPosted records the movements that posted the approved variances, keyed by
+// stock item ID, and closes the session
+func (c *CountSession) MarkPosted(movementIDs map[string]string) error {
+	if c.Status != CountSessionStatusSubmitted {
+		return ErrCountSessionNotSubmitted
+	}
+
+	for i := range c.Lines {
+		if id, ok := movementIDs[c.Lines[i].StockItemID]; ok {
+			c.Lines[i].MovementID = id
+		}
+	}
+	now := time.Now().UTC()
+	c.Status = CountSessionStatusPosted
+	c.PostedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// Cancel abandons a session that has not been posted; no variances are posted
+func (c *CountSession) Cancel() error {
+	if c.IsClosed() {
+		return ErrCountSessionClosed
+	}
+
+	c.Status = CountSessionStatusCancelled
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// IsClosed returns true once the session has been posted or cancelled
+func (c *CountSession) IsClosed() bool {
+	return c.Status == CountSessionStatusPosted || c.Status == CountSessionStatusCancelled
+}
+
+// HidesExpected returns true while counters must not see expected quantities
+func (c *CountSession) HidesExpected() bool {
+	return c.Blind && c.Status == CountSessionStatusOpen
+}
+
+// ApprovedLines returns the lines whose variances were approved for posting
+func (c *CountSession) ApprovedLines() []CountLine {
+	var lines []CountLine
+	for _, line := range c.Lines {
+		if line.Approved {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// TotalVariance returns the sum of the variances of all counted lines
+func (c *CountSession) TotalVariance() int {
+	total := 0
+	for _, line := range c.Lines {
+		total += line.Variance()
+	}
+	return total
+}
+
+func (c *CountSession) line(stockItemID string) *CountLine {
+	for i := range c.Lines {
+		if c.Lines[i].StockItemID == stockItemID {
+			return &c.Lines[i]
+		}
+	}
+	return nil
+}
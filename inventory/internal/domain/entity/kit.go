@@ -0,0 +1,104 @@
+// file: internal/domain/entity/kit.go
+package entity
+
+import (
+	"errors"
+)
+
+// KitComponent is a product and the quantity of it that goes into one kit
+type KitComponent struct {
+	ProductID string
+	Quantity  int
+}
+
+// Kit is the composition of a kit product. A kit is not stocked itself: it is available
+// in a warehouse as far as the stock of its components there makes kits up, and
+// reserving a kit reserves its components.
+type Kit struct {
+	ProductID  string
+	Components []KitComponent
+}
+
+// Kit validation errors
+var (
+	ErrKitComponentsRequired = errors.New("a kit needs at least one component")
+	ErrKitComponentQuantity  = errors.New("kit component quantity must be positive")
+	ErrKitComponentDuplicate = errors.New("a product can be a component of a kit only once")
+	ErrKitComponentSelf      = errors.New("a kit cannot be a component of itself")
+	ErrKitComponentKit       = errors.New("a kit cannot be a component of another kit")
+	ErrKitSerialized         = errors.New("a kit cannot be serialized; its components can")
+	ErrKitVariants           = errors.New("a kit cannot have variants")
+)
+
+// NewKit makes product a kit of the given components with validation
+func NewKit(product *Product, components []KitComponent) (*Kit, error) {
+	if product.IsDeleted() {
+		return nil, ErrProductDeleted
+	}
+	if product.IsVariant() {
+		return nil, ErrKitVariants
+	}
+	if product.Serialized {
+		return nil, ErrKitSerialized
+	}
+	if len(components) == 0 {
+		return nil, ErrKitComponentsRequired
+	}
+	seen := make(map[string]bool, len(components))
+	for _, c := range components {
+		if c.ProductID == "" {
+			return nil, ErrProductIDRequired
+		}
+		if c.ProductID == product.ID {
+			return nil, ErrKitComponentSelf
+		}
+		if c.Quantity <= 0 {
+			return nil, ErrKitComponentQuantity
+		}
+		if seen[c.ProductID] {
+			return nil, ErrKitComponentDuplicate
+		}
+		seen[c.ProductID] = true
+	}
+
+	product.IsKit = true
+	return &Kit{ProductID: product.ID, Components: components}, nil
+}
+
+// Stock returns the stock of the kit in a warehouse given the stock items of its
+// components there, keyed by product ID. Each quantity is the number of whole kits the
+// matching component quantities make up: on hand from what is on hand, available from
+// what is available and reservable from what is reservable. It returns false when a
+// component has no stock item.
+func (k *Kit) Stock(items map[string]*StockItem) (*StockItem, bool) {
+	var warehouseID string
+	var onHand, available, reservable int
+	for i, c := range k.Components {
+		item, ok := items[c.ProductID]
+		if !ok {
+			return nil, false
+		}
+		kitsOnHand := item.QuantityOnHand / c.Quantity
+		kitsAvailable := max(item.AvailableQuantity(), 0) / c.Quantity
+		kitsReservable := max(item.ReservableQuantity(), 0) / c.Quantity
+		if i == 0 {
+			warehouseID = item.WarehouseID
+			onHand, available, reservable = kitsOnHand, kitsAvailable, kitsReservable
+			continue
+		}
+		onHand = min(onHand, kitsOnHand)
+		available = min(available, kitsAvailable)
+		reservable = min(reservable, kitsReservable)
+	}
+	if len(k.Components) == 0 {
+		return nil, false
+	}
+
+	return &StockItem{
+		ProductID:        k.ProductID,
+		WarehouseID:      warehouseID,
+		QuantityOnHand:   onHand,
+		QuantityReserved: onHand - available,
+		QuantityExpired:  available - reservable,
+	}, true
+}
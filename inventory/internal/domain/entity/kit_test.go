@@ -0,0 +1,91 @@
+// file: internal/domain/entity/kit_test.go
+package entity
+
+import "testing"
+
+func TestKitStock(t *testing.T) {
+	// A kit of two of product-a and one of product-b
+	kit := &Kit{ProductID: "kit-1", Components: []KitComponent{
+		{ProductID: "product-a", Quantity: 2},
+		{ProductID: "product-b", Quantity: 1},
+	}}
+
+	type component struct{ onHand, reserved, expired int }
+	tests := []struct {
+		name                     string
+		a, b                     component
+		wantOnHand, wantReserved int
+		wantExpired              int
+		wantReservable           int
+	}{
+		{
+			name:       "limited by the component quantity",
+			a:          component{onHand: 10},
+			b:          component{onHand: 8},
+			wantOnHand: 5, wantReservable: 5,
+		},
+		{
+			name:       "each quantity limited by another component",
+			a:          component{onHand: 10, reserved: 6},
+			b:          component{onHand: 3},
+			wantOnHand: 3, wantReserved: 1, wantReservable: 2,
+		},
+		{
+			name:       "expired stock of a component",
+			a:          component{onHand: 10, expired: 6},
+			b:          component{onHand: 8},
+			wantOnHand: 5, wantExpired: 3, wantReservable: 2,
+		},
+		{
+			name:       "expired stock of every component",
+			a:          component{onHand: 10, expired: 2},
+			b:          component{onHand: 6, reserved: 1, expired: 3},
+			wantOnHand: 5, wantExpired: 3, wantReservable: 2,
+		},
+		{
+			name:       "component reserved beyond its stock",
+			a:          component{onHand: 2, reserved: 4},
+			b:          component{onHand: 8},
+			wantOnHand: 1, wantReserved: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stock, ok := kit.Stock(map[string]*StockItem{
+				"product-a": {ProductID: "product-a", WarehouseID: "wh-1", QuantityOnHand: tt.a.onHand,
+					QuantityReserved: tt.a.reserved, QuantityExpired: tt.a.expired},
+				"product-b": {ProductID: "product-b", WarehouseID: "wh-1", QuantityOnHand: tt.b.onHand,
+					QuantityReserved: tt.b.reserved, QuantityExpired: tt.b.expired},
+			})
+			if !ok {
+				t.Fatal("kit has no stock")
+			}
+			if stock.ProductID != kit.ProductID || stock.WarehouseID != "wh-1" {
+				t.Errorf("stock of: got %s in %s, want %s in wh-1", stock.ProductID, stock.WarehouseID, kit.ProductID)
+			}
+			if stock.QuantityOnHand != tt.wantOnHand {
+				t.Errorf("on hand: got %d, want %d", stock.QuantityOnHand, tt.wantOnHand)
+			}
+			if stock.QuantityReserved != tt.wantReserved {
+				t.Errorf("reserved: got %d, want %d", stock.QuantityReserved, tt.wantReserved)
+			}
+			if stock.QuantityExpired != tt.wantExpired {
+				t.Errorf("expired: got %d, want %d", stock.QuantityExpired, tt.wantExpired)
+			}
+			if got := stock.ReservableQuantity(); got != tt.wantReservable {
+				t.Errorf("reservable: got %d, want %d", got, tt.wantReservable)
+			}
+		})
+	}
+}
+
+func TestKitStockWithoutComponentStockItem(t *testing.T) {
+	kit := &Kit{ProductID: "kit-1", Components: []KitComponent{
+		{ProductID: "product-a", Quantity: 2},
+		{ProductID: "product-b", Quantity: 1},
+	}}
+	stock, ok := kit.Stock(map[string]*StockItem{"product-a": {ProductID: "product-a", QuantityOnHand: 10}})
+	if ok || stock != nil {
+		t.Errorf("stock: got %v, %v, want none", stock, ok)
+	}
+}
@@ -0,0 +1,204 @@
+// file: internal/domain/entity/location.go
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// LocationType is the level of a location in a warehouse's storage hierarchy
+type LocationType string
+
+const (
+	LocationTypeZone  LocationType = "ZONE"
+	LocationTypeAisle LocationType = "AISLE"
+	LocationTypeRack  LocationType = "RACK"
+	LocationTypeBin   LocationType = "BIN"
+)
+
+// parentLocationType is the level each location type sits directly under
+var parentLocationType = map[LocationType]LocationType{
+	LocationTypeAisle: LocationTypeZone,
+	LocationTypeRack:  LocationTypeAisle,
+	LocationTypeBin:   LocationTypeRack,
+}
+
+// locationPathSeparator joins the codes of a location and its ancestors into its path
+const locationPathSeparator = "-"
+
+// Location is a place in a warehouse: a zone, an aisle in a zone, a rack in an aisle or
+// a bin on a rack. Only bins hold stock.
+type Location struct {
+	ID             string
+	WarehouseID    string
+	ParentID       string // Empty for zones
+	Type           LocationType
+	Code           string // Unique among the location's siblings
+	Path           string // Codes of the location and its ancestors, e.g. "A-03-R2-B05"; unique in the warehouse
+	Capacity       int    // Units a bin holds across all stock items; 0 means unlimited
+	QuantityStored int    // Units stored in a bin, derived when the location is read
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// BinStock is the part of a stock item's on-hand stock stored in one bin. Stock of an item
+// that is in no bin has not been put away yet.
+type BinStock struct {
+	StockItemID string
+	LocationID  string
+	Path        string // Path of the bin, derived when the balance is read
+	Quantity    int
+	UpdatedAt   time.Time
+}
+
+// BinMovement records the share of a stock movement that changed one bin balance
+type BinMovement struct {
+	ID               string
+	LocationID       string
+	StockItemID      string
+	MovementID       string // Stock movement the change is part of
+	MovementType     MovementType
+	Quantity         int // Positive for stock put into the bin, negative for stock taken out
+	PreviousQuantity int
+	NewQuantity      int
+	ReferenceID      string
+	ReferenceType    string
+	CreatedBy        string
+	CreatedAt        time.Time
+}
+
+// Location validation errors
+var (
+	ErrLocationIDRequired        = errors.New("location ID is required")
+	ErrLocationWarehouseRequired = errors.New("location warehouse ID is required")
+	ErrLocationCodeRequired      = errors.New("location code is required")
+	ErrLocationCodeInvalid       = errors.New("location code cannot contain \"-\"")
+	ErrLocationTypeInvalid       = errors.New("invalid location type")
+	ErrLocationParent            = errors.New("location must sit directly under a location of the level above it")
+	ErrLocationCapacity          = errors.New("only bins have a capacity and it cannot be negative")
+	ErrLocationNotBin            = errors.New("location is not a bin")
+	ErrLocationWarehouseMismatch = errors.New("location is in another warehouse")
+	ErrBinCapacityExceeded       = errors.New("bin capacity exceeded")
+)
+
+// NewLocation creates a new Location under parent with validation. Zones have no parent.
+func NewLocation(id, warehouseID string, parent *Location, locationType LocationType, code string, capacity int) (*Location, error) {
+	if id == "" {
+		return nil, ErrLocationIDRequired
+	}
+	if warehouseID == "" {
+		return nil, ErrLocationWarehouseRequired
+	}
+	if code == "" {
+		return nil, ErrLocationCodeRequired
+	}
+	if strings.Contains(code, locationPathSeparator) {
+		return nil, ErrLocationCodeInvalid
+	}
+	if locationType != LocationTypeZone && parentLocationType[locationType] == "" {
+		return nil, ErrLocationTypeInvalid
+	}
+	if capacity < 0 || (capacity > 0 && locationType != LocationTypeBin) {
+		return nil, ErrLocationCapacity
+	}
+
+	path := code
+	if locationType == LocationTypeZone {
+		if parent != nil {
+			return nil, ErrLocationParent
+		}
+	} else {
+		if parent == nil || parent.Type != parentLocationType[locationType] {
+			return nil, ErrLocationParent
+		}
+		if parent.WarehouseID != warehouseID {
+			return nil, ErrLocationWarehouseMismatch
+		}
+		path = parent.Path + locationPathSeparator + code
+	}
+
+	now := time.Now().UTC()
+	location := &Location{
+		ID:          id,
+		WarehouseID: warehouseID,
+		Type:        locationType,
+		Code:        code,
+		Path:        path,
+		Capacity:    capacity,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if parent != nil {
+		location.ParentID = parent.ID
+	}
+	return location, nil
+}
+
+// IsBin returns true if the location holds stock
+func (l *Location) IsBin() bool {
+	return l.Type == LocationTypeBin
+}
+
+// FreeCapacity returns how many more units a bin holds, or -1 when its capacity is unlimited
+func (l *Location) FreeCapacity() int {
+	if l.Capacity == 0 {
+		return -1
+	}
+	return max(l.Capacity-l.QuantityStored, 0)
+}
+
+// Store puts quantity units into a bin within its capacity
+func (l *Location) Store(quantity int) error {
+	if !l.IsBin() {
+		return ErrLocationNotBin
+	}
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if free := l.FreeCapacity(); free >= 0 && quantity > free {
+		return ErrBinCapacityExceeded
+	}
+
+	l.QuantityStored += quantity
+	return nil
+}
+
+// NewBinStock creates an empty balance of a stock item in a bin
+func NewBinStock(stockItemID string, bin *Location) (*BinStock, error) {
+	if stockItemID == "" {
+		return nil, ErrStockItemIDRequired
+	}
+	if !bin.IsBin() {
+		return nil, ErrLocationNotBin
+	}
+	return &BinStock{
+		StockItemID: stockItemID,
+		LocationID:  bin.ID,
+		Path:        bin.Path,
+		UpdatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// Add puts stock into the bin balance
+func (b *BinStock) Add(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	b.Quantity += quantity
+	b.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Remove takes stock out of the bin balance
+func (b *BinStock) Remove(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if quantity > b.Quantity {
+		return ErrInsufficientStock
+	}
+	b.Quantity -= quantity
+	b.UpdatedAt = time.Now().UTC()
+	return nil
+}
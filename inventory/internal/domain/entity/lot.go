@@ -0,0 +1,176 @@
+// file: internal/domain/entity/lot.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Lot is a batch of a stock item received together, with its own on-hand and reserved
+// sub-balance and optional manufacture and expiry dates. Stock of an item that is in
+// no lot is untracked.
+type Lot struct {
+	ID               string
+	StockItemID      string
+	LotNumber        string
+	ManufacturedAt   *time.Time
+	ExpiresAt        *time.Time // Nil for lots that do not expire
+	QuantityOnHand   int
+	QuantityReserved int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// LotMovement is the part of a stock movement that changed one lot's balance
+type LotMovement struct {
+	ID               string
+	LotID            string
+	StockItemID      string
+	MovementID       string // Stock movement the lot movement is part of
+	MovementType     MovementType
+	Quantity         int // Positive for additions, negative for reductions
+	ReferenceID      string
+	ReferenceType    string
+	PreviousOnHand   int
+	NewOnHand        int
+	PreviousReserved int
+	NewReserved      int
+	CreatedBy        string
+	CreatedAt        time.Time
+}
+
+// Lot validation errors
+var (
+	ErrLotIDRequired        = errors.New("lot ID is required")
+	ErrLotStockItemRequired = errors.New("lot stock item ID is required")
+	ErrLotNumberRequired    = errors.New("lot number is required")
+	ErrLotDates             = errors.New("lot expiry date must be after its manufacture date")
+	ErrLotDatesMismatch     = errors.New("lot number is already recorded with different dates")
+	ErrLotExpired           = errors.New("lot has expired")
+)
+
+// NewLot creates a new empty Lot with validation
+func NewLot(id, stockItemID, lotNumber string, manufacturedAt, expiresAt *time.Time) (*Lot, error) {
+	if id == "" {
+		return nil, ErrLotIDRequired
+	}
+	if stockItemID == "" {
+		return nil, ErrLotStockItemRequired
+	}
+	if lotNumber == "" {
+		return nil, ErrLotNumberRequired
+	}
+	manufacturedAt, expiresAt = utcTime(manufacturedAt), utcTime(expiresAt)
+	if manufacturedAt != nil && expiresAt != nil && !expiresAt.After(*manufacturedAt) {
+		return nil, ErrLotDates
+	}
+
+	now := time.Now().UTC()
+	return &Lot{
+		ID:             id,
+		StockItemID:    stockItemID,
+		LotNumber:      lotNumber,
+		ManufacturedAt: manufacturedAt,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// HasDates reports whether the lot was recorded with the given dates; a nil date
+// matches whatever the lot holds
+func (l *Lot) HasDates(manufacturedAt, expiresAt *time.Time) bool {
+	same := func(recorded, given *time.Time) bool {
+		return given == nil || (recorded != nil && recorded.Equal(*given))
+	}
+	return same(l.ManufacturedAt, manufacturedAt) && same(l.ExpiresAt, expiresAt)
+}
+
+// AvailableQuantity returns the lot's on-hand quantity that is not reserved
+func (l *Lot) AvailableQuantity() int {
+	return l.QuantityOnHand - l.QuantityReserved
+}
+
+// IsExpired returns true if the lot's expiry date has been reached at the given time
+func (l *Lot) IsExpired(at time.Time) bool {
+	return l.ExpiresAt != nil && !at.Before(*l.ExpiresAt)
+}
+
+// Receive adds stock to the lot
+func (l *Lot) Receive(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+
+	l.QuantityOnHand += quantity
+	l.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Reserve holds unreserved stock of the lot; expired lots cannot be reserved
+func (l *Lot) Reserve(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if l.IsExpired(time.Now()) {
+		return ErrLotExpired
+	}
+	if l.AvailableQuantity() < quantity {
+		return ErrInsufficientStock
+	}
+
+	l.QuantityReserved += quantity
+	l.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Release returns reserved stock of the lot to available
+func (l *Lot) Release(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if l.QuantityReserved < quantity {
+		return ErrInsufficientReserved
+	}
+
+	l.QuantityReserved -= quantity
+	l.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Fulfill removes shipped stock from both the reserved and on-hand quantities
+func (l *Lot) Fulfill(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if l.QuantityReserved < quantity {
+		return ErrInsufficientReserved
+	}
+
+	l.QuantityReserved -= quantity
+	l.QuantityOnHand -= quantity
+	l.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Withdraw removes unreserved stock from the lot
+func (l *Lot) Withdraw(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if l.AvailableQuantity() < quantity {
+		return ErrInsufficientStock
+	}
+
+	l.QuantityOnHand -= quantity
+	l.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
@@ -6,21 +6,27 @@ import (
 	"time"
 )
 
-// ProductVariant represents a specific variant of a product (e.g., size, color)
+// ProductVariant holds the attributes that tell a variant apart from its siblings (e.g., size, color)
 type ProductVariant struct {
 	Size  string
 	Color string
 }
 
-// Product represents a product in the inventory system
+// Product represents a product in the inventory system. A variant is a product with a
+// parent: it has its own SKU and is stocked and reserved on its own, while its name,
+// description and category are those of its base product.
 type Product struct {
 	ID          string
+	ParentID    string // Base product of a variant; empty for base products
 	SKU         string
 	Name        string
 	Description string
-	Variant     ProductVariant
+	Variant     ProductVariant // Set for variants only
 	Category    string
-	MinStock    int // Threshold for low-stock alerts
+	BaseUnit    string // Unit of measure the product is stocked in, a UnitOfMeasure code
+	MinStock    int    // Threshold for low-stock alerts
+	Serialized  bool   // Stock is tracked unit by unit by serial number; fixed at creation
+	IsKit       bool   // Stocked only through the components of its Kit; fixed at creation
 	IsActive    bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -34,6 +40,10 @@ var (
 	ErrProductNameRequired = errors.New("product name is required")
 	ErrMinStockNegative    = errors.New("minimum stock cannot be negative")
 	ErrProductDeleted      = errors.New("product has been deleted")
+
+	ErrVariantAttributesRequired = errors.New("variant size or color is required")
+	ErrVariantOfVariant          = errors.New("a variant cannot have variants of its own")
+	ErrVariantDetailsInherited   = errors.New("variant name, description and category are those of its base product")
 )
 
 // NewProduct creates a new Product with validation
@@ -59,6 +69,7 @@ func NewProduct(id, sku, name, description, category string, variant ProductVari
 		Description: description,
 		Variant:     variant,
 		Category:    category,
+		BaseUnit:    DefaultBaseUnit,
 		MinStock:    minStock,
 		IsActive:    true,
 		CreatedAt:   now,
@@ -66,6 +77,29 @@ func NewProduct(id, sku, name, description, category string, variant ProductVari
 	}, nil
 }
 
+// NewProductVariant creates a new variant of base with validation. The variant takes
+// the details, low-stock threshold, base unit and serial tracking of its base product.
+func NewProductVariant(id string, base *Product, sku string, variant ProductVariant) (*Product, error) {
+	if base.IsDeleted() {
+		return nil, ErrProductDeleted
+	}
+	if base.IsVariant() {
+		return nil, ErrVariantOfVariant
+	}
+	if variant == (ProductVariant{}) {
+		return nil, ErrVariantAttributesRequired
+	}
+
+	p, err := NewProduct(id, sku, base.Name, base.Description, base.Category, variant, base.MinStock)
+	if err != nil {
+		return nil, err
+	}
+	p.ParentID = base.ID
+	p.BaseUnit = base.BaseUnit
+	p.Serialized = base.Serialized
+	return p, nil
+}
+
 // Update modifies product details
 func (p *Product) Update(name, description, category string, variant ProductVariant, minStock int) error {
 	if p.DeletedAt != nil {
@@ -77,6 +111,9 @@ func (p *Product) Update(name, description, category string, variant ProductVari
 	if minStock < 0 {
 		return ErrMinStockNegative
 	}
+	if p.IsVariant() && (name != p.Name || description != p.Description || category != p.Category) {
+		return ErrVariantDetailsInherited
+	}
 
 	p.Name = name
 	p.Description = description
@@ -102,4 +139,17 @@ func (p *Product) SoftDelete() error {
 // IsDeleted returns true if the product has been soft deleted
 func (p *Product) IsDeleted() bool {
 	return p.DeletedAt != nil
+}
+
+// IsVariant returns true if the product is a variant of a base product
+func (p *Product) IsVariant() bool {
+	return p.ParentID != ""
+}
+
+// Inherit copies the details a variant shares with its base product
+func (p *Product) Inherit(base *Product) {
+	p.Name = base.Name
+	p.Description = base.Description
+	p.Category = base.Category
+	p.UpdatedAt = time.Now().UTC()
 }
\ No newline at end of file
@@ -10,19 +10,51 @@ import (
 type ReservationStatus string
 
 const (
-	ReservationStatusPending   ReservationStatus = "PENDING"
-	ReservationStatusConfirmed ReservationStatus = "CONFIRMED"
-	ReservationStatusReleased  ReservationStatus = "RELEASED"
-	ReservationStatusFulfilled ReservationStatus = "FULFILLED"
-	ReservationStatusExpired   ReservationStatus = "EXPIRED"
+	ReservationStatusPending            ReservationStatus = "PENDING"
+	ReservationStatusConfirmed          ReservationStatus = "CONFIRMED"
+	ReservationStatusPartiallyReleased  ReservationStatus = "PARTIALLY_RELEASED"
+	ReservationStatusPartiallyFulfilled ReservationStatus = "PARTIALLY_FULFILLED"
+	ReservationStatusReleased           ReservationStatus = "RELEASED"
+	ReservationStatusFulfilled          ReservationStatus = "FULFILLED"
+	ReservationStatusExpired            ReservationStatus = "EXPIRED"
 )
 
-// ReservationItem represents a single item in a reservation
+// ReservationItem represents a single item in a reservation.
+// Quantity is what was reserved; the released and fulfilled parts of it no longer hold stock.
+// A reserved kit takes one line per component, released and shipped as that component.
 type ReservationItem struct {
-	StockItemID string
-	ProductID   string
-	WarehouseID string
-	Quantity    int
+	StockItemID       string
+	ProductID         string
+	WarehouseID       string
+	KitID             string // Kit product the line reserves a component of; empty otherwise
+	Quantity          int
+	ReleasedQuantity  int
+	FulfilledQuantity int
+}
+
+// OutstandingQuantity returns the quantity the line still holds
+func (i ReservationItem) OutstandingQuantity() int {
+	return i.Quantity - i.ReleasedQuantity - i.FulfilledQuantity
+}
+
+// ProductQuantity is a quantity of one product
+type ProductQuantity struct {
+	ProductID string
+	Quantity  int
+}
+
+// Shipment is a parcel that fulfilled part of a reservation. Shipments are
+// recorded so the same parcel is never decremented twice.
+type Shipment struct {
+	ID        string
+	Lines     []ShipmentLine
+	ShippedAt time.Time
+}
+
+// ShipmentLine is the quantity a shipment took from one reservation line
+type ShipmentLine struct {
+	Line     int // Index into Reservation.Items
+	Quantity int
 }
 
 // Reservation represents stock reserved for an order
@@ -30,6 +62,7 @@ type Reservation struct {
 	ID          string
 	OrderID     string
 	Items       []ReservationItem
+	Shipments   []Shipment
 	Status      ReservationStatus
 	ExpiresAt   time.Time
 	CreatedAt   time.Time
@@ -49,6 +82,11 @@ var (
 	ErrReservationAlreadyReleased = errors.New("reservation has already been released")
 	ErrReservationAlreadyFulfilled = errors.New("reservation has already been fulfilled")
 	ErrReservationExpired        = errors.New("reservation has expired")
+	ErrReservationReleaseQuantity = errors.New("release quantity exceeds the quantity held for the product")
+	ErrShipmentIDRequired        = errors.New("shipment ID is required")
+	ErrShipmentQuantity          = errors.New("shipment quantity exceeds the quantity held for the product")
+	ErrShipmentAlreadyRecorded   = errors.New("shipment has already been recorded")
+	ErrReservationExpiryNotLater = errors.New("new expiry must be later than the current expiry")
 )
 
 // NewReservation creates a new Reservation with validation
@@ -94,57 +132,243 @@ func (r *Reservation) Confirm() error {
 	return nil
 }
 
-// Release releases the reserved stock back to available
+// Extend moves the expiry of an active reservation that has not expired yet to a later time
+func (r *Reservation) Extend(expiresAt time.Time) error {
+	if err := r.checkReleasable(); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	if now.After(r.ExpiresAt) {
+		return ErrReservationExpired
+	}
+	if !expiresAt.After(r.ExpiresAt) {
+		return ErrReservationExpiryNotLater
+	}
+
+	r.ExpiresAt = expiresAt.UTC()
+	r.UpdatedAt = now
+	return nil
+}
+
+// Release releases all stock the reservation still holds back to available
 func (r *Reservation) Release() error {
-	if r.Status == ReservationStatusReleased {
-		return ErrReservationAlreadyReleased
+	if err := r.checkReleasable(); err != nil {
+		return err
+	}
+
+	for i := range r.Items {
+		r.Items[i].ReleasedQuantity += r.Items[i].OutstandingQuantity()
+	}
+	r.settle()
+	return nil
+}
+
+// ReleaseProduct releases part of the quantity held for a product, taking it from the
+// product's last lines first. The reservation is RELEASED once it holds nothing and
+// PARTIALLY_RELEASED until then.
+func (r *Reservation) ReleaseProduct(productID string, quantity int) error {
+	if err := r.checkReleasable(); err != nil {
+		return err
+	}
+	if quantity <= 0 {
+		return ErrReservationItemQuantity
+	}
+	if quantity > r.heldFor(productID) {
+		return ErrReservationReleaseQuantity
+	}
+
+	for i := len(r.Items) - 1; i >= 0 && quantity > 0; i-- {
+		item := &r.Items[i]
+		if item.ProductID != productID {
+			continue
+		}
+		take := min(quantity, item.OutstandingQuantity())
+		item.ReleasedQuantity += take
+		quantity -= take
 	}
-	if r.Status == ReservationStatusFulfilled {
+	r.settle()
+	return nil
+}
+
+func (r *Reservation) checkReleasable() error {
+	switch r.Status {
+	case ReservationStatusReleased:
+		return ErrReservationAlreadyReleased
+	case ReservationStatusFulfilled:
 		return ErrReservationAlreadyFulfilled
+	case ReservationStatusExpired:
+		// Expiry already returned the stock
+		return ErrReservationExpired
 	}
+	return nil
+}
 
+// settle derives the status from what the lines still hold. Once nothing is held the
+// reservation is FULFILLED if any of it shipped and RELEASED otherwise.
+func (r *Reservation) settle() {
 	now := time.Now().UTC()
-	r.Status = ReservationStatusReleased
-	r.ReleasedAt = &now
+	shipped := false
+	for _, item := range r.Items {
+		shipped = shipped || item.FulfilledQuantity > 0
+	}
+
+	switch {
+	case r.OutstandingQuantity() == 0 && shipped:
+		r.Status = ReservationStatusFulfilled
+		r.FulfilledAt = &now
+	case r.OutstandingQuantity() == 0:
+		r.Status = ReservationStatusReleased
+		r.ReleasedAt = &now
+	case shipped:
+		r.Status = ReservationStatusPartiallyFulfilled
+	default:
+		r.Status = ReservationStatusPartiallyReleased
+	}
 	r.UpdatedAt = now
-	return nil
 }
 
-// Fulfill marks the reservation as fulfilled (order shipped)
+func (r *Reservation) heldFor(productID string) int {
+	held := 0
+	for _, item := range r.Items {
+		if item.ProductID == productID {
+			held += item.OutstandingQuantity()
+		}
+	}
+	return held
+}
+
+// Fulfill fulfills everything the reservation still holds (order shipped) without
+// recording a shipment
 func (r *Reservation) Fulfill() error {
-	if r.Status == ReservationStatusFulfilled {
-		return ErrReservationAlreadyFulfilled
+	if err := r.checkFulfillable(); err != nil {
+		return err
+	}
+
+	for i := range r.Items {
+		r.Items[i].FulfilledQuantity += r.Items[i].OutstandingQuantity()
+	}
+	r.settle()
+	return nil
+}
+
+// Ship records a shipment that fulfills the given product quantities, taking them from
+// each product's first lines. An empty items list ships everything still held. The
+// reservation is PARTIALLY_FULFILLED until every line is complete and FULFILLED after.
+// Recording a shipment ID twice returns ErrShipmentAlreadyRecorded.
+func (r *Reservation) Ship(shipmentID string, items []ProductQuantity, shippedAt time.Time) (*Shipment, error) {
+	if shipmentID == "" {
+		return nil, ErrShipmentIDRequired
+	}
+	if r.Shipment(shipmentID) != nil {
+		return nil, ErrShipmentAlreadyRecorded
 	}
-	if r.Status == ReservationStatusReleased {
+	if err := r.checkFulfillable(); err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		for _, item := range r.Items {
+			if item.OutstandingQuantity() > 0 {
+				items = append(items, ProductQuantity{ProductID: item.ProductID, Quantity: item.OutstandingQuantity()})
+			}
+		}
+	}
+	// Validate against the quantities held before taking anything, so a product listed
+	// twice cannot overdraw its lines
+	requested := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, ErrReservationItemQuantity
+		}
+		requested[item.ProductID] += item.Quantity
+		if requested[item.ProductID] > r.heldFor(item.ProductID) {
+			return nil, ErrShipmentQuantity
+		}
+	}
+
+	shipment := Shipment{ID: shipmentID, ShippedAt: shippedAt.UTC()}
+	for _, item := range items {
+		quantity := item.Quantity
+		for i := range r.Items {
+			line := &r.Items[i]
+			if quantity == 0 {
+				break
+			}
+			if line.ProductID != item.ProductID || line.OutstandingQuantity() == 0 {
+				continue
+			}
+			take := min(quantity, line.OutstandingQuantity())
+			line.FulfilledQuantity += take
+			quantity -= take
+			shipment.Lines = append(shipment.Lines, ShipmentLine{Line: i, Quantity: take})
+		}
+	}
+	r.Shipments = append(r.Shipments, shipment)
+	r.settle()
+	return &r.Shipments[len(r.Shipments)-1], nil
+}
+
+// Shipment returns the recorded shipment with the given ID, or nil
+func (r *Reservation) Shipment(id string) *Shipment {
+	for i := range r.Shipments {
+		if r.Shipments[i].ID == id {
+			return &r.Shipments[i]
+		}
+	}
+	return nil
+}
+
+func (r *Reservation) checkFulfillable() error {
+	switch r.Status {
+	case ReservationStatusFulfilled:
+		return ErrReservationAlreadyFulfilled
+	case ReservationStatusReleased:
 		return ErrReservationAlreadyReleased
 	}
-	if r.Status != ReservationStatusConfirmed && r.Status != ReservationStatusPending {
+	if !r.IsActive() {
 		return ErrReservationNotConfirmed
 	}
-
-	now := time.Now().UTC()
-	r.Status = ReservationStatusFulfilled
-	r.FulfilledAt = &now
-	r.UpdatedAt = now
 	return nil
 }
 
-// Expire marks the reservation as expired
+// Expire marks the reservation as expired, releasing whatever it still holds
 func (r *Reservation) Expire() error {
-	if r.Status != ReservationStatusPending && r.Status != ReservationStatusConfirmed {
+	if !r.IsActive() {
 		return ErrReservationNotPending
 	}
 
+	for i := range r.Items {
+		r.Items[i].ReleasedQuantity += r.Items[i].OutstandingQuantity()
+	}
 	r.Status = ReservationStatusExpired
 	r.UpdatedAt = time.Now().UTC()
 	return nil
 }
 
+// IsActive returns true while the reservation holds stock
+func (r *Reservation) IsActive() bool {
+	switch r.Status {
+	case ReservationStatusPending, ReservationStatusConfirmed,
+		ReservationStatusPartiallyReleased, ReservationStatusPartiallyFulfilled:
+		return true
+	}
+	return false
+}
+
 // IsExpired checks if the reservation has expired
 func (r *Reservation) IsExpired() bool {
 	return time.Now().UTC().After(r.ExpiresAt) && r.Status != ReservationStatusFulfilled && r.Status != ReservationStatusReleased
 }
 
+// OutstandingQuantity returns the quantity still held across all items
+func (r *Reservation) OutstandingQuantity() int {
+	total := 0
+	for _, item := range r.Items {
+		total += item.OutstandingQuantity()
+	}
+	return total
+}
+
 // TotalQuantity returns the total quantity across all items
 func (r *Reservation) TotalQuantity() int {
 	total := 0
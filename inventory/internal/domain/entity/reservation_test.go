@@ -0,0 +1,147 @@
+// file: internal/domain/entity/reservation_test.go
+package entity
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newTestReservation creates a confirmed reservation of 3 of product-1, 2 of product-2
+// and another 4 of product-1, in that line order
+func newTestReservation(t *testing.T) *Reservation {
+	t.Helper()
+	r, err := NewReservation("res-1", "order-1", []ReservationItem{
+		{StockItemID: "item-1", ProductID: "product-1", Quantity: 3},
+		{StockItemID: "item-2", ProductID: "product-2", Quantity: 2},
+		{StockItemID: "item-3", ProductID: "product-1", Quantity: 4},
+	}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Confirm(); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestReservationReleaseProduct(t *testing.T) {
+	tests := []struct {
+		name         string
+		shipped      []ProductQuantity // Shipped before releasing
+		releases     []ProductQuantity
+		wantReleased []int // Per line
+		wantStatus   ReservationStatus
+		wantErr      error
+	}{
+		{
+			name:         "from the last line first",
+			releases:     []ProductQuantity{{ProductID: "product-1", Quantity: 3}},
+			wantReleased: []int{0, 0, 3},
+			wantStatus:   ReservationStatusPartiallyReleased,
+		},
+		{
+			name:         "across lines",
+			releases:     []ProductQuantity{{ProductID: "product-1", Quantity: 5}},
+			wantReleased: []int{1, 0, 4},
+			wantStatus:   ReservationStatusPartiallyReleased,
+		},
+		{
+			name: "everything held",
+			releases: []ProductQuantity{
+				{ProductID: "product-1", Quantity: 7},
+				{ProductID: "product-2", Quantity: 2},
+			},
+			wantReleased: []int{3, 2, 4},
+			wantStatus:   ReservationStatusReleased,
+		},
+		{
+			name:         "more than held",
+			releases:     []ProductQuantity{{ProductID: "product-1", Quantity: 8}},
+			wantReleased: []int{0, 0, 0},
+			wantStatus:   ReservationStatusConfirmed,
+			wantErr:      ErrReservationReleaseQuantity,
+		},
+		{
+			name:         "product not reserved",
+			releases:     []ProductQuantity{{ProductID: "product-3", Quantity: 1}},
+			wantReleased: []int{0, 0, 0},
+			wantStatus:   ReservationStatusConfirmed,
+			wantErr:      ErrReservationReleaseQuantity,
+		},
+		{
+			name:         "zero quantity",
+			releases:     []ProductQuantity{{ProductID: "product-1", Quantity: 0}},
+			wantReleased: []int{0, 0, 0},
+			wantStatus:   ReservationStatusConfirmed,
+			wantErr:      ErrReservationItemQuantity,
+		},
+		{
+			name:         "after a partial shipment",
+			shipped:      []ProductQuantity{{ProductID: "product-1", Quantity: 5}},
+			releases:     []ProductQuantity{{ProductID: "product-1", Quantity: 2}},
+			wantReleased: []int{0, 0, 2},
+			wantStatus:   ReservationStatusPartiallyFulfilled,
+		},
+		{
+			name:         "more than left after a partial shipment",
+			shipped:      []ProductQuantity{{ProductID: "product-1", Quantity: 5}},
+			releases:     []ProductQuantity{{ProductID: "product-1", Quantity: 3}},
+			wantReleased: []int{0, 0, 0},
+			wantStatus:   ReservationStatusPartiallyFulfilled,
+			wantErr:      ErrReservationReleaseQuantity,
+		},
+		{
+			name:    "the rest after a partial shipment",
+			shipped: []ProductQuantity{{ProductID: "product-1", Quantity: 5}},
+			releases: []ProductQuantity{
+				{ProductID: "product-1", Quantity: 2},
+				{ProductID: "product-2", Quantity: 2},
+			},
+			wantReleased: []int{0, 2, 2},
+			wantStatus:   ReservationStatusFulfilled,
+		},
+		{
+			name: "after the reservation is released",
+			releases: []ProductQuantity{
+				{ProductID: "product-1", Quantity: 7},
+				{ProductID: "product-2", Quantity: 2},
+				{ProductID: "product-2", Quantity: 1},
+			},
+			wantReleased: []int{3, 2, 4},
+			wantStatus:   ReservationStatusReleased,
+			wantErr:      ErrReservationAlreadyReleased,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReservation(t)
+			if tt.shipped != nil {
+				if _, err := r.Ship("shipment-1", tt.shipped, time.Now()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var err error
+			for _, release := range tt.releases {
+				if err = r.ReleaseProduct(release.ProductID, release.Quantity); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+
+			var released []int
+			for _, item := range r.Items {
+				released = append(released, item.ReleasedQuantity)
+			}
+			if !slices.Equal(released, tt.wantReleased) {
+				t.Errorf("released: got %v, want %v", released, tt.wantReleased)
+			}
+			if r.Status != tt.wantStatus {
+				t.Errorf("status: got %s, want %s", r.Status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// file: internal/domain/entity/serial_unit.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// SerialStatus represents where a serialized unit is in its lifecycle
+type SerialStatus string
+
+const (
+	SerialStatusAvailable SerialStatus = "AVAILABLE"
+	SerialStatusReserved  SerialStatus = "RESERVED"
+	SerialStatusShipped   SerialStatus = "SHIPPED"
+	SerialStatusReturned  SerialStatus = "RETURNED"
+	SerialStatusScrapped  SerialStatus = "SCRAPPED"
+)
+
+// SerialUnit is one unit of a serialized product, identified by its serial number.
+// Available, reserved and returned units are on hand at their stock item; shipped and
+// scrapped units have left it.
+type SerialUnit struct {
+	ID            string
+	ProductID     string
+	SerialNumber  string
+	StockItemID   string // Stock item holding the unit, or that last held it
+	Status        SerialStatus
+	ReservationID string // Reservation holding the unit while it is reserved
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// SerialMovement records one status change of a serialized unit
+type SerialMovement struct {
+	ID            string
+	SerialUnitID  string
+	StockItemID   string
+	MovementID    string // Stock movement the change is part of
+	MovementType  MovementType
+	FromStatus    SerialStatus // Empty when the unit was first received
+	ToStatus      SerialStatus
+	ReferenceID   string
+	ReferenceType string
+	CreatedBy     string
+	CreatedAt     time.Time
+}
+
+// SerialUnit validation errors
+var (
+	ErrSerialUnitIDRequired      = errors.New("serial unit ID is required")
+	ErrSerialProductRequired     = errors.New("serial unit product ID is required")
+	ErrSerialNumberRequired      = errors.New("serial number is required")
+	ErrSerialStockItemRequired   = errors.New("serial unit stock item ID is required")
+	ErrSerialUnitInStock         = errors.New("serial unit is already in stock")
+	ErrSerialUnitNotAvailable    = errors.New("serial unit is not available")
+	ErrSerialUnitNotReserved     = errors.New("serial unit is not reserved")
+	ErrSerialUnitScrapped        = errors.New("serial unit has been scrapped")
+	ErrSerialReservationRequired = errors.New("serial unit reservation ID is required")
+)
+
+// NewSerialUnit creates a newly received, available serial unit with validation
+func NewSerialUnit(id, productID, serialNumber, stockItemID string) (*SerialUnit, error) {
+	if id == "" {
+		return nil, ErrSerialUnitIDRequired
+	}
+	if productID == "" {
+		return nil, ErrSerialProductRequired
+	}
+	if serialNumber == "" {
+		return nil, ErrSerialNumberRequired
+	}
+	if stockItemID == "" {
+		return nil, ErrSerialStockItemRequired
+	}
+
+	now := time.Now().UTC()
+	return &SerialUnit{
+		ID:           id,
+		ProductID:    productID,
+		SerialNumber: serialNumber,
+		StockItemID:  stockItemID,
+		Status:       SerialStatusAvailable,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// InStock returns true if the unit is on hand at its stock item
+func (u *SerialUnit) InStock() bool {
+	switch u.Status {
+	case SerialStatusAvailable, SerialStatusReserved, SerialStatusReturned:
+		return true
+	}
+	return false
+}
+
+// IsAvailable returns true if the unit is on hand and not reserved
+func (u *SerialUnit) IsAvailable() bool {
+	return u.Status == SerialStatusAvailable || u.Status == SerialStatusReturned
+}
+
+// Return brings a shipped unit back into stock at the given stock item
+func (u *SerialUnit) Return(stockItemID string) error {
+	if stockItemID == "" {
+		return ErrSerialStockItemRequired
+	}
+	switch u.Status {
+	case SerialStatusShipped:
+	case SerialStatusScrapped:
+		return ErrSerialUnitScrapped
+	default:
+		return ErrSerialUnitInStock
+	}
+
+	u.StockItemID = stockItemID
+	u.Status = SerialStatusReturned
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Reserve holds an available unit for a reservation
+func (u *SerialUnit) Reserve(reservationID string) error {
+	if reservationID == "" {
+		return ErrSerialReservationRequired
+	}
+	if !u.IsAvailable() {
+		return ErrSerialUnitNotAvailable
+	}
+
+	u.Status = SerialStatusReserved
+	u.ReservationID = reservationID
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Release returns a reserved unit to available
+func (u *SerialUnit) Release() error {
+	if u.Status != SerialStatusReserved {
+		return ErrSerialUnitNotReserved
+	}
+
+	u.Status = SerialStatusAvailable
+	u.ReservationID = ""
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Ship marks a unit reserved for the given reservation as shipped
+func (u *SerialUnit) Ship(reservationID string) error {
+	if u.Status != SerialStatusReserved || u.ReservationID != reservationID {
+		return ErrSerialUnitNotReserved
+	}
+
+	u.Status = SerialStatusShipped
+	u.ReservationID = ""
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Scrap writes an unreserved unit in stock off
+func (u *SerialUnit) Scrap() error {
+	if u.Status == SerialStatusScrapped {
+		return ErrSerialUnitScrapped
+	}
+	if !u.IsAvailable() {
+		return ErrSerialUnitNotAvailable
+	}
+
+	u.Status = SerialStatusScrapped
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
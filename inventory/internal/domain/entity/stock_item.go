@@ -9,12 +9,17 @@ import (
 // StockItem represents the stock level of a product in a specific warehouse
 type StockItem struct {
 	ID              string
-	ProductID       string
+	ProductID       string // The variant stocked, for products with variants
 	WarehouseID     string
 	QuantityOnHand  int // Physical stock available
 	QuantityReserved int // Stock reserved for pending orders
+	QuantityExpired int // Unreserved stock in expired lots, derived when the item is read; it cannot be reserved
 	ReorderPoint    int // When to trigger replenishment
 	ReorderQuantity int // How much to reorder
+	FrozenBy        string // Count session freezing on-hand changes, empty when not frozen
+	BinLocationID   string // Home bin replenishments are put away to, empty when none
+	BinLocation     string // Path of the home bin, derived when the item is read
+	Version         int // Optimistic concurrency token, incremented on every persisted change
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -29,6 +34,8 @@ var (
 	ErrInsufficientReserved     = errors.New("insufficient reserved stock")
 	ErrReorderPointNegative     = errors.New("reorder point cannot be negative")
 	ErrReorderQuantityNegative  = errors.New("reorder quantity cannot be negative")
+	ErrAdjustmentBelowReserved  = errors.New("adjustment would push on-hand stock below the reserved quantity")
+	ErrStockItemFrozen          = errors.New("stock item is frozen by a cycle count")
 )
 
 // NewStockItem creates a new StockItem with validation
@@ -58,6 +65,7 @@ func NewStockItem(id, productID, warehouseID string, reorderPoint, reorderQuanti
 		QuantityReserved: 0,
 		ReorderPoint:    reorderPoint,
 		ReorderQuantity: reorderQuantity,
+		Version:         1,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}, nil
@@ -68,12 +76,17 @@ func (s *StockItem) AvailableQuantity() int {
 	return s.QuantityOnHand - s.QuantityReserved
 }
 
-// Reserve attempts to reserve a quantity of stock
+// ReservableQuantity returns the available quantity that is not in expired lots
+func (s *StockItem) ReservableQuantity() int {
+	return s.AvailableQuantity() - s.QuantityExpired
+}
+
+// Reserve attempts to reserve a quantity of stock, leaving stock in expired lots alone
 func (s *StockItem) Reserve(quantity int) error {
 	if quantity < 0 {
 		return ErrQuantityNegative
 	}
-	if s.AvailableQuantity() < quantity {
+	if s.ReservableQuantity() < quantity {
 		return ErrInsufficientStock
 	}
 
@@ -104,6 +117,9 @@ func (s *StockItem) Fulfill(quantity int) error {
 	if s.QuantityReserved < quantity {
 		return ErrInsufficientReserved
 	}
+	if s.IsFrozen() {
+		return ErrStockItemFrozen
+	}
 	if s.QuantityOnHand < quantity {
 		return ErrInsufficientStock
 	}
@@ -119,18 +135,99 @@ func (s *StockItem) Replenish(quantity int) error {
 	if quantity < 0 {
 		return ErrQuantityNegative
 	}
+	if s.IsFrozen() {
+		return ErrStockItemFrozen
+	}
 
 	s.QuantityOnHand += quantity
 	s.UpdatedAt = time.Now().UTC()
 	return nil
 }
 
-// NeedsReorder returns true if stock is at or below reorder point
+// Withdraw removes unreserved stock from the on-hand quantity, for stock leaving the
+// warehouse outside of a reservation
+func (s *StockItem) Withdraw(quantity int) error {
+	if quantity < 0 {
+		return ErrQuantityNegative
+	}
+	if s.AvailableQuantity() < quantity {
+		return ErrInsufficientStock
+	}
+	if s.IsFrozen() {
+		return ErrStockItemFrozen
+	}
+
+	s.QuantityOnHand -= quantity
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Adjust corrects the on-hand quantity by delta, negative for shrinkage or damage and
+// positive for found stock. It refuses to push on-hand below the reserved quantity.
+func (s *StockItem) Adjust(delta int) error {
+	if s.QuantityOnHand+delta < s.QuantityReserved {
+		return ErrAdjustmentBelowReserved
+	}
+	if s.IsFrozen() {
+		return ErrStockItemFrozen
+	}
+
+	s.QuantityOnHand += delta
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Freeze stops on-hand changes to the stock item while a count session counts it.
+// Reservations and releases are still allowed as they leave the shelf untouched.
+func (s *StockItem) Freeze(countSessionID string) error {
+	if s.IsFrozen() {
+		return ErrStockItemFrozen
+	}
+
+	s.FrozenBy = countSessionID
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Unfreeze lifts a freeze placed by the given count session; freezes placed by other
+// sessions are left alone
+func (s *StockItem) Unfreeze(countSessionID string) {
+	if s.FrozenBy != countSessionID {
+		return
+	}
+
+	s.FrozenBy = ""
+	s.UpdatedAt = time.Now().UTC()
+}
+
+// IsFrozen returns true while a count session freezes on-hand changes
+func (s *StockItem) IsFrozen() bool {
+	return s.FrozenBy != ""
+}
+
+// AssignBin makes a bin of the item's warehouse its home bin, which replenishments are
+// put away to when no bin is given
+func (s *StockItem) AssignBin(bin *Location) error {
+	if !bin.IsBin() {
+		return ErrLocationNotBin
+	}
+	if bin.WarehouseID != s.WarehouseID {
+		return ErrLocationWarehouseMismatch
+	}
+
+	s.BinLocationID = bin.ID
+	s.BinLocation = bin.Path
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// NeedsReorder returns true if stock is at or below reorder point. Stock in expired
+// lots can never be sold, so it does not count.
 func (s *StockItem) NeedsReorder() bool {
-	return s.AvailableQuantity() <= s.ReorderPoint
+	return s.ReservableQuantity() <= s.ReorderPoint
 }
 
-// IsLowStock returns true if available quantity is below or equal to reorder point
+// IsLowStock returns true if the reservable quantity is below or equal to reorder point
 func (s *StockItem) IsLowStock() bool {
-	return s.AvailableQuantity() <= s.ReorderPoint
+	return s.ReservableQuantity() <= s.ReorderPoint
 }
\ No newline at end of file
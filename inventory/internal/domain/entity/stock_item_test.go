@@ -0,0 +1,33 @@
+// file: internal/domain/entity/stock_item_test.go
+package entity
+
+import "testing"
+
+func TestStockItemLowStockIgnoresExpiredStock(t *testing.T) {
+	tests := []struct {
+		name                      string
+		onHand, reserved, expired int
+		want                      bool
+	}{
+		{name: "above reorder point", onHand: 30, reserved: 5, want: false},
+		{name: "at reorder point", onHand: 15, reserved: 5, want: true},
+		{name: "only expired stock above reorder point", onHand: 30, reserved: 5, expired: 15, want: true},
+		{name: "expired stock leaves enough", onHand: 40, reserved: 5, expired: 15, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &StockItem{
+				QuantityOnHand:   tt.onHand,
+				QuantityReserved: tt.reserved,
+				QuantityExpired:  tt.expired,
+				ReorderPoint:     10,
+			}
+			if got := item.IsLowStock(); got != tt.want {
+				t.Errorf("IsLowStock: got %v, want %v", got, tt.want)
+			}
+			if got := item.NeedsReorder(); got != tt.want {
+				t.Errorf("NeedsReorder: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
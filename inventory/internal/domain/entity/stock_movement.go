@@ -16,6 +16,7 @@ const (
 	MovementTypeFulfillment   MovementType = "FULFILLMENT"
 	MovementTypeAdjustment    MovementType = "ADJUSTMENT"
 	MovementTypeTransfer      MovementType = "TRANSFER"
+	MovementTypeBinMove       MovementType = "BIN_MOVE" // Stock moved between bins; on-hand is unchanged
 )
 
 // StockMovement represents an audit record of stock changes
@@ -86,7 +87,7 @@ func NewStockMovement(
 func isValidMovementType(mt MovementType) bool {
 	switch mt {
 	case MovementTypeReplenishment, MovementTypeReservation, MovementTypeRelease,
-		MovementTypeFulfillment, MovementTypeAdjustment, MovementTypeTransfer:
+		MovementTypeFulfillment, MovementTypeAdjustment, MovementTypeTransfer, MovementTypeBinMove:
 		return true
 	}
 	return false
@@ -0,0 +1,212 @@
+// file: internal/domain/entity/transfer.go
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// TransferStatus represents the current state of a transfer
+type TransferStatus string
+
+const (
+	TransferStatusDraft       TransferStatus = "DRAFT"
+	TransferStatusDispatched  TransferStatus = "DISPATCHED"
+	TransferStatusInTransit   TransferStatus = "IN_TRANSIT"
+	TransferStatusReceived    TransferStatus = "RECEIVED"
+	TransferStatusDiscrepancy TransferStatus = "DISCREPANCY"
+)
+
+// TransferItem is the quantity of one product moved between the source and destination
+// stock items of a transfer. Quantity leaves the source on dispatch; ReceivedQuantity is
+// what the destination counted on receipt.
+type TransferItem struct {
+	ProductID              string
+	SourceStockItemID      string
+	DestinationStockItemID string
+	Quantity               int
+	ReceivedQuantity       int
+}
+
+// ShortQuantity returns the part of the dispatched quantity that never arrived
+func (i TransferItem) ShortQuantity() int {
+	return i.Quantity - i.ReceivedQuantity
+}
+
+// Transfer moves stock from one warehouse to another. Dispatched stock is removed from
+// the source and is available nowhere until the destination receives it; a receipt that
+// counts less than was dispatched closes the transfer as a DISCREPANCY.
+type Transfer struct {
+	ID                     string
+	SourceWarehouseID      string
+	DestinationWarehouseID string
+	Items                  []TransferItem
+	Status                 TransferStatus
+	Notes                  string
+	CreatedBy              string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	DispatchedAt           *time.Time
+	ReceivedAt             *time.Time
+}
+
+// Transfer validation errors
+var (
+	ErrTransferIDRequired          = errors.New("transfer ID is required")
+	ErrTransferSourceRequired      = errors.New("source warehouse ID is required")
+	ErrTransferDestinationRequired = errors.New("destination warehouse ID is required")
+	ErrTransferSameWarehouse       = errors.New("source and destination warehouses must differ")
+	ErrTransferItemsRequired       = errors.New("at least one transfer item is required")
+	ErrTransferItemQuantity        = errors.New("transfer item quantity must be positive")
+	ErrTransferDuplicateProduct    = errors.New("a product may be listed only once per transfer")
+	ErrTransferNotDraft            = errors.New("transfer is not in draft status")
+	ErrTransferNotDispatched       = errors.New("transfer is not in dispatched status")
+	ErrTransferNotInTransit        = errors.New("transfer is not on its way")
+	ErrTransferReceiptQuantity     = errors.New("received quantity must be between zero and the dispatched quantity")
+	ErrTransferProductNotListed    = errors.New("product is not part of the transfer")
+)
+
+// NewTransfer creates a new draft Transfer with validation
+func NewTransfer(id, sourceWarehouseID, destinationWarehouseID string, items []TransferItem, notes, createdBy string) (*Transfer, error) {
+	if id == "" {
+		return nil, ErrTransferIDRequired
+	}
+	if sourceWarehouseID == "" {
+		return nil, ErrTransferSourceRequired
+	}
+	if destinationWarehouseID == "" {
+		return nil, ErrTransferDestinationRequired
+	}
+	if sourceWarehouseID == destinationWarehouseID {
+		return nil, ErrTransferSameWarehouse
+	}
+	if len(items) == 0 {
+		return nil, ErrTransferItemsRequired
+	}
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, ErrTransferItemQuantity
+		}
+		if seen[item.ProductID] {
+			return nil, ErrTransferDuplicateProduct
+		}
+		seen[item.ProductID] = true
+	}
+
+	now := time.Now().UTC()
+	return &Transfer{
+		ID:                     id,
+		SourceWarehouseID:      sourceWarehouseID,
+		DestinationWarehouseID: destinationWarehouseID,
+		Items:                  items,
+		Status:                 TransferStatusDraft,
+		Notes:                  notes,
+		CreatedBy:              createdBy,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}, nil
+}
+
+// Dispatch records that the transfer left the source warehouse
+func (t *Transfer) Dispatch() error {
+	if t.Status != TransferStatusDraft {
+		return ErrTransferNotDraft
+	}
+
+	now := time.Now().UTC()
+	t.Status = TransferStatusDispatched
+	t.DispatchedAt = &now
+	t.UpdatedAt = now
+	return nil
+}
+
+// MarkInTransit records that the carrier picked the dispatched transfer up
+func (t *Transfer) MarkInTransit() error {
+	if t.Status != TransferStatusDispatched {
+		return ErrTransferNotDispatched
+	}
+
+	t.Status = TransferStatusInTransit
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Receive records the quantities the destination counted. Products left out of received
+// arrived in full. The transfer is RECEIVED when everything arrived and DISCREPANCY
+// when anything is short.
+func (t *Transfer) Receive(received []ProductQuantity) error {
+	if !t.IsOnItsWay() {
+		return ErrTransferNotInTransit
+	}
+
+	counted := make(map[string]int, len(received))
+	for _, r := range received {
+		line := t.item(r.ProductID)
+		if line == nil {
+			return ErrTransferProductNotListed
+		}
+		if r.Quantity < 0 || r.Quantity > line.Quantity {
+			return ErrTransferReceiptQuantity
+		}
+		counted[r.ProductID] = r.Quantity
+	}
+
+	short := false
+	for i := range t.Items {
+		item := &t.Items[i]
+		quantity, ok := counted[item.ProductID]
+		if !ok {
+			quantity = item.Quantity
+		}
+		item.ReceivedQuantity = quantity
+		short = short || item.ShortQuantity() > 0
+	}
+
+	now := time.Now().UTC()
+	t.Status = TransferStatusReceived
+	if short {
+		t.Status = TransferStatusDiscrepancy
+	}
+	t.ReceivedAt = &now
+	t.UpdatedAt = now
+	return nil
+}
+
+// IsOnItsWay returns true while the transfer has left the source but not been received
+func (t *Transfer) IsOnItsWay() bool {
+	return t.Status == TransferStatusDispatched || t.Status == TransferStatusInTransit
+}
+
+// InTransitQuantity returns the quantity that left the source and has not arrived yet
+func (t *Transfer) InTransitQuantity() int {
+	if !t.IsOnItsWay() {
+		return 0
+	}
+	total := 0
+	for _, item := range t.Items {
+		total += item.Quantity
+	}
+	return total
+}
+
+// ShortQuantity returns the quantity that was dispatched but never arrived
+func (t *Transfer) ShortQuantity() int {
+	if t.Status != TransferStatusReceived && t.Status != TransferStatusDiscrepancy {
+		return 0
+	}
+	total := 0
+	for _, item := range t.Items {
+		total += item.ShortQuantity()
+	}
+	return total
+}
+
+func (t *Transfer) item(productID string) *TransferItem {
+	for i := range t.Items {
+		if t.Items[i].ProductID == productID {
+			return &t.Items[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// file: internal/domain/entity/unit_of_measure.go
+package entity
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// DefaultBaseUnit is the unit products are stocked in unless created with another one
+const DefaultBaseUnit = "EA"
+
+// UnitOfMeasure is a unit quantities can be given in, such as eaches, cases or pallets
+type UnitOfMeasure struct {
+	Code      string // Upper case, e.g. "CASE"
+	Name      string
+	CreatedAt time.Time
+}
+
+// UnitConversion says how many base units of a product a quantity of another unit makes
+// up: Quantity of the unit are BaseQuantity of the product's base unit, e.g. 1 CASE is
+// 12 EA, or 2 PACK are 3 EA. Stock is always kept in the base unit.
+type UnitConversion struct {
+	ProductID    string
+	UnitCode     string
+	Quantity     int
+	BaseQuantity int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Unit of measure validation errors
+var (
+	ErrUnitCodeRequired     = errors.New("unit code is required")
+	ErrUnitCodeInvalid      = errors.New("unit code may only contain letters, digits, '-' and '_'")
+	ErrUnitNameRequired     = errors.New("unit name is required")
+	ErrUnitIsBaseUnit       = errors.New("the base unit of a product converts to itself")
+	ErrConversionQuantity   = errors.New("unit conversion quantities must be positive")
+	ErrQuantityNotWholeUnit = errors.New("quantity does not convert to a whole number of base units")
+	ErrConversionOverflow   = errors.New("quantity is too large to convert to base units")
+)
+
+// NewUnitOfMeasure creates a new UnitOfMeasure with validation
+func NewUnitOfMeasure(code, name string) (*UnitOfMeasure, error) {
+	code, err := NormalizeUnitCode(code)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrUnitNameRequired
+	}
+	return &UnitOfMeasure{Code: code, Name: name, CreatedAt: time.Now().UTC()}, nil
+}
+
+// NormalizeUnitCode returns code in upper case, validating its characters
+func NormalizeUnitCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return "", ErrUnitCodeRequired
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return "", ErrUnitCodeInvalid
+		}
+	}
+	return code, nil
+}
+
+// NewUnitConversion creates the conversion of a unit into the base unit of product
+// with validation
+func NewUnitConversion(product *Product, unitCode string, quantity, baseQuantity int) (*UnitConversion, error) {
+	if product.IsDeleted() {
+		return nil, ErrProductDeleted
+	}
+	unitCode, err := NormalizeUnitCode(unitCode)
+	if err != nil {
+		return nil, err
+	}
+	if unitCode == product.BaseUnit {
+		return nil, ErrUnitIsBaseUnit
+	}
+	if quantity <= 0 || baseQuantity <= 0 {
+		return nil, ErrConversionQuantity
+	}
+
+	now := time.Now().UTC()
+	return &UnitConversion{
+		ProductID:    product.ID,
+		UnitCode:     unitCode,
+		Quantity:     quantity,
+		BaseQuantity: baseQuantity,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// ToBase converts a quantity of the unit into base units. It returns
+// ErrQuantityNotWholeUnit when the quantity does not make up whole base units, and
+// ErrConversionOverflow when it is too large to be held in base units.
+func (c *UnitConversion) ToBase(quantity int) (int, error) {
+	if quantity > math.MaxInt/c.BaseQuantity || quantity < math.MinInt/c.BaseQuantity {
+		return 0, ErrConversionOverflow
+	}
+	base := quantity * c.BaseQuantity
+	if base%c.Quantity != 0 {
+		return 0, ErrQuantityNotWholeUnit
+	}
+	return base / c.Quantity, nil
+}
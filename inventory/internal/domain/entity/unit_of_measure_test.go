@@ -0,0 +1,39 @@
+// file: internal/domain/entity/unit_of_measure_test.go
+package entity
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUnitConversionToBase(t *testing.T) {
+	tests := []struct {
+		name                   string
+		quantity, baseQuantity int // Of the conversion
+		in                     int
+		want                   int
+		wantErr                error
+	}{
+		{name: "case of twelve", quantity: 1, baseQuantity: 12, in: 3, want: 36},
+		{name: "two packs make three", quantity: 2, baseQuantity: 3, in: 4, want: 6},
+		{name: "negative quantity", quantity: 1, baseQuantity: 12, in: -2, want: -24},
+		{name: "not a whole base unit", quantity: 2, baseQuantity: 3, in: 3, wantErr: ErrQuantityNotWholeUnit},
+		{name: "largest convertible quantity", quantity: 1, baseQuantity: 12, in: math.MaxInt / 12, want: math.MaxInt / 12 * 12},
+		{name: "overflow", quantity: 1, baseQuantity: 12, in: math.MaxInt/12 + 1, wantErr: ErrConversionOverflow},
+		{name: "overflow before dividing", quantity: 4, baseQuantity: 2, in: math.MaxInt/2 + 2, wantErr: ErrConversionOverflow},
+		{name: "negative overflow", quantity: 1, baseQuantity: 12, in: math.MinInt/12 - 1, wantErr: ErrConversionOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &UnitConversion{Quantity: tt.quantity, BaseQuantity: tt.baseQuantity}
+			got, err := c.ToBase(tt.in)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("base quantity: got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
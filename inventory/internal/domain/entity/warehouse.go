@@ -21,6 +21,7 @@ type Warehouse struct {
 	Code      string
 	Name      string
 	Address   WarehouseAddress
+	Priority  int // Allocation order; lower values are drawn from first
 	IsActive  bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -33,10 +34,11 @@ var (
 	ErrWarehouseCodeRequired = errors.New("warehouse code is required")
 	ErrWarehouseNameRequired = errors.New("warehouse name is required")
 	ErrWarehouseDeleted      = errors.New("warehouse has been deleted")
+	ErrWarehousePriority     = errors.New("warehouse priority cannot be negative")
 )
 
 // NewWarehouse creates a new Warehouse with validation
-func NewWarehouse(id, code, name string, address WarehouseAddress) (*Warehouse, error) {
+func NewWarehouse(id, code, name string, address WarehouseAddress, priority int) (*Warehouse, error) {
 	if id == "" {
 		return nil, ErrWarehouseIDRequired
 	}
@@ -46,6 +48,9 @@ func NewWarehouse(id, code, name string, address WarehouseAddress) (*Warehouse,
 	if name == "" {
 		return nil, ErrWarehouseNameRequired
 	}
+	if priority < 0 {
+		return nil, ErrWarehousePriority
+	}
 
 	now := time.Now().UTC()
 	return &Warehouse{
@@ -53,6 +58,7 @@ func NewWarehouse(id, code, name string, address WarehouseAddress) (*Warehouse,
 		Code:      code,
 		Name:      name,
 		Address:   address,
+		Priority:  priority,
 		IsActive:  true,
 		CreatedAt: now,
 		UpdatedAt: now,
@@ -60,16 +66,20 @@ func NewWarehouse(id, code, name string, address WarehouseAddress) (*Warehouse,
 }
 
 // Update modifies warehouse details
-func (w *Warehouse) Update(name string, address WarehouseAddress) error {
+func (w *Warehouse) Update(name string, address WarehouseAddress, priority int) error {
 	if w.DeletedAt != nil {
 		return ErrWarehouseDeleted
 	}
 	if name == "" {
 		return ErrWarehouseNameRequired
 	}
+	if priority < 0 {
+		return ErrWarehousePriority
+	}
 
 	w.Name = name
 	w.Address = address
+	w.Priority = priority
 	w.UpdatedAt = time.Now().UTC()
 	return nil
 }
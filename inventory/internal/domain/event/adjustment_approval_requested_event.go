@@ -0,0 +1,36 @@
+// file: internal/domain/event/adjustment_approval_requested_event.go
+package event
+
+import (
+	"time"
+)
+
+// AdjustmentApprovalRequestedEvent is published when a stock adjustment exceeds the
+// approval thresholds and waits for an inventory manager
+type AdjustmentApprovalRequestedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	AdjustmentID string   `json:"adjustment_id"`
+	StockItemID  string   `json:"stock_item_id"`
+	ProductID    string   `json:"product_id"`
+	SKU          string   `json:"sku"`
+	WarehouseID  string   `json:"warehouse_id"`
+	Quantity     int      `json:"quantity"`
+	ReasonCode   string   `json:"reason_code"`
+	Value        *float64 `json:"value,omitempty"`
+	RequestedBy  string   `json:"requested_by,omitempty"`
+}
+
+// EventName returns the canonical event name
+func (e AdjustmentApprovalRequestedEvent) EventName() string {
+	return "inventory.adjustment.approval_requested"
+}
+
+// AggregateID returns the aggregate identifier
+func (e AdjustmentApprovalRequestedEvent) AggregateID() string {
+	return e.AdjustmentID
+}
@@ -0,0 +1,33 @@
+// file: internal/domain/event/adjustment_rejected_event.go
+package event
+
+import (
+	"time"
+)
+
+// AdjustmentRejectedEvent is published when a reviewer declines a pending stock adjustment
+type AdjustmentRejectedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	AdjustmentID string `json:"adjustment_id"`
+	StockItemID  string `json:"stock_item_id"`
+	Quantity     int    `json:"quantity"`
+	ReasonCode   string `json:"reason_code"`
+	RequestedBy  string `json:"requested_by,omitempty"`
+	ReviewedBy   string `json:"reviewed_by,omitempty"`
+	ReviewNotes  string `json:"review_notes,omitempty"`
+}
+
+// EventName returns the canonical event name
+func (e AdjustmentRejectedEvent) EventName() string {
+	return "inventory.adjustment.rejected"
+}
+
+// AggregateID returns the aggregate identifier
+func (e AdjustmentRejectedEvent) AggregateID() string {
+	return e.AdjustmentID
+}
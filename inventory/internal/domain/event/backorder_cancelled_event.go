@@ -0,0 +1,30 @@
+// file: internal/domain/event/backorder_cancelled_event.go
+package event
+
+import (
+	"time"
+)
+
+// BackorderCancelledEvent is published when an open backorder is withdrawn
+type BackorderCancelledEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	BackorderID       string `json:"backorder_id"`
+	OrderID           string `json:"order_id"`
+	ProductID         string `json:"product_id"`
+	QuantityCancelled int    `json:"quantity_cancelled"`
+}
+
+// EventName returns the canonical event name
+func (e BackorderCancelledEvent) EventName() string {
+	return "inventory.backorder.cancelled"
+}
+
+// AggregateID returns the aggregate identifier
+func (e BackorderCancelledEvent) AggregateID() string {
+	return e.BackorderID
+}
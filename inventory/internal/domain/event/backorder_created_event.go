@@ -0,0 +1,33 @@
+// file: internal/domain/event/backorder_created_event.go
+package event
+
+import (
+	"time"
+)
+
+// BackorderCreatedEvent is published when an unreservable quantity is queued for an order
+type BackorderCreatedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	BackorderID string `json:"backorder_id"`
+	OrderID     string `json:"order_id"`
+	ProductID   string `json:"product_id"`
+	SKU         string `json:"sku"`
+	WarehouseID string `json:"warehouse_id,omitempty"`
+	Quantity    int    `json:"quantity"`
+	Priority    int    `json:"priority"`
+}
+
+// EventName returns the canonical event name
+func (e BackorderCreatedEvent) EventName() string {
+	return "inventory.backorder.created"
+}
+
+// AggregateID returns the aggregate identifier
+func (e BackorderCreatedEvent) AggregateID() string {
+	return e.BackorderID
+}
@@ -0,0 +1,36 @@
+// file: internal/domain/event/backorder_filled_event.go
+package event
+
+import (
+	"time"
+)
+
+// BackorderFilledEvent is published when arriving stock is reserved for a backorder.
+// A backorder may be filled over several events; Status is FILLED on the last one.
+type BackorderFilledEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	BackorderID       string `json:"backorder_id"`
+	OrderID           string `json:"order_id"`
+	ReservationID     string `json:"reservation_id"`
+	ProductID         string `json:"product_id"`
+	SKU               string `json:"sku"`
+	WarehouseID       string `json:"warehouse_id"`
+	QuantityReserved  int    `json:"quantity_reserved"`
+	QuantityRemaining int    `json:"quantity_remaining"`
+	Status            string `json:"status"`
+}
+
+// EventName returns the canonical event name
+func (e BackorderFilledEvent) EventName() string {
+	return "inventory.backorder.filled"
+}
+
+// AggregateID returns the aggregate identifier
+func (e BackorderFilledEvent) AggregateID() string {
+	return e.BackorderID
+}
@@ -0,0 +1,31 @@
+// file: internal/domain/event/count_session_posted_event.go
+package event
+
+import (
+	"time"
+)
+
+// CountSessionPostedEvent is published when the approved variances of a count session
+// have been posted as adjustments. Variances lists the posted variances only.
+type CountSessionPostedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	CountSessionID string          `json:"count_session_id"`
+	WarehouseID    string          `json:"warehouse_id"`
+	Variances      []CountVariance `json:"variances"`
+	PostedBy       string          `json:"posted_by,omitempty"`
+}
+
+// EventName returns the canonical event name
+func (e CountSessionPostedEvent) EventName() string {
+	return "inventory.count_session.posted"
+}
+
+// AggregateID returns the aggregate identifier
+func (e CountSessionPostedEvent) AggregateID() string {
+	return e.CountSessionID
+}
@@ -0,0 +1,44 @@
+// file: internal/domain/event/count_session_submitted_event.go
+package event
+
+import (
+	"time"
+)
+
+// CountSessionSubmittedEvent is published when counting ends and the variances of a
+// count session wait for review
+type CountSessionSubmittedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	CountSessionID string          `json:"count_session_id"`
+	WarehouseID    string          `json:"warehouse_id"`
+	Variances      []CountVariance `json:"variances"`
+	TotalVariance  int             `json:"total_variance"`
+	SubmittedBy    string          `json:"submitted_by,omitempty"`
+}
+
+// CountVariance contains the expected and counted quantities of one stock item whose
+// count differs from its recorded on-hand quantity
+type CountVariance struct {
+	StockItemID      string `json:"stock_item_id"`
+	ProductID        string `json:"product_id"`
+	SKU              string `json:"sku"`
+	ExpectedQuantity int    `json:"expected_quantity"`
+	CountedQuantity  int    `json:"counted_quantity"`
+	Variance         int    `json:"variance"`
+	MovementID       string `json:"movement_id,omitempty"`
+}
+
+// EventName returns the canonical event name
+func (e CountSessionSubmittedEvent) EventName() string {
+	return "inventory.count_session.submitted"
+}
+
+// AggregateID returns the aggregate identifier
+func (e CountSessionSubmittedEvent) AggregateID() string {
+	return e.CountSessionID
+}
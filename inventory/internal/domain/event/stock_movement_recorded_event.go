@@ -37,6 +37,7 @@ const (
 	MovementTypeReplenishment MovementType = "REPLENISHMENT"
 	MovementTypeAdjustment    MovementType = "ADJUSTMENT"
 	MovementTypeTransfer      MovementType = "TRANSFER"
+	MovementTypeBinMove       MovementType = "BIN_MOVE"
 )
 
 // EventName returns the canonical event name
@@ -0,0 +1,31 @@
+// file: internal/domain/event/stock_reservation_extended_event.go
+package event
+
+import (
+	"time"
+)
+
+// StockReservationExtendedEvent is published when the hold of a reservation is extended
+type StockReservationExtendedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	ReservationID     string    `json:"reservation_id"`
+	OrderID           string    `json:"order_id"`
+	PreviousExpiresAt time.Time `json:"previous_expires_at"`
+	ExpiresAt         time.Time `json:"expires_at"`
+	ExtendedBy        string    `json:"extended_by"`
+}
+
+// EventName returns the canonical event name
+func (e StockReservationExtendedEvent) EventName() string {
+	return "inventory.stock.reservation_extended"
+}
+
+// AggregateID returns the aggregate identifier
+func (e StockReservationExtendedEvent) AggregateID() string {
+	return e.ReservationID
+}
@@ -0,0 +1,40 @@
+// file: internal/domain/event/transfer_dispatched_event.go
+package event
+
+import (
+	"time"
+)
+
+// TransferDispatchedEvent is published when a transfer leaves its source warehouse.
+// The dispatched quantities are in transit until a TransferReceivedEvent follows.
+type TransferDispatchedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	TransferID             string               `json:"transfer_id"`
+	SourceWarehouseID      string               `json:"source_warehouse_id"`
+	DestinationWarehouseID string               `json:"destination_warehouse_id"`
+	Items                  []TransferItemDetail `json:"items"`
+	PerformedBy            string               `json:"performed_by,omitempty"`
+}
+
+// TransferItemDetail contains the quantities of one product in a transfer
+type TransferItemDetail struct {
+	ProductID        string `json:"product_id"`
+	SKU              string `json:"sku"`
+	Quantity         int    `json:"quantity"`
+	ReceivedQuantity int    `json:"received_quantity"`
+}
+
+// EventName returns the canonical event name
+func (e TransferDispatchedEvent) EventName() string {
+	return "inventory.transfer.dispatched"
+}
+
+// AggregateID returns the aggregate identifier
+func (e TransferDispatchedEvent) AggregateID() string {
+	return e.TransferID
+}
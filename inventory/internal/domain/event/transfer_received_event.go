@@ -0,0 +1,34 @@
+// file: internal/domain/event/transfer_received_event.go
+package event
+
+import (
+	"time"
+)
+
+// TransferReceivedEvent is published when the destination warehouse receives a transfer.
+// Status is DISCREPANCY and ShortQuantity positive when less arrived than was dispatched.
+type TransferReceivedEvent struct {
+	EventID       string    `json:"event_id"`
+	CorrelationID string    `json:"correlation_id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Version       string    `json:"version"`
+
+	// Payload
+	TransferID             string               `json:"transfer_id"`
+	SourceWarehouseID      string               `json:"source_warehouse_id"`
+	DestinationWarehouseID string               `json:"destination_warehouse_id"`
+	Items                  []TransferItemDetail `json:"items"`
+	ShortQuantity          int                  `json:"short_quantity"`
+	Status                 string               `json:"status"`
+	PerformedBy            string               `json:"performed_by,omitempty"`
+}
+
+// EventName returns the canonical event name
+func (e TransferReceivedEvent) EventName() string {
+	return "inventory.transfer.received"
+}
+
+// AggregateID returns the aggregate identifier
+func (e TransferReceivedEvent) AggregateID() string {
+	return e.TransferID
+}
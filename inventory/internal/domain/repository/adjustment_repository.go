@@ -0,0 +1,31 @@
+// file: internal/domain/repository/adjustment_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// AdjustmentFilter defines filtering options for adjustment queries
+type AdjustmentFilter struct {
+	Status      *entity.AdjustmentStatus
+	StockItemID *string
+	Limit       int
+	Offset      int
+}
+
+// AdjustmentRepository defines the interface for stock adjustment persistence
+type AdjustmentRepository interface {
+	// Create persists a new adjustment
+	Create(ctx context.Context, adjustment *entity.Adjustment) error
+
+	// GetByID retrieves an adjustment by its ID
+	GetByID(ctx context.Context, id string) (*entity.Adjustment, error)
+
+	// List retrieves adjustments with optional filtering
+	List(ctx context.Context, filter AdjustmentFilter) ([]*entity.Adjustment, int, error)
+
+	// Update persists changes to an existing adjustment
+	Update(ctx context.Context, adjustment *entity.Adjustment) error
+}
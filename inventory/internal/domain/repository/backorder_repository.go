@@ -0,0 +1,27 @@
+// file: internal/domain/repository/backorder_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// BackorderRepository defines the interface for backorder persistence
+type BackorderRepository interface {
+	// Create persists a new backorder
+	Create(ctx context.Context, backorder *entity.Backorder) error
+
+	// GetByID retrieves a backorder by its ID
+	GetByID(ctx context.Context, id string) (*entity.Backorder, error)
+
+	// GetByOrderID retrieves the backorders of a specific order
+	GetByOrderID(ctx context.Context, orderID string) ([]*entity.Backorder, error)
+
+	// GetOpen retrieves the open backorders of a product that stock in a warehouse may
+	// serve, those preferring the warehouse and those without a preference, oldest first
+	GetOpen(ctx context.Context, productID, warehouseID string) ([]*entity.Backorder, error)
+
+	// Update persists changes to an existing backorder
+	Update(ctx context.Context, backorder *entity.Backorder) error
+}
@@ -0,0 +1,35 @@
+// file: internal/domain/repository/count_session_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// CountSessionFilter defines filtering options for count session queries
+type CountSessionFilter struct {
+	Status      *entity.CountSessionStatus
+	WarehouseID *string
+	Limit       int
+	Offset      int
+}
+
+// CountSessionRepository defines the interface for count session persistence
+type CountSessionRepository interface {
+	// Create persists a new count session
+	Create(ctx context.Context, session *entity.CountSession) error
+
+	// GetByID retrieves a count session by its ID
+	GetByID(ctx context.Context, id string) (*entity.CountSession, error)
+
+	// GetByIDForUpdate retrieves a count session and locks it until the surrounding
+	// transaction ends, so concurrent counters do not overwrite each other's lines
+	GetByIDForUpdate(ctx context.Context, id string) (*entity.CountSession, error)
+
+	// List retrieves count sessions with optional filtering
+	List(ctx context.Context, filter CountSessionFilter) ([]*entity.CountSession, int, error)
+
+	// Update persists changes to an existing count session
+	Update(ctx context.Context, session *entity.CountSession) error
+}
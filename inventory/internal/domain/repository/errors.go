@@ -0,0 +1,34 @@
+// file: internal/domain/repository/errors.go
+package repository
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Repository errors shared by all persistence implementations
+var (
+	ErrNotFound      = errors.New("record not found")
+	ErrAlreadyExists = errors.New("record already exists")
+
+	// ErrConcurrentModification is returned by compare-and-swap updates when the record
+	// changed after it was read. Callers should re-read and retry.
+	ErrConcurrentModification = errors.New("record was modified concurrently")
+)
+
+// ConcurrentModificationError describes a failed compare-and-swap update.
+// It matches ErrConcurrentModification with errors.Is.
+type ConcurrentModificationError struct {
+	Entity          string
+	ID              string
+	ExpectedVersion int
+}
+
+func (e *ConcurrentModificationError) Error() string {
+	return fmt.Sprintf("%s %s: version %d is stale: %s", e.Entity, e.ID, e.ExpectedVersion, ErrConcurrentModification)
+}
+
+// Is reports whether target is ErrConcurrentModification
+func (e *ConcurrentModificationError) Is(target error) bool {
+	return target == ErrConcurrentModification
+}
@@ -0,0 +1,34 @@
+// file: internal/domain/repository/kit_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// KitStock is the stock of a kit in one warehouse, counted in the kits the stock of its
+// components there makes up
+type KitStock struct {
+	KitID          string
+	WarehouseID    string
+	QuantityOnHand int
+	Available      int
+	MinStock       int // Low-stock threshold of the kit product
+}
+
+// KitRepository defines the interface for persistence of kit compositions
+type KitRepository interface {
+	// Create persists the components of a new kit
+	Create(ctx context.Context, kit *entity.Kit) error
+
+	// GetByProductID retrieves the composition of a kit product
+	GetByProductID(ctx context.Context, productID string) (*entity.Kit, error)
+
+	// ListByComponent retrieves the live kits a product is a component of
+	ListByComponent(ctx context.Context, productID string) ([]*entity.Kit, error)
+
+	// GetLowStock retrieves the stock of live kits in the live warehouses stocking all their
+	// components, where the kits available are at or below the kit's minimum stock
+	GetLowStock(ctx context.Context) ([]KitStock, error)
+}
@@ -0,0 +1,53 @@
+// file: internal/domain/repository/location_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// LocationFilter defines filtering options for location queries
+type LocationFilter struct {
+	WarehouseID *string
+	ParentID    *string
+	Type        *entity.LocationType
+	PathPrefix  *string // Locations whose path starts with this prefix
+	Limit       int
+	Offset      int
+}
+
+// LocationRepository defines the interface for persistence of warehouse locations, the
+// stock balances of their bins and the bin movements that changed them
+type LocationRepository interface {
+	// Create persists a new location
+	Create(ctx context.Context, location *entity.Location) error
+
+	// GetByID retrieves a location by its ID
+	GetByID(ctx context.Context, id string) (*entity.Location, error)
+
+	// GetForUpdate retrieves a location by its ID and locks it until the transaction ends,
+	// so stock put into a bin concurrently cannot exceed its capacity
+	GetForUpdate(ctx context.Context, id string) (*entity.Location, error)
+
+	// GetByPath retrieves the location of a warehouse with the given path
+	GetByPath(ctx context.Context, warehouseID, path string) (*entity.Location, error)
+
+	// List retrieves locations with optional filtering, ordered by path
+	List(ctx context.Context, filter LocationFilter) ([]*entity.Location, int, error)
+
+	// GetBinStock retrieves the bins holding stock of a stock item, in pick order (by path)
+	GetBinStock(ctx context.Context, stockItemID string) ([]*entity.BinStock, error)
+
+	// GetBinContents retrieves the stock balances held in a bin
+	GetBinContents(ctx context.Context, locationID string) ([]*entity.BinStock, error)
+
+	// SaveBinStock creates or updates the balance of a stock item in a bin
+	SaveBinStock(ctx context.Context, stock *entity.BinStock) error
+
+	// CreateMovement persists a bin movement record
+	CreateMovement(ctx context.Context, movement *entity.BinMovement) error
+
+	// GetMovements retrieves the movements of a bin, newest first
+	GetMovements(ctx context.Context, locationID string, limit, offset int) ([]*entity.BinMovement, int, error)
+}
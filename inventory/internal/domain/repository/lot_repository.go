@@ -0,0 +1,47 @@
+// file: internal/domain/repository/lot_repository.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// LotFilter defines filtering options for lot queries
+type LotFilter struct {
+	StockItemID   *string
+	ProductID     *string
+	LotNumber     *string
+	ExpiresBefore *time.Time // Lots expiring before this time, expired ones included
+	IncludeEmpty  bool       // Include lots with no stock left
+	Limit         int
+	Offset        int
+}
+
+// LotRepository defines the interface for persistence of lots and their movements
+type LotRepository interface {
+	// Create persists a new lot
+	Create(ctx context.Context, lot *entity.Lot) error
+
+	// GetByID retrieves a lot by its ID
+	GetByID(ctx context.Context, id string) (*entity.Lot, error)
+
+	// GetByLotNumber retrieves the lot of a stock item with the given lot number
+	GetByLotNumber(ctx context.Context, stockItemID, lotNumber string) (*entity.Lot, error)
+
+	// GetByStockItem retrieves the lots of a stock item that hold stock, first expiring first
+	GetByStockItem(ctx context.Context, stockItemID string) ([]*entity.Lot, error)
+
+	// List retrieves lots with optional filtering, first expiring first
+	List(ctx context.Context, filter LotFilter) ([]*entity.Lot, int, error)
+
+	// Update persists changes to an existing lot
+	Update(ctx context.Context, lot *entity.Lot) error
+
+	// CreateMovement persists a lot movement record
+	CreateMovement(ctx context.Context, movement *entity.LotMovement) error
+
+	// GetMovements retrieves the movements of a lot, newest first
+	GetMovements(ctx context.Context, lotID string, limit, offset int) ([]*entity.LotMovement, int, error)
+}
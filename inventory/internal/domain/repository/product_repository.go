@@ -9,7 +9,7 @@ import (
 
 // ProductFilter defines filtering options for product queries
 type ProductFilter struct {
-	SKU      *string
+	SKU      *string // Matches the product's SKU or the SKU of one of its variants
 	Name     *string
 	Category *string
 	IsActive *bool
@@ -28,9 +28,12 @@ type ProductRepository interface {
 	// GetBySKU retrieves a product by its SKU
 	GetBySKU(ctx context.Context, sku string) (*entity.Product, error)
 
-	// List retrieves products with optional filtering
+	// List retrieves base products with optional filtering; variants are listed by ListVariants
 	List(ctx context.Context, filter ProductFilter) ([]*entity.Product, int, error)
 
+	// ListVariants retrieves the live variants of a base product, ordered by SKU
+	ListVariants(ctx context.Context, productID string) ([]*entity.Product, error)
+
 	// Update persists changes to an existing product
 	Update(ctx context.Context, product *entity.Product) error
 
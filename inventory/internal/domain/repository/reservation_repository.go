@@ -26,6 +26,10 @@ type ReservationRepository interface {
 	// GetByID retrieves a reservation by its ID
 	GetByID(ctx context.Context, id string) (*entity.Reservation, error)
 
+	// GetByIDForUpdate retrieves a reservation and locks it until the surrounding
+	// transaction ends, so concurrent changes to its lines do not overwrite each other
+	GetByIDForUpdate(ctx context.Context, id string) (*entity.Reservation, error)
+
 	// GetByOrderID retrieves reservations for a specific order
 	GetByOrderID(ctx context.Context, orderID string) ([]*entity.Reservation, error)
 
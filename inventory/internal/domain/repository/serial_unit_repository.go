@@ -0,0 +1,43 @@
+// file: internal/domain/repository/serial_unit_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// SerialUnitFilter defines filtering options for serial unit queries
+type SerialUnitFilter struct {
+	ProductID     *string
+	StockItemID   *string
+	SerialNumber  *string
+	ReservationID *string
+	Statuses      []entity.SerialStatus // Any of these statuses; every status when empty
+	Limit         int
+	Offset        int
+}
+
+// SerialUnitRepository defines the interface for persistence of serial units and their history
+type SerialUnitRepository interface {
+	// Create persists a new serial unit
+	Create(ctx context.Context, unit *entity.SerialUnit) error
+
+	// GetByID retrieves a serial unit by its ID
+	GetByID(ctx context.Context, id string) (*entity.SerialUnit, error)
+
+	// GetBySerialNumber retrieves the unit of a product with the given serial number
+	GetBySerialNumber(ctx context.Context, productID, serialNumber string) (*entity.SerialUnit, error)
+
+	// List retrieves serial units with optional filtering, oldest first
+	List(ctx context.Context, filter SerialUnitFilter) ([]*entity.SerialUnit, int, error)
+
+	// Update persists changes to an existing serial unit
+	Update(ctx context.Context, unit *entity.SerialUnit) error
+
+	// CreateMovement persists a serial movement record
+	CreateMovement(ctx context.Context, movement *entity.SerialMovement) error
+
+	// GetMovements retrieves the full history of a serial unit, oldest first
+	GetMovements(ctx context.Context, serialUnitID string) ([]*entity.SerialMovement, error)
+}
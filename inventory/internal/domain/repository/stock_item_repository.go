@@ -16,14 +16,18 @@ type StockItemFilter struct {
 	Offset      int
 }
 
-// AggregatedStock represents total stock for a product across warehouses
+// AggregatedStock represents total stock for a product across warehouses. The stock of a
+// base product is the stock of its variants; the stock of a kit is, in each warehouse,
+// the number of kits the stock of its components there makes up.
 type AggregatedStock struct {
 	ProductID        string
 	TotalOnHand      int
 	TotalReserved    int
 	TotalAvailable   int
+	TotalInTransit   int // Dispatched by transfers and not received yet; available nowhere
 	WarehouseCount   int
 	WarehouseDetails []WarehouseStockDetail
+	VariantDetails   []VariantStockDetail // One per live variant of a base product, ordered by SKU
 }
 
 // WarehouseStockDetail represents stock in a specific warehouse
@@ -35,6 +39,16 @@ type WarehouseStockDetail struct {
 	Available        int
 }
 
+// VariantStockDetail represents stock of one variant across warehouses
+type VariantStockDetail struct {
+	ProductID        string
+	SKU              string
+	Variant          entity.ProductVariant
+	QuantityOnHand   int
+	QuantityReserved int
+	Available        int
+}
+
 // StockItemRepository defines the interface for stock item persistence
 type StockItemRepository interface {
 	// Create persists a new stock item
@@ -52,10 +66,12 @@ type StockItemRepository interface {
 	// Update persists changes to an existing stock item
 	Update(ctx context.Context, stockItem *entity.StockItem) error
 
-	// UpdateWithLock updates a stock item with optimistic locking
+	// UpdateWithLock updates a stock item only if its stored version equals expectedVersion,
+	// returning ErrConcurrentModification otherwise. On success the item's Version is advanced.
 	UpdateWithLock(ctx context.Context, stockItem *entity.StockItem, expectedVersion int) error
 
-	// GetAggregatedStock retrieves total stock for a product across all warehouses
+	// GetAggregatedStock retrieves total stock for a product, its variants or the components
+	// of a kit across all warehouses
 	GetAggregatedStock(ctx context.Context, productID string) (*AggregatedStock, error)
 
 	// GetLowStockItems retrieves all stock items at or below reorder point
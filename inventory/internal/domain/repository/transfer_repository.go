@@ -0,0 +1,36 @@
+// file: internal/domain/repository/transfer_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// TransferFilter defines filtering options for transfer queries
+type TransferFilter struct {
+	Status                 *entity.TransferStatus
+	SourceWarehouseID      *string
+	DestinationWarehouseID *string
+	Limit                  int
+	Offset                 int
+}
+
+// TransferRepository defines the interface for transfer persistence
+type TransferRepository interface {
+	// Create persists a new transfer
+	Create(ctx context.Context, transfer *entity.Transfer) error
+
+	// GetByID retrieves a transfer by its ID
+	GetByID(ctx context.Context, id string) (*entity.Transfer, error)
+
+	// GetByIDForUpdate retrieves a transfer and locks it until the surrounding
+	// transaction ends, so concurrent status changes are applied one after the other
+	GetByIDForUpdate(ctx context.Context, id string) (*entity.Transfer, error)
+
+	// List retrieves transfers with optional filtering
+	List(ctx context.Context, filter TransferFilter) ([]*entity.Transfer, int, error)
+
+	// Update persists changes to an existing transfer
+	Update(ctx context.Context, transfer *entity.Transfer) error
+}
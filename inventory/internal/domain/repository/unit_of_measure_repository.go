@@ -0,0 +1,30 @@
+// file: internal/domain/repository/unit_of_measure_repository.go
+package repository
+
+import (
+	"context"
+
+	"github.com/inventory-service/internal/domain/entity"
+)
+
+// UnitOfMeasureRepository defines the interface for persistence of the unit of measure
+// catalog and the per-product conversions of units into base units
+type UnitOfMeasureRepository interface {
+	// Create persists a new unit of measure
+	Create(ctx context.Context, unit *entity.UnitOfMeasure) error
+
+	// GetByCode retrieves a unit of measure by its code
+	GetByCode(ctx context.Context, code string) (*entity.UnitOfMeasure, error)
+
+	// List retrieves all units of measure ordered by code
+	List(ctx context.Context) ([]*entity.UnitOfMeasure, error)
+
+	// SaveConversion creates or replaces the conversion of a unit for a product
+	SaveConversion(ctx context.Context, conversion *entity.UnitConversion) error
+
+	// GetConversion retrieves the conversion of a unit for a product
+	GetConversion(ctx context.Context, productID, unitCode string) (*entity.UnitConversion, error)
+
+	// ListConversions retrieves the conversions of a product ordered by unit code
+	ListConversions(ctx context.Context, productID string) ([]*entity.UnitConversion, error)
+}
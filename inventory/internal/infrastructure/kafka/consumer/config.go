@@ -0,0 +1,117 @@
+// file: internal/infrastructure/kafka/consumer/config.go
+package consumer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Order Service topics consumed by the inventory service
+const (
+	TopicOrderCreated   = "order.created"
+	TopicOrderCancelled = "order.cancelled"
+	TopicOrderFulfilled = "order.fulfilled"
+)
+
+// Config holds Kafka consumer configuration
+type Config struct {
+	Brokers         []string
+	GroupID         string
+	ClientID        string
+	Topics          []string
+	InitialOffset   string // "oldest" or "newest"; where a group without committed offsets starts
+	SessionTimeout  time.Duration
+	RetryBackoff    time.Duration // Delay before a failed message is handled again, doubled per attempt
+	MaxRetryBackoff time.Duration
+	InPlaceAttempts int             // Attempts on a message before it moves to the next retry tier
+	RetryTiers      []time.Duration // Delay of each retry topic; a message failing the last tier is dead-lettered
+	DeadLetterTopic string
+	ProcessingLease time.Duration // How long a claimed event is reserved for its handler before others may take it over
+	IdempotencyTTL  time.Duration // How long processed events are remembered for deduplication
+	PurgeInterval   time.Duration // How often records past IdempotencyTTL are deleted
+}
+
+// DefaultConfig returns a production-ready default configuration
+func DefaultConfig() Config {
+	return Config{
+		Brokers:         []string{"localhost:9092"},
+		GroupID:         "inventory-service",
+		ClientID:        "inventory-service",
+		Topics:          []string{TopicOrderCreated, TopicOrderCancelled, TopicOrderFulfilled},
+		InitialOffset:   "oldest",
+		SessionTimeout:  10 * time.Second,
+		RetryBackoff:    500 * time.Millisecond,
+		MaxRetryBackoff: 30 * time.Second,
+		InPlaceAttempts: 3,
+		RetryTiers:      []time.Duration{30 * time.Second, 5 * time.Minute, 30 * time.Minute},
+		DeadLetterTopic: "inventory-service.dlt",
+		ProcessingLease: time.Minute,
+		IdempotencyTTL:  7 * 24 * time.Hour,
+		PurgeInterval:   time.Hour,
+	}
+}
+
+// Validate reports settings the consumer cannot run with
+func (c Config) Validate() error {
+	if len(c.Brokers) == 0 {
+		return errors.New("at least one broker is required")
+	}
+	if c.GroupID == "" {
+		return errors.New("consumer group ID is required")
+	}
+	if len(c.Topics) == 0 {
+		return errors.New("at least one topic is required")
+	}
+	switch c.InitialOffset {
+	case "oldest", "newest":
+	default:
+		return fmt.Errorf("invalid initial offset %q: must be \"oldest\" or \"newest\"", c.InitialOffset)
+	}
+	if c.RetryBackoff <= 0 || c.MaxRetryBackoff < c.RetryBackoff {
+		return errors.New("retry backoff must be positive and not exceed the maximum")
+	}
+	if c.InPlaceAttempts < 1 {
+		return errors.New("at least one in-place attempt is required")
+	}
+	if len(c.RetryTiers) == 0 {
+		return errors.New("at least one retry tier is required")
+	}
+	for _, delay := range c.RetryTiers {
+		if delay < 0 {
+			return errors.New("retry tier delays cannot be negative")
+		}
+	}
+	if c.DeadLetterTopic == "" {
+		return errors.New("dead letter topic is required")
+	}
+	if c.ProcessingLease <= 0 || c.IdempotencyTTL <= 0 || c.PurgeInterval <= 0 {
+		return errors.New("processing lease, idempotency TTL and purge interval must be positive")
+	}
+	return nil
+}
+
+// Backoff returns the delay before the given retry (1 for the first retry),
+// doubling from RetryBackoff up to MaxRetryBackoff
+func (c Config) Backoff(retry int) time.Duration {
+	delay := c.RetryBackoff
+	for i := 1; i < retry && delay < c.MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, c.MaxRetryBackoff)
+}
+
+// RetryTopic returns the topic of a retry tier, numbered from 1. Retry topics belong to
+// the consumer group, so other groups reading the same source topics never see them.
+func (c Config) RetryTopic(tier int) string {
+	return fmt.Sprintf("%s.retry.%d", c.GroupID, tier)
+}
+
+// SubscribedTopics returns the source topics followed by every retry topic
+func (c Config) SubscribedTopics() []string {
+	topics := append([]string(nil), c.Topics...)
+	for tier := 1; tier <= len(c.RetryTiers); tier++ {
+		topics = append(topics, c.RetryTopic(tier))
+	}
+	return topics
+}
@@ -0,0 +1,159 @@
+// file: internal/infrastructure/kafka/consumer/dispatcher.go
+package consumer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+)
+
+// dispatcher hands consumed messages to a MessageHandler.
+//
+// A failing message is retried in place a few times with backoff, then moved to the
+// next retry tier: a topic whose messages are held back until their tier's delay has
+// passed. A message failing its last tier, or one that is malformed, is quarantined in
+// the dead letter store and sent to the dead letter topic. Either way the partition
+// moves on, so one poison message does not stall the messages behind it; the price is
+// that a retried message is no longer ordered with later messages of its key.
+type dispatcher struct {
+	handler     MessageHandler
+	sender      producer.MessageSender
+	deadLetters port.DeadLetterStore
+	config      Config
+	logger      *slog.Logger
+}
+
+// dispatch returns nil once the message is handled, moved to a retry tier or
+// dead-lettered, after which its offset may be committed. It only fails when ctx is done.
+func (d *dispatcher) dispatch(ctx context.Context, raw port.ConsumedEvent) error {
+	del := unwrap(raw)
+	if err := sleepUntil(ctx, del.notBefore); err != nil {
+		return err
+	}
+
+	var err error
+	attempt := 1
+	for ; ; attempt++ {
+		err = d.handler.HandleMessage(ctx, del.msg)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(err, ErrMalformedMessage) || attempt >= d.config.InPlaceAttempts {
+			break
+		}
+
+		delay := d.config.Backoff(attempt)
+		d.logger.Warn("failed to handle message, will retry",
+			slog.String("topic", del.msg.Topic),
+			slog.Int("partition", int(del.msg.Partition)),
+			slog.Int64("offset", del.msg.Offset),
+			slog.Int("tier", del.tier),
+			slog.Int("attempt", attempt),
+			slog.Duration("retry_in", delay),
+			slog.String("error", err.Error()),
+		)
+		if err := sleepUntil(ctx, time.Now().Add(delay)); err != nil {
+			return err
+		}
+	}
+
+	attempts := del.attempts + attempt
+	if errors.Is(err, ErrMalformedMessage) || del.tier >= len(d.config.RetryTiers) {
+		return d.deadLetter(ctx, del.msg, attempts, err)
+	}
+	return d.retryLater(ctx, del, attempts, err)
+}
+
+// retryLater moves a message to the next retry tier
+func (d *dispatcher) retryLater(ctx context.Context, del delivery, attempts int, cause error) error {
+	tier := del.tier + 1
+	notBefore := time.Now().Add(d.config.RetryTiers[del.tier])
+	msg := retryMessage(d.config, del.msg, tier, attempts, notBefore, cause.Error())
+
+	d.logger.Warn("moving message to retry tier",
+		slog.String("topic", del.msg.Topic),
+		slog.Int("partition", int(del.msg.Partition)),
+		slog.Int64("offset", del.msg.Offset),
+		slog.Int("tier", tier),
+		slog.Time("not_before", notBefore),
+		slog.String("error", cause.Error()),
+	)
+	return d.persist(ctx, "retry", func(ctx context.Context) error {
+		return d.sender.Send(ctx, msg)
+	})
+}
+
+// deadLetter quarantines a message and publishes it to the dead letter topic
+func (d *dispatcher) deadLetter(ctx context.Context, msg port.ConsumedEvent, attempts int, cause error) error {
+	dl := &port.DeadLetter{
+		ConsumedEvent:  msg,
+		Reason:         cause.Error(),
+		Attempts:       attempts,
+		DeadLetteredAt: time.Now().UTC(),
+	}
+
+	d.logger.Error("dead-lettering message",
+		slog.String("topic", msg.Topic),
+		slog.Int("partition", int(msg.Partition)),
+		slog.Int64("offset", msg.Offset),
+		slog.Int("attempts", attempts),
+		slog.String("error", cause.Error()),
+	)
+	if err := d.persist(ctx, "quarantine", func(ctx context.Context) error {
+		return d.deadLetters.Save(ctx, dl)
+	}); err != nil {
+		return err
+	}
+	return d.persist(ctx, "dead letter", func(ctx context.Context) error {
+		return d.sender.Send(ctx, wrap(d.config.DeadLetterTopic, msg, map[string]string{
+			HeaderAttempts:      strconv.Itoa(attempts),
+			HeaderFailureReason: dl.Reason,
+		}))
+	})
+}
+
+// persist retries fn until it succeeds or ctx is done. The message's offset is only
+// committed once it is safely stored elsewhere.
+func (d *dispatcher) persist(ctx context.Context, what string, fn func(ctx context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		delay := d.config.Backoff(attempt)
+		d.logger.Error("failed to store message for "+what+", will retry",
+			slog.Duration("retry_in", delay),
+			slog.String("error", err.Error()),
+		)
+		if err := sleepUntil(ctx, time.Now().Add(delay)); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepUntil waits until t, returning early with ctx's error when it is done
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,188 @@
+// file: internal/infrastructure/kafka/consumer/handler.go
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/event"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+)
+
+// ErrMalformedMessage is returned for messages that can never be handled, such as an
+// undecodable payload or an unknown event type. They are dead-lettered without retries.
+var ErrMalformedMessage = errors.New("malformed message")
+
+// MessageHandler processes one consumed message. A nil error means the message is
+// done with and its offset may be committed; any other error except
+// ErrMalformedMessage makes the consumer retry the message, through the retry tiers
+// and finally the dead letter topic.
+type MessageHandler interface {
+	HandleMessage(ctx context.Context, msg port.ConsumedEvent) error
+}
+
+// OrderEventHandler dispatches Order Service events to the order event use case.
+//
+// Before handling an event it claims a lease on it in the idempotency store, so two
+// consumers given the same redelivered event do not handle it side by side. The event
+// is completed in the handler's transaction together with its outcome events. A
+// duplicate delivery of a completed event publishes that stored outcome again instead
+// of handling the event a second time.
+type OrderEventHandler struct {
+	tx          port.TransactionManager
+	idempotency port.IdempotencyStore
+	orders      *usecase.OrderEventUseCase
+	lease       time.Duration
+	logger      *slog.Logger
+}
+
+// NewOrderEventHandler creates a new OrderEventHandler
+func NewOrderEventHandler(
+	tx port.TransactionManager,
+	idempotency port.IdempotencyStore,
+	orders *usecase.OrderEventUseCase,
+	lease time.Duration,
+	logger *slog.Logger,
+) *OrderEventHandler {
+	return &OrderEventHandler{
+		tx:          tx,
+		idempotency: idempotency,
+		orders:      orders,
+		lease:       lease,
+		logger:      logger,
+	}
+}
+
+var _ MessageHandler = (*OrderEventHandler)(nil)
+
+// HandleMessage decodes an order event and applies it exactly once
+func (h *OrderEventHandler) HandleMessage(ctx context.Context, msg port.ConsumedEvent) error {
+	dispatch, meta, err := h.decode(msg)
+	if err != nil {
+		return err
+	}
+
+	eventID := meta.EventID
+	if id := msg.Headers[producer.HeaderEventID]; id != "" {
+		eventID = id
+	}
+	if eventID == "" {
+		// Without an ID the message position is the only stable identity
+		eventID = fmt.Sprintf("%s-%d-%d", msg.Topic, msg.Partition, msg.Offset)
+	}
+	if meta.CorrelationID != "" {
+		ctx = usecase.WithCorrelationID(ctx, meta.CorrelationID)
+	}
+
+	owner := uuid.NewString()
+	record, err := h.idempotency.Claim(ctx, eventID, msg.Topic, owner, h.lease)
+	if err != nil {
+		return err
+	}
+	if record.Status == port.IdempotencyStatusCompleted {
+		return h.replay(ctx, record)
+	}
+
+	err = h.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		outcome, err := dispatch(ctx)
+		if err != nil {
+			return err
+		}
+		result, err := json.Marshal(outcome)
+		if err != nil {
+			return fmt.Errorf("failed to encode outcome of event %s: %w", eventID, err)
+		}
+		return h.idempotency.Complete(ctx, eventID, owner, result)
+	})
+	if err != nil {
+		// Release the lease even when ctx was cancelled, so the next delivery need not wait for it to expire
+		if failErr := h.idempotency.Fail(context.WithoutCancel(ctx), eventID, owner, err.Error()); failErr != nil &&
+			!errors.Is(failErr, port.ErrLeaseLost) {
+			h.logger.Warn("failed to release event lease",
+				slog.String("event_id", eventID),
+				slog.String("error", failErr.Error()),
+			)
+		}
+		return err
+	}
+	return nil
+}
+
+// replay publishes the stored outcome of an event that was already handled
+func (h *OrderEventHandler) replay(ctx context.Context, record *port.IdempotencyRecord) error {
+	var outcome []port.OutboxEntry
+	if len(record.Result) > 0 {
+		if err := json.Unmarshal(record.Result, &outcome); err != nil {
+			return fmt.Errorf("failed to decode stored outcome of event %s: %w", record.EventID, err)
+		}
+	}
+	h.logger.Debug("replaying outcome of already processed event",
+		slog.String("event_id", record.EventID),
+		slog.String("topic", record.Topic),
+		slog.Int("outcome_events", len(outcome)),
+	)
+	return h.orders.ReplayOutcome(ctx, outcome)
+}
+
+// outcomeFunc applies a decoded event and returns the outcome events it published
+type outcomeFunc func(ctx context.Context) ([]port.OutboxEntry, error)
+
+// decode parses the payload by the event_type header, falling back to the topic name
+// (which may carry an environment prefix such as "staging.order.created")
+func (h *OrderEventHandler) decode(msg port.ConsumedEvent) (outcomeFunc, event.EventMetadata, error) {
+	eventType := msg.Headers[producer.HeaderEventType]
+	if eventType == "" {
+		eventType = msg.Topic
+	}
+
+	switch {
+	case eventType == TopicOrderCreated || strings.HasSuffix(eventType, "."+TopicOrderCreated):
+		var evt event.OrderCreatedEvent
+		if err := unmarshal(msg, &evt); err != nil {
+			return nil, event.EventMetadata{}, err
+		}
+		return func(ctx context.Context) ([]port.OutboxEntry, error) {
+			return h.orders.HandleOrderCreated(ctx, evt)
+		}, event.EventMetadata{EventID: evt.EventID, CorrelationID: evt.CorrelationID}, nil
+
+	case eventType == TopicOrderCancelled || strings.HasSuffix(eventType, "."+TopicOrderCancelled):
+		var evt event.OrderCancelledEvent
+		if err := unmarshal(msg, &evt); err != nil {
+			return nil, event.EventMetadata{}, err
+		}
+		return func(ctx context.Context) ([]port.OutboxEntry, error) {
+			return h.orders.HandleOrderCancelled(ctx, evt)
+		}, event.EventMetadata{EventID: evt.EventID, CorrelationID: evt.CorrelationID}, nil
+
+	case eventType == TopicOrderFulfilled || strings.HasSuffix(eventType, "."+TopicOrderFulfilled):
+		var evt event.OrderFulfilledEvent
+		if err := unmarshal(msg, &evt); err != nil {
+			return nil, event.EventMetadata{}, err
+		}
+		return func(ctx context.Context) ([]port.OutboxEntry, error) {
+			return h.orders.HandleOrderFulfilled(ctx, evt)
+		}, event.EventMetadata{EventID: evt.EventID, CorrelationID: evt.CorrelationID}, nil
+	}
+
+	return nil, event.EventMetadata{}, fmt.Errorf("%w: unknown event type %q", ErrMalformedMessage, eventType)
+}
+
+// unmarshal decodes an order event payload; an event without an order ID is malformed
+func unmarshal(msg port.ConsumedEvent, evt event.DomainEvent) error {
+	if err := json.Unmarshal(msg.Value, evt); err != nil {
+		return fmt.Errorf("%w: decode %s payload: %v", ErrMalformedMessage, msg.Topic, err)
+	}
+	if evt.AggregateID() == "" {
+		return fmt.Errorf("%w: %s payload has no order ID", ErrMalformedMessage, msg.Topic)
+	}
+	return nil
+}
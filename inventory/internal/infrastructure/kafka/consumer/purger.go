@@ -0,0 +1,103 @@
+// file: internal/infrastructure/kafka/consumer/purger.go
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// IdempotencyPurger deletes idempotency records once they are older than the
+// configured TTL, bounding how long redelivered events are recognised
+type IdempotencyPurger struct {
+	store    port.IdempotencyStore
+	ttl      time.Duration
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped bool
+}
+
+// NewIdempotencyPurger creates a purger using the TTL and interval of the consumer configuration
+func NewIdempotencyPurger(store port.IdempotencyStore, config Config, logger *slog.Logger) *IdempotencyPurger {
+	return &IdempotencyPurger{
+		store:    store,
+		ttl:      config.IdempotencyTTL,
+		interval: config.PurgeInterval,
+		logger:   logger,
+	}
+}
+
+// Start purges in the background until Stop is called or ctx is done
+func (p *IdempotencyPurger) Start(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return errors.New("idempotency purger has been stopped")
+	}
+	if p.done != nil {
+		return errors.New("idempotency purger already started")
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go p.run(ctx)
+	return nil
+}
+
+// Stop waits for a purge in progress to finish, or for ctx
+func (p *IdempotencyPurger) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	if p.stopped || p.done == nil {
+		p.stopped = true
+		p.mu.Unlock()
+		return nil
+	}
+	p.stopped = true
+	p.cancel()
+	done := p.done
+	p.mu.Unlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("idempotency purger did not stop in time: %w", ctx.Err())
+	}
+}
+
+func (p *IdempotencyPurger) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.purge(ctx)
+		}
+	}
+}
+
+func (p *IdempotencyPurger) purge(ctx context.Context) {
+	purged, err := p.store.Purge(ctx, time.Now().Add(-p.ttl))
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Error("failed to purge idempotency records", slog.String("error", err.Error()))
+		}
+		return
+	}
+	if purged > 0 {
+		p.logger.Info("purged idempotency records", slog.Int64("count", purged))
+	}
+}
@@ -0,0 +1,30 @@
+// file: internal/infrastructure/kafka/consumer/replayer.go
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+)
+
+// Replayer implements port.MessageReplayer. A replayed message is put on the first
+// retry tier, due immediately, so it is processed by the consumer group like any other
+// retry and starts over with the full set of retry tiers.
+type Replayer struct {
+	config Config
+	sender producer.MessageSender
+}
+
+// NewReplayer creates a new Replayer
+func NewReplayer(config Config, sender producer.MessageSender) *Replayer {
+	return &Replayer{config: config, sender: sender}
+}
+
+var _ port.MessageReplayer = (*Replayer)(nil)
+
+// Replay sends a dead-lettered message back into processing
+func (r *Replayer) Replay(ctx context.Context, dl *port.DeadLetter) error {
+	return r.sender.Send(ctx, retryMessage(r.config, dl.ConsumedEvent, 1, 0, time.Now(), "replayed: "+dl.Reason))
+}
@@ -0,0 +1,103 @@
+// file: internal/infrastructure/kafka/consumer/retry.go
+package consumer
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+)
+
+// Headers the consumer adds to messages it moves to a retry or dead letter topic.
+// The original headers are kept alongside them.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderOriginalTimestamp = "x-original-timestamp"
+	HeaderRetryTier         = "x-retry-tier"
+	HeaderRetryNotBefore    = "x-retry-not-before" // Unix milliseconds
+	HeaderAttempts          = "x-attempts"
+	HeaderFailureReason     = "x-failure-reason"
+)
+
+var retryHeaders = []string{
+	HeaderOriginalTopic, HeaderOriginalPartition, HeaderOriginalOffset, HeaderOriginalTimestamp,
+	HeaderRetryTier, HeaderRetryNotBefore, HeaderAttempts, HeaderFailureReason,
+}
+
+// delivery is a consumed message as first received, together with its retry state
+type delivery struct {
+	msg       port.ConsumedEvent // Original topic, partition, offset and headers
+	tier      int                // 0 for the source topic, then the retry tier it was read from
+	attempts  int                // Attempts made in earlier tiers
+	notBefore time.Time
+}
+
+// unwrap restores the original message from a message read from a retry topic.
+// Messages from source topics are returned as they are.
+func unwrap(raw port.ConsumedEvent) delivery {
+	topic, ok := raw.Headers[HeaderOriginalTopic]
+	if !ok {
+		return delivery{msg: raw}
+	}
+
+	d := delivery{msg: raw}
+	d.msg.Topic = topic
+	d.msg.Headers = make(map[string]string, len(raw.Headers))
+	for k, v := range raw.Headers {
+		d.msg.Headers[k] = v
+	}
+	for _, h := range retryHeaders {
+		delete(d.msg.Headers, h)
+	}
+
+	if v, err := strconv.ParseInt(raw.Headers[HeaderOriginalPartition], 10, 32); err == nil {
+		d.msg.Partition = int32(v)
+	}
+	if v, err := strconv.ParseInt(raw.Headers[HeaderOriginalOffset], 10, 64); err == nil {
+		d.msg.Offset = v
+	}
+	if v, err := strconv.ParseInt(raw.Headers[HeaderOriginalTimestamp], 10, 64); err == nil {
+		d.msg.Timestamp = v
+	}
+	d.tier, _ = strconv.Atoi(raw.Headers[HeaderRetryTier])
+	d.attempts, _ = strconv.Atoi(raw.Headers[HeaderAttempts])
+	if v, err := strconv.ParseInt(raw.Headers[HeaderRetryNotBefore], 10, 64); err == nil {
+		d.notBefore = time.UnixMilli(v)
+	}
+	return d
+}
+
+// wrap builds the message that carries an original message to another topic
+func wrap(topic string, msg port.ConsumedEvent, extra map[string]string) producer.Message {
+	headers := make(map[string]string, len(msg.Headers)+len(extra)+4)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	headers[HeaderOriginalTopic] = msg.Topic
+	headers[HeaderOriginalPartition] = strconv.FormatInt(int64(msg.Partition), 10)
+	headers[HeaderOriginalOffset] = strconv.FormatInt(msg.Offset, 10)
+	headers[HeaderOriginalTimestamp] = strconv.FormatInt(msg.Timestamp, 10)
+	for k, v := range extra {
+		headers[k] = v
+	}
+	return producer.Message{
+		Topic:     topic,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// retryMessage schedules an original message on a retry tier
+func retryMessage(config Config, msg port.ConsumedEvent, tier, attempts int, notBefore time.Time, reason string) producer.Message {
+	return wrap(config.RetryTopic(tier), msg, map[string]string{
+		HeaderRetryTier:      strconv.Itoa(tier),
+		HeaderRetryNotBefore: strconv.FormatInt(notBefore.UnixMilli(), 10),
+		HeaderAttempts:       strconv.Itoa(attempts),
+		HeaderFailureReason:  reason,
+	})
+}
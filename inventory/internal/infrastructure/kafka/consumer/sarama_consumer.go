@@ -0,0 +1,192 @@
+// file: internal/infrastructure/kafka/consumer/sarama_consumer.go
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/IBM/sarama"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/infrastructure/kafka/producer"
+)
+
+// GroupConsumer implements port.EventConsumer with a Kafka consumer group.
+// Offsets are committed manually, one message at a time, only after the handler has
+// returned, so a message whose handler transaction did not commit is delivered again.
+type GroupConsumer struct {
+	config     Config
+	group      sarama.ConsumerGroup
+	dispatcher *dispatcher
+	logger     *slog.Logger
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped bool
+}
+
+// NewGroupConsumer connects a consumer group to the brokers in the configuration.
+// Messages are moved to retry and dead letter topics with sender and quarantined in deadLetters.
+func NewGroupConsumer(
+	config Config,
+	handler MessageHandler,
+	sender producer.MessageSender,
+	deadLetters port.DeadLetterStore,
+	logger *slog.Logger,
+) (*GroupConsumer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid consumer configuration: %w", err)
+	}
+
+	c := sarama.NewConfig()
+	c.ClientID = config.ClientID
+	c.Consumer.Offsets.AutoCommit.Enable = false
+	c.Consumer.Offsets.Initial = sarama.OffsetOldest
+	if config.InitialOffset == "newest" {
+		c.Consumer.Offsets.Initial = sarama.OffsetNewest
+	}
+	if config.SessionTimeout > 0 {
+		c.Consumer.Group.Session.Timeout = config.SessionTimeout
+		c.Consumer.Group.Heartbeat.Interval = config.SessionTimeout / 3
+	}
+	c.Consumer.Return.Errors = true
+
+	group, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer group: %w", err)
+	}
+	return &GroupConsumer{
+		config: config,
+		group:  group,
+		dispatcher: &dispatcher{
+			handler:     handler,
+			sender:      sender,
+			deadLetters: deadLetters,
+			config:      config,
+			logger:      logger,
+		},
+		logger: logger,
+	}, nil
+}
+
+var _ port.EventConsumer = (*GroupConsumer)(nil)
+
+// Start joins the group and consumes in the background until Stop is called or ctx is done
+func (g *GroupConsumer) Start(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.stopped {
+		return errors.New("consumer has been stopped")
+	}
+	if g.done != nil {
+		return errors.New("consumer already started")
+	}
+
+	ctx, g.cancel = context.WithCancel(ctx)
+	g.done = make(chan struct{})
+	go g.run(ctx)
+	go g.logErrors()
+
+	g.logger.Info("kafka consumer started",
+		slog.String("group_id", g.config.GroupID),
+		slog.Any("topics", g.config.SubscribedTopics()),
+	)
+	return nil
+}
+
+// Stop lets the message in flight finish, leaves the group and closes the client
+func (g *GroupConsumer) Stop(ctx context.Context) error {
+	g.mu.Lock()
+	if g.stopped {
+		g.mu.Unlock()
+		return nil
+	}
+	g.stopped = true
+	done := g.done
+	if g.cancel != nil {
+		g.cancel()
+	}
+	g.mu.Unlock()
+
+	if done != nil {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return fmt.Errorf("consumer did not stop in time: %w", ctx.Err())
+		}
+	}
+	if err := g.group.Close(); err != nil {
+		return fmt.Errorf("failed to close consumer group: %w", err)
+	}
+	g.logger.Info("kafka consumer stopped")
+	return nil
+}
+
+// run rejoins the group after every rebalance until ctx is done
+func (g *GroupConsumer) run(ctx context.Context) {
+	defer close(g.done)
+	for ctx.Err() == nil {
+		if err := g.group.Consume(ctx, g.config.SubscribedTopics(), g); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+			g.logger.Error("consumer group session failed", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (g *GroupConsumer) logErrors() {
+	for err := range g.group.Errors() {
+		g.logger.Error("kafka consumer error", slog.String("error", err.Error()))
+	}
+}
+
+// Setup is run at the beginning of a new session, before ConsumeClaim
+func (g *GroupConsumer) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup is run at the end of a session, once all ConsumeClaim goroutines have exited
+func (g *GroupConsumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim processes the messages of one partition in order and commits each
+// offset after its message was handled
+func (g *GroupConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if err := g.dispatcher.dispatch(ctx, toConsumedEvent(msg)); err != nil {
+				// Session ended mid-retry; the next owner of the partition redelivers the message
+				return nil
+			}
+			session.MarkMessage(msg, "")
+			session.Commit()
+		}
+	}
+}
+
+func toConsumedEvent(msg *sarama.ConsumerMessage) port.ConsumedEvent {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		if h != nil {
+			headers[string(h.Key)] = string(h.Value)
+		}
+	}
+	return port.ConsumedEvent{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Timestamp: msg.Timestamp.UnixMilli(),
+	}
+}
@@ -2,6 +2,8 @@
 package producer
 
 import (
+	"errors"
+	"fmt"
 	"time"
 )
 
@@ -35,12 +37,57 @@ func DefaultConfig() Config {
 	}
 }
 
+// Validate reports settings that cannot be applied together
+func (c Config) Validate() error {
+	if len(c.Brokers) == 0 {
+		return errors.New("at least one broker is required")
+	}
+	switch c.Acks {
+	case "all", "1", "0":
+	default:
+		return fmt.Errorf("invalid acks %q: must be \"all\", \"1\" or \"0\"", c.Acks)
+	}
+	switch c.CompressionType {
+	case "", "none", "gzip", "snappy", "lz4", "zstd":
+	default:
+		return fmt.Errorf("invalid compression type %q", c.CompressionType)
+	}
+	if c.MaxRetries < 0 || c.BatchSize < 0 || c.LingerMs < 0 {
+		return errors.New("retries, batch size and linger cannot be negative")
+	}
+	if c.IdempotentEnabled && (c.Acks != "all" || c.MaxRetries < 1) {
+		return errors.New("idempotent producer requires acks \"all\" and at least one retry")
+	}
+	return nil
+}
+
+// Backoff returns the delay before the given retry (1 for the first retry),
+// doubling from RetryBackoff up to MaxBackoff
+func (c Config) Backoff(retry int) time.Duration {
+	delay := c.RetryBackoff
+	for i := 1; i < retry && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if c.MaxBackoff > 0 && delay > c.MaxBackoff {
+		return c.MaxBackoff
+	}
+	return delay
+}
+
+// Linger returns how long a partial batch waits for more messages before being sent
+func (c Config) Linger() time.Duration {
+	return time.Duration(c.LingerMs) * time.Millisecond
+}
+
 // OutboxConfig holds configuration for outbox processing
 type OutboxConfig struct {
 	PollInterval    time.Duration
 	BatchSize       int
 	MaxRetries      int
 	RetentionPeriod time.Duration // How long to keep published entries
+	RetryBackoff    time.Duration // Delay before the first retry of a failed entry, doubled per attempt
+	MaxRetryBackoff time.Duration
+	PurgeInterval   time.Duration // How often published entries past RetentionPeriod are deleted
 }
 
 // DefaultOutboxConfig returns default outbox configuration
@@ -50,5 +97,8 @@ func DefaultOutboxConfig() OutboxConfig {
 		BatchSize:       100,
 		MaxRetries:      5,
 		RetentionPeriod: 7 * 24 * time.Hour,
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 5 * time.Minute,
+		PurgeInterval:   time.Hour,
 	}
 }
\ No newline at end of file
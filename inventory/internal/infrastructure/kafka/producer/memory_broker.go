@@ -0,0 +1,224 @@
+// file: internal/infrastructure/kafka/producer/memory_broker.go
+package producer
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// ErrBrokerUnavailable is the default error injected by MemoryBroker.FailNext
+var ErrBrokerUnavailable = errors.New("broker unavailable")
+
+// StoredMessage is a message accepted by a MemoryBroker
+type StoredMessage struct {
+	Message
+	Partition   int32
+	Offset      int64
+	Batch       int64  // Identifies the produce batch the message was written in
+	Compression string // Codec the batch was written with
+}
+
+// injectedFailure makes one delivery attempt fail. When delivered is set the message is
+// written before the error is returned, as when a broker's acknowledgement is lost.
+type injectedFailure struct {
+	err       error
+	delivered bool
+}
+
+// MemoryBroker is an in-process Transport standing in for a Kafka cluster in tests and
+// local runs. It applies the same Config as the real transport where that is observable
+// in a single process: keys are hashed to partitions, failed attempts are retried with
+// backoff up to MaxRetries, acks "0" never reports errors, batches are split at BatchSize
+// bytes and stamped with the compression codec, and with idempotence enabled a retry
+// after a lost acknowledgement does not duplicate the message.
+type MemoryBroker struct {
+	config     Config
+	partitions int32
+
+	mu        sync.Mutex
+	topics    map[string][][]StoredMessage
+	failures  []injectedFailure
+	nextBatch int64
+	closed    bool
+}
+
+// NewMemoryBroker creates a broker whose topics have the given number of partitions
+func NewMemoryBroker(config Config, partitions int) *MemoryBroker {
+	if partitions < 1 {
+		partitions = 1
+	}
+	return &MemoryBroker{
+		config:     config,
+		partitions: int32(partitions),
+		topics:     make(map[string][][]StoredMessage),
+	}
+}
+
+var _ Transport = (*MemoryBroker)(nil)
+
+// FailNext makes the next n delivery attempts fail with err (ErrBrokerUnavailable when nil).
+// With delivered set, each failing attempt still writes the message, simulating a lost
+// acknowledgement.
+func (b *MemoryBroker) FailNext(n int, err error, delivered bool) {
+	if err == nil {
+		err = ErrBrokerUnavailable
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := 0; i < n; i++ {
+		b.failures = append(b.failures, injectedFailure{err: err, delivered: delivered})
+	}
+}
+
+// Messages returns the messages of a topic ordered by partition and offset
+func (b *MemoryBroker) Messages(topic string) []StoredMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var msgs []StoredMessage
+	for _, partition := range b.topics[topic] {
+		msgs = append(msgs, partition...)
+	}
+	return msgs
+}
+
+// SendMessages writes msgs in batches, retrying failed batches as configured
+func (b *MemoryBroker) SendMessages(ctx context.Context, msgs []Message) error {
+	for _, batch := range b.split(msgs) {
+		if err := b.sendBatch(ctx, batch); err != nil && b.config.Acks != "0" {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close marks the broker closed; later sends fail
+func (b *MemoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+// split groups msgs into batches of at most BatchSize bytes of keys and values
+func (b *MemoryBroker) split(msgs []Message) [][]Message {
+	var batches [][]Message
+	var current []Message
+	size := 0
+	for _, msg := range msgs {
+		msgSize := len(msg.Key) + len(msg.Value)
+		if len(current) > 0 && b.config.BatchSize > 0 && size+msgSize > b.config.BatchSize {
+			batches = append(batches, current)
+			current, size = nil, 0
+		}
+		current = append(current, msg)
+		size += msgSize
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+func (b *MemoryBroker) sendBatch(ctx context.Context, msgs []Message) error {
+	b.mu.Lock()
+	batchID := b.nextBatch
+	b.nextBatch++
+	b.mu.Unlock()
+
+	written := false
+	for retry := 0; ; retry++ {
+		if retry > 0 {
+			timer := time.NewTimer(b.config.Backoff(retry))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		err := b.attempt(batchID, msgs, written)
+		if err == nil || errors.Is(err, ErrProducerClosed) {
+			return err
+		}
+		var failure *attemptError
+		if errors.As(err, &failure) {
+			written = written || failure.delivered
+			err = failure.err
+		}
+		// Without acknowledgements a failure is never seen, so there is nothing to retry
+		if retry >= b.config.MaxRetries || b.config.Acks == "0" {
+			return err
+		}
+	}
+}
+
+type attemptError struct {
+	injectedFailure
+}
+
+func (e *attemptError) Error() string { return e.err.Error() }
+
+// attempt performs one delivery of a batch. When the batch was already written by an
+// earlier attempt whose acknowledgement was lost, an idempotent producer's retry is
+// recognised and not written again.
+func (b *MemoryBroker) attempt(batchID int64, msgs []Message, alreadyWritten bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrProducerClosed
+	}
+
+	var failure *injectedFailure
+	if len(b.failures) > 0 {
+		failure = &b.failures[0]
+		b.failures = b.failures[1:]
+		if !failure.delivered {
+			return &attemptError{*failure}
+		}
+	}
+
+	if !(alreadyWritten && b.config.IdempotentEnabled) {
+		for _, msg := range msgs {
+			b.append(batchID, msg)
+		}
+	}
+	if failure != nil {
+		return &attemptError{*failure}
+	}
+	return nil
+}
+
+func (b *MemoryBroker) append(batchID int64, msg Message) {
+	partitions, ok := b.topics[msg.Topic]
+	if !ok {
+		partitions = make([][]StoredMessage, b.partitions)
+		b.topics[msg.Topic] = partitions
+	}
+
+	partition := b.partitionFor(msg.Key)
+	compression := b.config.CompressionType
+	if compression == "" {
+		compression = "none"
+	}
+	partitions[partition] = append(partitions[partition], StoredMessage{
+		Message:     msg,
+		Partition:   partition,
+		Offset:      int64(len(partitions[partition])),
+		Batch:       batchID,
+		Compression: compression,
+	})
+}
+
+// partitionFor hashes a key to a partition; unkeyed messages go to partition 0
+func (b *MemoryBroker) partitionFor(key []byte) int32 {
+	if len(key) == 0 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	return int32(h.Sum32() % uint32(b.partitions))
+}
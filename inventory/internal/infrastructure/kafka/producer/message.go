@@ -0,0 +1,76 @@
+// file: internal/infrastructure/kafka/producer/message.go
+package producer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// Header keys set on every published message
+const (
+	HeaderEventID       = "event_id"
+	HeaderEventType     = "event_type"
+	HeaderAggregateType = "aggregate_type"
+	HeaderCorrelationID = "correlation_id"
+	HeaderContentType   = "content_type"
+)
+
+// Message is a record to be written to a Kafka topic
+type Message struct {
+	Topic     string
+	Key       []byte
+	Value     []byte
+	Headers   map[string]string
+	Timestamp time.Time
+}
+
+// MessageSender delivers messages to Kafka. Send returns once the message has been
+// acknowledged according to the producer's acknowledgement settings.
+type MessageSender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// TopicMapper maps event types to Kafka topics
+type TopicMapper interface {
+	GetTopic(eventType string) string
+}
+
+// DefaultTopicMapper publishes each event type to the topic of the same name,
+// optionally prefixed (e.g. "staging.inventory.stock.reserved")
+type DefaultTopicMapper struct {
+	prefix string
+}
+
+// NewDefaultTopicMapper creates a new DefaultTopicMapper
+func NewDefaultTopicMapper(prefix string) *DefaultTopicMapper {
+	return &DefaultTopicMapper{prefix: prefix}
+}
+
+// GetTopic returns the Kafka topic for an event type
+func (m *DefaultTopicMapper) GetTopic(eventType string) string {
+	if m.prefix == "" {
+		return eventType
+	}
+	return fmt.Sprintf("%s.%s", m.prefix, eventType)
+}
+
+// newOutboxMessage builds the Kafka message for an outbox entry.
+// Messages are keyed by aggregate ID so each aggregate's events share a partition.
+func newOutboxMessage(topics TopicMapper, entry port.OutboxEntry) Message {
+	return Message{
+		Topic: topics.GetTopic(entry.EventType),
+		Key:   []byte(entry.AggregateID),
+		Value: entry.Payload,
+		Headers: map[string]string{
+			HeaderEventID:       entry.ID,
+			HeaderEventType:     entry.EventType,
+			HeaderAggregateType: entry.AggregateType,
+			HeaderCorrelationID: entry.CorrelationID,
+			HeaderContentType:   "application/json",
+		},
+		Timestamp: time.UnixMilli(entry.CreatedAt).UTC(),
+	}
+}
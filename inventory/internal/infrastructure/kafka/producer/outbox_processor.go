@@ -0,0 +1,219 @@
+// file: internal/infrastructure/kafka/producer/outbox_processor.go
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// OutboxProcessor implements port.OutboxProcessor. It claims pending outbox entries,
+// sends them to Kafka and records the outcome in the same transaction, so delivery is
+// at-least-once: an entry sent just before a failed commit is sent again later.
+//
+// Entries of one aggregate are delivered in the order they were written. When an entry
+// fails, later entries of its aggregate wait until it is delivered or parked.
+type OutboxProcessor struct {
+	tx     port.TransactionManager
+	store  port.OutboxStore
+	sender MessageSender
+	topics TopicMapper
+	config OutboxConfig
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped bool
+}
+
+// NewOutboxProcessor creates a new OutboxProcessor
+func NewOutboxProcessor(
+	tx port.TransactionManager,
+	store port.OutboxStore,
+	sender MessageSender,
+	topics TopicMapper,
+	config OutboxConfig,
+	logger *slog.Logger,
+) *OutboxProcessor {
+	return &OutboxProcessor{
+		tx:     tx,
+		store:  store,
+		sender: sender,
+		topics: topics,
+		config: config,
+		logger: logger,
+	}
+}
+
+var _ port.OutboxProcessor = (*OutboxProcessor)(nil)
+
+// Start begins polling the outbox in the background until Stop is called or ctx is done
+func (p *OutboxProcessor) Start(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return errors.New("outbox processor has been stopped")
+	}
+	if p.done != nil {
+		return errors.New("outbox processor already started")
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go p.run(ctx)
+
+	p.logger.Info("outbox processor started",
+		slog.Duration("poll_interval", p.config.PollInterval),
+		slog.Int("batch_size", p.config.BatchSize),
+	)
+	return nil
+}
+
+// Stop signals the processor to finish its current batch and waits for it, or for ctx
+func (p *OutboxProcessor) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	if p.stopped || p.done == nil {
+		p.stopped = true
+		p.mu.Unlock()
+		return nil
+	}
+	p.stopped = true
+	p.cancel()
+	done := p.done
+	p.mu.Unlock()
+
+	select {
+	case <-done:
+		p.logger.Info("outbox processor stopped")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("outbox processor did not stop in time: %w", ctx.Err())
+	}
+}
+
+func (p *OutboxProcessor) run(ctx context.Context) {
+	defer close(p.done)
+
+	poll := time.NewTicker(p.config.PollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(p.config.PurgeInterval)
+	defer purge.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-purge.C:
+			p.purge(ctx)
+		case <-poll.C:
+			// Keep draining while batches come back full
+			for ctx.Err() == nil {
+				n, err := p.processBatch(ctx, p.config.BatchSize)
+				if err != nil {
+					if ctx.Err() == nil {
+						p.logger.Error("failed to process outbox entries", slog.String("error", err.Error()))
+					}
+					break
+				}
+				if n < p.config.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ProcessPendingEvents claims up to batchSize due entries and publishes them
+func (p *OutboxProcessor) ProcessPendingEvents(ctx context.Context, batchSize int) error {
+	_, err := p.processBatch(ctx, batchSize)
+	return err
+}
+
+// processBatch publishes one batch and returns how many entries were claimed
+func (p *OutboxProcessor) processBatch(ctx context.Context, batchSize int) (int, error) {
+	claimed := 0
+	err := p.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		entries, err := p.store.ClaimPending(ctx, batchSize)
+		if err != nil {
+			return err
+		}
+		claimed = len(entries)
+
+		published := make([]string, 0, len(entries))
+		blocked := make(map[string]bool)
+		for _, entry := range entries {
+			aggregate := entry.AggregateType + "/" + entry.AggregateID
+			if blocked[aggregate] {
+				continue
+			}
+
+			if err := p.sender.Send(ctx, newOutboxMessage(p.topics, entry.OutboxEntry)); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				blocked[aggregate] = true
+				if err := p.recordFailure(ctx, entry, err); err != nil {
+					return err
+				}
+				continue
+			}
+			published = append(published, entry.ID)
+		}
+
+		return p.store.MarkPublished(ctx, published)
+	})
+	return claimed, err
+}
+
+func (p *OutboxProcessor) recordFailure(ctx context.Context, entry port.PendingOutboxEntry, sendErr error) error {
+	attempts := entry.Attempts + 1
+	park := attempts >= p.config.MaxRetries
+	nextAttempt := time.Now().Add(p.backoff(attempts))
+
+	if park {
+		p.logger.Error("parking outbox entry after repeated delivery failures",
+			slog.String("entry_id", entry.ID),
+			slog.String("event_type", entry.EventType),
+			slog.String("aggregate_id", entry.AggregateID),
+			slog.Int("attempts", attempts),
+			slog.String("error", sendErr.Error()),
+		)
+	} else {
+		p.logger.Warn("failed to publish outbox entry, will retry",
+			slog.String("entry_id", entry.ID),
+			slog.String("event_type", entry.EventType),
+			slog.Int("attempts", attempts),
+			slog.Time("next_attempt_at", nextAttempt),
+			slog.String("error", sendErr.Error()),
+		)
+	}
+	return p.store.MarkFailed(ctx, entry.ID, sendErr.Error(), nextAttempt, park)
+}
+
+// backoff returns the delay before the given attempt number is retried
+func (p *OutboxProcessor) backoff(attempts int) time.Duration {
+	delay := p.config.RetryBackoff
+	for i := 1; i < attempts && delay < p.config.MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.config.MaxRetryBackoff)
+}
+
+func (p *OutboxProcessor) purge(ctx context.Context) {
+	purged, err := p.store.PurgePublished(ctx, time.Now().Add(-p.config.RetentionPeriod))
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Error("failed to purge published outbox entries", slog.String("error", err.Error()))
+		}
+		return
+	}
+	if purged > 0 {
+		p.logger.Info("purged published outbox entries", slog.Int64("count", purged))
+	}
+}
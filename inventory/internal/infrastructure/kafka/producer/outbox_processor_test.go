@@ -0,0 +1,230 @@
+// file: internal/infrastructure/kafka/producer/outbox_processor_test.go
+package producer
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+type noTx struct{}
+
+func (noTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// memOutboxStore keeps outbox entries in memory. It claims pending entries in sequence
+// order regardless of their next attempt time, so a retry can be driven immediately.
+type memOutboxStore struct {
+	mu      sync.Mutex
+	entries []*memOutboxEntry
+}
+
+type memOutboxEntry struct {
+	port.PendingOutboxEntry
+	published bool
+	parked    bool
+	lastError string
+}
+
+func (s *memOutboxStore) add(entries ...port.OutboxEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range entries {
+		s.entries = append(s.entries, &memOutboxEntry{
+			PendingOutboxEntry: port.PendingOutboxEntry{OutboxEntry: e, Sequence: int64(len(s.entries) + 1)},
+		})
+	}
+}
+
+func (s *memOutboxStore) get(id string) memOutboxEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if e.ID == id {
+			return *e
+		}
+	}
+	return memOutboxEntry{}
+}
+
+func (s *memOutboxStore) ClaimPending(_ context.Context, limit int) ([]port.PendingOutboxEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var claimed []port.PendingOutboxEntry
+	for _, e := range s.entries {
+		if len(claimed) == limit {
+			break
+		}
+		if !e.published && !e.parked {
+			claimed = append(claimed, e.PendingOutboxEntry)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *memOutboxStore) MarkPublished(_ context.Context, ids []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if slices.Contains(ids, e.ID) {
+			e.published = true
+		}
+	}
+	return nil
+}
+
+func (s *memOutboxStore) MarkFailed(_ context.Context, id string, reason string, _ time.Time, park bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if e.ID == id {
+			e.Attempts++
+			e.lastError = reason
+			e.parked = park
+		}
+	}
+	return nil
+}
+
+func (s *memOutboxStore) PurgePublished(context.Context, time.Time) (int64, error) { return 0, nil }
+
+func outboxEntry(id, aggregateID, eventType string) port.OutboxEntry {
+	return port.OutboxEntry{
+		ID:            id,
+		AggregateType: "reservation",
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       []byte(`{}`),
+		CorrelationID: "corr-" + id,
+		CreatedAt:     time.Now().UnixMilli(),
+	}
+}
+
+// newTestProcessor wires an OutboxProcessor to a memory broker whose producer does not
+// retry, so every injected failure fails one outbox delivery
+func newTestProcessor(t *testing.T, maxRetries int) (*OutboxProcessor, *memOutboxStore, *MemoryBroker) {
+	t.Helper()
+	config := testConfig()
+	config.MaxRetries = 0
+	config.IdempotentEnabled = false
+	p, broker := newTestProducer(t, config, 3)
+
+	outbox := DefaultOutboxConfig()
+	outbox.MaxRetries = maxRetries
+	store := &memOutboxStore{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewOutboxProcessor(noTx{}, store, p, NewDefaultTopicMapper("test"), outbox, logger), store, broker
+}
+
+func TestOutboxProcessorPublishesEntries(t *testing.T) {
+	processor, store, broker := newTestProcessor(t, 5)
+	store.add(
+		outboxEntry("1", "res-1", "inventory.stock.reserved"),
+		outboxEntry("2", "res-1", "inventory.stock.released"),
+		outboxEntry("3", "res-2", "inventory.stock.reserved"),
+	)
+
+	if err := processor.ProcessPendingEvents(context.Background(), 10); err != nil {
+		t.Fatal(err)
+	}
+
+	reserved := broker.Messages("test.inventory.stock.reserved")
+	released := broker.Messages("test.inventory.stock.released")
+	if len(reserved) != 2 || len(released) != 1 {
+		t.Fatalf("messages per topic: got %d reserved and %d released, want 2 and 1", len(reserved), len(released))
+	}
+	for _, msg := range append(reserved, released...) {
+		id := msg.Headers[HeaderCorrelationID][len("corr-"):]
+		entry := store.get(id)
+		if string(msg.Key) != entry.AggregateID {
+			t.Errorf("entry %s key: got %q, want %q", id, msg.Key, entry.AggregateID)
+		}
+		if got := msg.Headers[HeaderEventID]; got != entry.ID {
+			t.Errorf("entry %s event ID header: got %q, want %q", id, got, entry.ID)
+		}
+		if got := msg.Headers[HeaderEventType]; got != entry.EventType {
+			t.Errorf("entry %s event type header: got %q, want %q", id, got, entry.EventType)
+		}
+		if !entry.published {
+			t.Errorf("entry %s was sent but not marked published", id)
+		}
+	}
+}
+
+func TestOutboxProcessorRetriesFailedSend(t *testing.T) {
+	processor, store, broker := newTestProcessor(t, 5)
+	store.add(
+		outboxEntry("1", "res-1", "inventory.stock.reserved"),
+		outboxEntry("2", "res-1", "inventory.stock.released"),
+		outboxEntry("3", "res-2", "inventory.stock.reserved"),
+	)
+	ctx := context.Background()
+
+	broker.FailNext(1, nil, false)
+	if err := processor.ProcessPendingEvents(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := store.get("1")
+	if failed.published || failed.parked {
+		t.Fatalf("failed entry: published %v, parked %v; want it pending for retry", failed.published, failed.parked)
+	}
+	if failed.Attempts != 1 || failed.lastError == "" {
+		t.Errorf("failed entry: attempts %d, last error %q; want 1 attempt and the send error", failed.Attempts, failed.lastError)
+	}
+	if store.get("2").published {
+		t.Error("entry 2 was published before the earlier entry of its aggregate")
+	}
+	if !store.get("3").published {
+		t.Error("entry 3 of another aggregate was held back by the failure")
+	}
+
+	if err := processor.ProcessPendingEvents(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"1", "2"} {
+		if !store.get(id).published {
+			t.Errorf("entry %s not published on retry", id)
+		}
+	}
+	delivered := 0
+	for _, topic := range []string{"test.inventory.stock.reserved", "test.inventory.stock.released"} {
+		for _, msg := range broker.Messages(topic) {
+			if string(msg.Key) == "res-1" {
+				delivered++
+			}
+		}
+	}
+	if delivered != 2 {
+		t.Fatalf("messages of res-1: got %d, want each entry once", delivered)
+	}
+}
+
+func TestOutboxProcessorParksAfterMaxRetries(t *testing.T) {
+	processor, store, broker := newTestProcessor(t, 2)
+	store.add(outboxEntry("1", "res-1", "inventory.stock.reserved"))
+	ctx := context.Background()
+
+	broker.FailNext(2, nil, false)
+	for range 3 {
+		if err := processor.ProcessPendingEvents(ctx, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entry := store.get("1")
+	if !entry.parked || entry.published || entry.Attempts != 2 {
+		t.Fatalf("entry: parked %v, published %v, attempts %d; want parked after 2 attempts",
+			entry.parked, entry.published, entry.Attempts)
+	}
+	if got := len(broker.Messages("test.inventory.stock.reserved")); got != 0 {
+		t.Errorf("messages sent: got %d, want none", got)
+	}
+}
@@ -0,0 +1,90 @@
+// file: internal/infrastructure/kafka/producer/producer.go
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrProducerClosed is returned when sending through a closed producer
+var ErrProducerClosed = errors.New("producer is closed")
+
+// Transport delivers messages to a Kafka cluster. Implementations are created from a
+// Config and apply its delivery settings: acknowledgements, retries with backoff,
+// batching and linger, compression and idempotence.
+type Transport interface {
+	// SendMessages returns once every message was acknowledged as required by the
+	// configured acks, or with the first delivery error after retries are exhausted
+	SendMessages(ctx context.Context, msgs []Message) error
+	// Close flushes buffered messages and releases the connection
+	Close() error
+}
+
+// Producer publishes messages to Kafka through a Transport. It implements MessageSender.
+type Producer struct {
+	config    Config
+	transport Transport
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// New creates a Producer on the given transport after validating the configuration
+func New(config Config, transport Transport) (*Producer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid producer configuration: %w", err)
+	}
+	return &Producer{config: config, transport: transport}, nil
+}
+
+// NewKafkaProducer creates a Producer connected to the brokers in the configuration
+func NewKafkaProducer(config Config) (*Producer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid producer configuration: %w", err)
+	}
+	transport, err := NewSaramaTransport(config)
+	if err != nil {
+		return nil, err
+	}
+	return New(config, transport)
+}
+
+var _ MessageSender = (*Producer)(nil)
+
+// Send publishes a single message
+func (p *Producer) Send(ctx context.Context, msg Message) error {
+	return p.SendBatch(ctx, []Message{msg})
+}
+
+// SendBatch publishes messages in order. Messages with the same key go to the same
+// partition, so their relative order is preserved by Kafka.
+func (p *Producer) SendBatch(ctx context.Context, msgs []Message) error {
+	for _, msg := range msgs {
+		if msg.Topic == "" {
+			return errors.New("message topic is required")
+		}
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrProducerClosed
+	}
+	if err := p.transport.SendMessages(ctx, msgs); err != nil {
+		return fmt.Errorf("failed to send %d message(s): %w", len(msgs), err)
+	}
+	return nil
+}
+
+// Close flushes pending messages and closes the transport. Later sends fail with ErrProducerClosed.
+func (p *Producer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	return p.transport.Close()
+}
@@ -0,0 +1,169 @@
+// file: internal/infrastructure/kafka/producer/producer_test.go
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// testConfig is DefaultConfig with backoffs short enough for tests
+func testConfig() Config {
+	config := DefaultConfig()
+	config.RetryBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	return config
+}
+
+func newTestProducer(t *testing.T, config Config, partitions int) (*Producer, *MemoryBroker) {
+	t.Helper()
+	broker := NewMemoryBroker(config, partitions)
+	p, err := New(config, broker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = p.Close() })
+	return p, broker
+}
+
+func TestNewOutboxMessage(t *testing.T) {
+	entry := port.OutboxEntry{
+		ID:            "event-1",
+		AggregateType: "reservation",
+		AggregateID:   "res-1",
+		EventType:     "inventory.stock.reserved",
+		Payload:       []byte(`{"reservation_id":"res-1"}`),
+		CorrelationID: "corr-1",
+		CreatedAt:     time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC).UnixMilli(),
+	}
+
+	tests := []struct {
+		prefix    string
+		wantTopic string
+	}{
+		{prefix: "", wantTopic: "inventory.stock.reserved"},
+		{prefix: "staging", wantTopic: "staging.inventory.stock.reserved"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("prefix %q", tt.prefix), func(t *testing.T) {
+			msg := newOutboxMessage(NewDefaultTopicMapper(tt.prefix), entry)
+			if msg.Topic != tt.wantTopic {
+				t.Errorf("topic: got %q, want %q", msg.Topic, tt.wantTopic)
+			}
+			if string(msg.Key) != entry.AggregateID {
+				t.Errorf("key: got %q, want aggregate ID %q", msg.Key, entry.AggregateID)
+			}
+			if string(msg.Value) != string(entry.Payload) {
+				t.Errorf("value: got %s, want %s", msg.Value, entry.Payload)
+			}
+			if !msg.Timestamp.Equal(time.UnixMilli(entry.CreatedAt)) {
+				t.Errorf("timestamp: got %v, want %v", msg.Timestamp, time.UnixMilli(entry.CreatedAt))
+			}
+			wantHeaders := map[string]string{
+				HeaderEventID:       "event-1",
+				HeaderEventType:     "inventory.stock.reserved",
+				HeaderAggregateType: "reservation",
+				HeaderCorrelationID: "corr-1",
+				HeaderContentType:   "application/json",
+			}
+			for key, want := range wantHeaders {
+				if got := msg.Headers[key]; got != want {
+					t.Errorf("header %s: got %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestProducerKeepsKeyOrderWithinPartition(t *testing.T) {
+	p, broker := newTestProducer(t, testConfig(), 4)
+	ctx := context.Background()
+
+	keys := []string{"res-1", "res-2", "res-3"}
+	for i := range 5 {
+		for _, key := range keys {
+			msg := Message{Topic: "stock", Key: []byte(key), Value: []byte(fmt.Sprint(i))}
+			if err := p.Send(ctx, msg); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	partitions := make(map[string]int32)
+	values := make(map[string]string)
+	for _, msg := range broker.Messages("stock") {
+		key := string(msg.Key)
+		if partition, seen := partitions[key]; seen && partition != msg.Partition {
+			t.Fatalf("key %s written to partitions %d and %d", key, partition, msg.Partition)
+		}
+		partitions[key] = msg.Partition
+		values[key] += string(msg.Value)
+	}
+	for _, key := range keys {
+		if values[key] != "01234" {
+			t.Errorf("messages of key %s: got order %q, want %q", key, values[key], "01234")
+		}
+	}
+}
+
+func TestProducerRejectsMessageWithoutTopic(t *testing.T) {
+	p, broker := newTestProducer(t, testConfig(), 1)
+	err := p.SendBatch(context.Background(), []Message{{Topic: "stock"}, {Key: []byte("k")}})
+	if err == nil {
+		t.Fatal("expected an error for a message without topic")
+	}
+	if got := len(broker.Messages("stock")); got != 0 {
+		t.Errorf("messages written: got %d, want none of the batch", got)
+	}
+}
+
+func TestProducerRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		failures   int
+		delivered  bool // Failing attempts still write the message
+		idempotent bool
+		wantErr    bool
+		wantStored int
+	}{
+		{name: "recovers within retries", failures: 2, idempotent: true, wantStored: 1},
+		{name: "fails after retries", failures: 6, idempotent: true, wantErr: true, wantStored: 0},
+		{name: "lost ack is not duplicated when idempotent", failures: 1, delivered: true, idempotent: true, wantStored: 1},
+		{name: "lost ack is duplicated otherwise", failures: 1, delivered: true, wantStored: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testConfig()
+			config.IdempotentEnabled = tt.idempotent
+			p, broker := newTestProducer(t, config, 1)
+			broker.FailNext(tt.failures, nil, tt.delivered)
+
+			err := p.Send(context.Background(), Message{Topic: "stock", Key: []byte("k"), Value: []byte("v")})
+			if tt.wantErr {
+				if !errors.Is(err, ErrBrokerUnavailable) {
+					t.Fatalf("error: got %v, want %v", err, ErrBrokerUnavailable)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(broker.Messages("stock")); got != tt.wantStored {
+				t.Errorf("stored messages: got %d, want %d", got, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestProducerClosed(t *testing.T) {
+	p, _ := newTestProducer(t, testConfig(), 1)
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	err := p.Send(context.Background(), Message{Topic: "stock"})
+	if !errors.Is(err, ErrProducerClosed) {
+		t.Fatalf("error: got %v, want %v", err, ErrProducerClosed)
+	}
+}
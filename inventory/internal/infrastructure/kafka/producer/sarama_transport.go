@@ -0,0 +1,133 @@
+// file: internal/infrastructure/kafka/producer/sarama_transport.go
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// SaramaTransport is the Transport for a real Kafka cluster
+type SaramaTransport struct {
+	producer sarama.SyncProducer
+}
+
+// NewSaramaTransport connects to the brokers in the configuration
+func NewSaramaTransport(config Config) (*SaramaTransport, error) {
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(config.Brokers, saramaConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka producer: %w", err)
+	}
+	return &SaramaTransport{producer: producer}, nil
+}
+
+var _ Transport = (*SaramaTransport)(nil)
+
+// newSaramaConfig translates every field of Config into the sarama client configuration
+func newSaramaConfig(config Config) (*sarama.Config, error) {
+	c := sarama.NewConfig()
+	c.ClientID = config.ClientID
+
+	switch config.Acks {
+	case "0":
+		c.Producer.RequiredAcks = sarama.NoResponse
+	case "1":
+		c.Producer.RequiredAcks = sarama.WaitForLocal
+	default:
+		c.Producer.RequiredAcks = sarama.WaitForAll
+	}
+
+	c.Producer.Retry.Max = config.MaxRetries
+	c.Producer.Retry.BackoffFunc = func(retries, _ int) time.Duration {
+		return config.Backoff(retries)
+	}
+
+	// BatchSize is in bytes, as for the Java client's batch.size
+	c.Producer.Flush.Bytes = config.BatchSize
+	c.Producer.Flush.Frequency = config.Linger()
+
+	switch config.CompressionType {
+	case "gzip":
+		c.Producer.Compression = sarama.CompressionGZIP
+	case "snappy":
+		c.Producer.Compression = sarama.CompressionSnappy
+	case "lz4":
+		c.Producer.Compression = sarama.CompressionLZ4
+	case "zstd":
+		c.Producer.Compression = sarama.CompressionZSTD
+	default:
+		c.Producer.Compression = sarama.CompressionNone
+	}
+
+	if config.IdempotentEnabled {
+		c.Producer.Idempotent = true
+		// Required for idempotence: retried requests must not be reordered
+		c.Net.MaxOpenRequests = 1
+	}
+
+	// Messages are keyed by aggregate ID; hashing the key keeps each aggregate on one partition
+	c.Producer.Partitioner = sarama.NewHashPartitioner
+	c.Producer.Return.Successes = true
+	c.Producer.Return.Errors = true
+
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid Kafka client configuration: %w", err)
+	}
+	return c, nil
+}
+
+// SendMessages sends msgs and waits for their acknowledgements
+func (t *SaramaTransport) SendMessages(ctx context.Context, msgs []Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	batch := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		batch = append(batch, toSaramaMessage(msg))
+	}
+
+	err := t.producer.SendMessages(batch)
+	var producerErrs sarama.ProducerErrors
+	if errors.As(err, &producerErrs) && len(producerErrs) > 0 {
+		return producerErrs[0].Err
+	}
+	return err
+}
+
+// Close flushes buffered messages and closes the client
+func (t *SaramaTransport) Close() error {
+	return t.producer.Close()
+}
+
+func toSaramaMessage(msg Message) *sarama.ProducerMessage {
+	keys := make([]string, 0, len(msg.Headers))
+	for k := range msg.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	headers := make([]sarama.RecordHeader, 0, len(keys))
+	for _, k := range keys {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(msg.Headers[k])})
+	}
+
+	pm := &sarama.ProducerMessage{
+		Topic:     msg.Topic,
+		Value:     sarama.ByteEncoder(msg.Value),
+		Headers:   headers,
+		Timestamp: msg.Timestamp,
+	}
+	if msg.Key != nil {
+		pm.Key = sarama.ByteEncoder(msg.Key)
+	}
+	return pm
+}
@@ -0,0 +1,134 @@
+// file: internal/infrastructure/memory/idempotency_store.go
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// IdempotencyStore is an in-process port.IdempotencyStore for tests and local runs.
+// It follows the same lease rules as the PostgreSQL store, but it is not transactional:
+// Complete takes effect immediately even if the caller's transaction later rolls back.
+type IdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]*port.IdempotencyRecord
+	now     func() time.Time
+}
+
+// NewIdempotencyStore creates an empty IdempotencyStore
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{
+		records: make(map[string]*port.IdempotencyRecord),
+		now:     time.Now,
+	}
+}
+
+var _ port.IdempotencyStore = (*IdempotencyStore)(nil)
+
+// Claim takes a lease on a new or failed event, or on one whose lease expired
+func (s *IdempotencyStore) Claim(_ context.Context, eventID, topic, owner string, lease time.Duration) (*port.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now().UTC()
+	expires := now.Add(lease)
+	record, ok := s.records[eventID]
+	if !ok {
+		record = &port.IdempotencyRecord{EventID: eventID, Topic: topic, CreatedAt: now}
+		s.records[eventID] = record
+	} else {
+		switch record.Status {
+		case port.IdempotencyStatusCompleted:
+			return copyRecord(record), nil
+		case port.IdempotencyStatusInProgress:
+			if record.LeaseExpiresAt != nil && record.LeaseExpiresAt.After(now) {
+				return nil, fmt.Errorf("event %s held by %s: %w", eventID, record.Owner, port.ErrEventInProgress)
+			}
+		}
+	}
+
+	record.Status = port.IdempotencyStatusInProgress
+	record.Owner = owner
+	record.LeaseExpiresAt = &expires
+	record.Attempts++
+	record.UpdatedAt = now
+	return copyRecord(record), nil
+}
+
+// Complete stores the result and marks the event completed if owner holds the lease
+func (s *IdempotencyStore) Complete(_ context.Context, eventID, owner string, result []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.leased(eventID, owner)
+	if err != nil {
+		return fmt.Errorf("complete event %s: %w", eventID, err)
+	}
+	record.Status = port.IdempotencyStatusCompleted
+	record.Result = append([]byte(nil), result...)
+	record.LastError = ""
+	record.Owner = ""
+	record.LeaseExpiresAt = nil
+	record.UpdatedAt = s.now().UTC()
+	return nil
+}
+
+// Fail releases the lease and records the error if owner holds the lease
+func (s *IdempotencyStore) Fail(_ context.Context, eventID, owner, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.leased(eventID, owner)
+	if err != nil {
+		return fmt.Errorf("fail event %s: %w", eventID, err)
+	}
+	record.Status = port.IdempotencyStatusFailed
+	record.LastError = reason
+	record.Owner = ""
+	record.LeaseExpiresAt = nil
+	record.UpdatedAt = s.now().UTC()
+	return nil
+}
+
+// Purge deletes settled records last updated before the given time, and in-progress
+// records whose lease expired before it
+func (s *IdempotencyStore) Purge(_ context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int64
+	for id, record := range s.records {
+		expired := record.Status != port.IdempotencyStatusInProgress && record.UpdatedAt.Before(before)
+		abandoned := record.Status == port.IdempotencyStatusInProgress &&
+			record.LeaseExpiresAt != nil && record.LeaseExpiresAt.Before(before)
+		if expired || abandoned {
+			delete(s.records, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// leased returns the record if owner holds its lease. As in the PostgreSQL store an
+// expired lease still counts until another owner claims the event.
+func (s *IdempotencyStore) leased(eventID, owner string) (*port.IdempotencyRecord, error) {
+	record, ok := s.records[eventID]
+	if !ok || record.Status != port.IdempotencyStatusInProgress || record.Owner != owner {
+		return nil, port.ErrLeaseLost
+	}
+	return record, nil
+}
+
+func copyRecord(r *port.IdempotencyRecord) *port.IdempotencyRecord {
+	c := *r
+	c.Result = append([]byte(nil), r.Result...)
+	if r.LeaseExpiresAt != nil {
+		expires := *r.LeaseExpiresAt
+		c.LeaseExpiresAt = &expires
+	}
+	return &c
+}
@@ -0,0 +1,139 @@
+// file: internal/infrastructure/memory/idempotency_store_test.go
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// newTestStore returns a store whose clock is moved by the returned function
+func newTestStore() (*IdempotencyStore, func(time.Duration)) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	s := NewIdempotencyStore()
+	s.now = func() time.Time { return now }
+	return s, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestIdempotencyStoreLease(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	record, err := s.Claim(ctx, "event-1", "stock", "worker-1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Status != port.IdempotencyStatusInProgress || record.Owner != "worker-1" || record.Attempts != 1 {
+		t.Fatalf("claimed record: got %+v, want in progress for worker-1 on attempt 1", record)
+	}
+
+	// A live lease keeps other owners out
+	advance(30 * time.Second)
+	if _, err := s.Claim(ctx, "event-1", "stock", "worker-2", time.Minute); !errors.Is(err, port.ErrEventInProgress) {
+		t.Fatalf("claim of a leased event: got %v, want %v", err, port.ErrEventInProgress)
+	}
+
+	// An expired lease is taken over, and the previous owner can no longer settle it
+	advance(time.Minute)
+	record, err = s.Claim(ctx, "event-1", "stock", "worker-2", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Owner != "worker-2" || record.Attempts != 2 {
+		t.Fatalf("taken over record: got %+v, want worker-2 on attempt 2", record)
+	}
+	if err := s.Complete(ctx, "event-1", "worker-1", []byte("late")); !errors.Is(err, port.ErrLeaseLost) {
+		t.Fatalf("complete by the previous owner: got %v, want %v", err, port.ErrLeaseLost)
+	}
+	if err := s.Fail(ctx, "event-1", "worker-1", "late"); !errors.Is(err, port.ErrLeaseLost) {
+		t.Fatalf("fail by the previous owner: got %v, want %v", err, port.ErrLeaseLost)
+	}
+
+	// Once completed, a claim returns the stored result instead of a lease
+	if err := s.Complete(ctx, "event-1", "worker-2", []byte("done")); err != nil {
+		t.Fatal(err)
+	}
+	record, err = s.Claim(ctx, "event-1", "stock", "worker-3", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Status != port.IdempotencyStatusCompleted || string(record.Result) != "done" || record.Owner != "" {
+		t.Fatalf("completed record: got %+v, want completed with result %q", record, "done")
+	}
+	if err := s.Complete(ctx, "event-1", "worker-3", nil); !errors.Is(err, port.ErrLeaseLost) {
+		t.Fatalf("complete without a lease: got %v, want %v", err, port.ErrLeaseLost)
+	}
+}
+
+func TestIdempotencyStoreFailedEventIsClaimedAgain(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore()
+
+	if _, err := s.Claim(ctx, "event-1", "stock", "worker-1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Fail(ctx, "event-1", "worker-1", "broker down"); err != nil {
+		t.Fatal(err)
+	}
+	// The lease is released at once, without waiting for it to expire
+	record, err := s.Claim(ctx, "event-1", "stock", "worker-2", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Owner != "worker-2" || record.Attempts != 2 || record.LastError != "broker down" {
+		t.Fatalf("reclaimed record: got %+v, want worker-2 on attempt 2 with the last error", record)
+	}
+	if err := s.Complete(ctx, "unknown", "worker-2", nil); !errors.Is(err, port.ErrLeaseLost) {
+		t.Fatalf("complete of an unknown event: got %v, want %v", err, port.ErrLeaseLost)
+	}
+}
+
+func TestIdempotencyStorePurge(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	claim := func(eventID string, lease time.Duration) {
+		t.Helper()
+		if _, err := s.Claim(ctx, eventID, "stock", "worker-1", lease); err != nil {
+			t.Fatal(err)
+		}
+	}
+	claim("completed-old", time.Minute)
+	if err := s.Complete(ctx, "completed-old", "worker-1", nil); err != nil {
+		t.Fatal(err)
+	}
+	claim("failed-old", time.Minute)
+	if err := s.Fail(ctx, "failed-old", "worker-1", "broken"); err != nil {
+		t.Fatal(err)
+	}
+	claim("abandoned", time.Minute)
+	claim("leased", 3*time.Hour)
+
+	advance(2 * time.Hour)
+	cutoff := s.now()
+	claim("completed-new", time.Minute)
+	if err := s.Complete(ctx, "completed-new", "worker-1", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	purged, err := s.Purge(ctx, cutoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 3 {
+		t.Errorf("purged: got %d, want 3", purged)
+	}
+	for _, eventID := range []string{"completed-old", "failed-old", "abandoned"} {
+		if _, ok := s.records[eventID]; ok {
+			t.Errorf("record %s was kept", eventID)
+		}
+	}
+	for _, eventID := range []string{"leased", "completed-new"} {
+		if _, ok := s.records[eventID]; !ok {
+			t.Errorf("record %s was purged", eventID)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// file: internal/infrastructure/postgres/adjustment_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const adjustmentColumns = `id, stock_item_id, quantity, reason_code, notes, unit_cost, status, COALESCE(movement_id, ''),
+	requested_by, reviewed_by, review_notes, created_at, updated_at, reviewed_at, applied_at`
+
+// AdjustmentRepository implements repository.AdjustmentRepository on PostgreSQL
+type AdjustmentRepository struct {
+	db *DB
+}
+
+// NewAdjustmentRepository creates a new AdjustmentRepository
+func NewAdjustmentRepository(db *DB) *AdjustmentRepository {
+	return &AdjustmentRepository{db: db}
+}
+
+var _ repository.AdjustmentRepository = (*AdjustmentRepository)(nil)
+
+// Create persists a new adjustment
+func (r *AdjustmentRepository) Create(ctx context.Context, a *entity.Adjustment) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO adjustments (id, stock_item_id, quantity, reason_code, notes, unit_cost, status, movement_id,
+			requested_by, reviewed_by, review_notes, created_at, updated_at, reviewed_at, applied_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10, $11, $12, $13, $14, $15)`,
+		a.ID, a.StockItemID, a.Quantity, a.ReasonCode, a.Notes, a.UnitCost, string(a.Status), a.MovementID,
+		a.RequestedBy, a.ReviewedBy, a.ReviewNotes, a.CreatedAt, a.UpdatedAt, a.ReviewedAt, a.AppliedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert adjustment: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves an adjustment by its ID
+func (r *AdjustmentRepository) GetByID(ctx context.Context, id string) (*entity.Adjustment, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+adjustmentColumns+` FROM adjustments WHERE id = $1`, id)
+	a, err := scanAdjustment(row)
+	if err != nil {
+		return nil, fmt.Errorf("select adjustment: %w", mapError(err))
+	}
+	return a, nil
+}
+
+// List retrieves adjustments with optional filtering, newest first
+func (r *AdjustmentRepository) List(ctx context.Context, filter repository.AdjustmentFilter) ([]*entity.Adjustment, int, error) {
+	var b whereBuilder
+	if filter.Status != nil {
+		b.add("status = ?", string(*filter.Status))
+	}
+	if filter.StockItemID != nil {
+		b.add("stock_item_id = ?", *filter.StockItemID)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM adjustments`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count adjustments: %w", mapError(err))
+	}
+
+	query := `SELECT ` + adjustmentColumns + ` FROM adjustments` + b.where() + ` ORDER BY created_at DESC, id` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select adjustments: %w", mapError(err))
+	}
+	adjustments, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Adjustment, error) {
+		return scanAdjustment(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan adjustments: %w", mapError(err))
+	}
+	return adjustments, total, nil
+}
+
+// Update persists the review and application of an existing adjustment
+func (r *AdjustmentRepository) Update(ctx context.Context, a *entity.Adjustment) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE adjustments
+		SET status = $2, movement_id = NULLIF($3, ''), reviewed_by = $4, review_notes = $5,
+			updated_at = $6, reviewed_at = $7, applied_at = $8
+		WHERE id = $1`,
+		a.ID, string(a.Status), a.MovementID, a.ReviewedBy, a.ReviewNotes, a.UpdatedAt, a.ReviewedAt, a.AppliedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update adjustment: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update adjustment %s: %w", a.ID, repository.ErrNotFound)
+	}
+	return nil
+}
+
+func scanAdjustment(row pgx.Row) (*entity.Adjustment, error) {
+	var (
+		a      entity.Adjustment
+		status string
+	)
+	err := row.Scan(&a.ID, &a.StockItemID, &a.Quantity, &a.ReasonCode, &a.Notes, &a.UnitCost, &status, &a.MovementID,
+		&a.RequestedBy, &a.ReviewedBy, &a.ReviewNotes, &a.CreatedAt, &a.UpdatedAt, &a.ReviewedAt, &a.AppliedAt)
+	if err != nil {
+		return nil, err
+	}
+	a.Status = entity.AdjustmentStatus(status)
+	a.CreatedAt = a.CreatedAt.UTC()
+	a.UpdatedAt = a.UpdatedAt.UTC()
+	return &a, nil
+}
@@ -0,0 +1,118 @@
+// file: internal/infrastructure/postgres/backorder_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const backorderColumns = `id, order_id, product_id, COALESCE(warehouse_id, ''), quantity, reserved_quantity, priority,
+	status, created_at, updated_at`
+
+// BackorderRepository implements repository.BackorderRepository on PostgreSQL
+type BackorderRepository struct {
+	db *DB
+}
+
+// NewBackorderRepository creates a new BackorderRepository
+func NewBackorderRepository(db *DB) *BackorderRepository {
+	return &BackorderRepository{db: db}
+}
+
+var _ repository.BackorderRepository = (*BackorderRepository)(nil)
+
+// Create persists a new backorder
+func (r *BackorderRepository) Create(ctx context.Context, b *entity.Backorder) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO backorders (id, order_id, product_id, warehouse_id, quantity, reserved_quantity, priority,
+			status, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8, $9, $10)`,
+		b.ID, b.OrderID, b.ProductID, b.WarehouseID, b.Quantity, b.ReservedQuantity, b.Priority,
+		string(b.Status), b.CreatedAt, b.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert backorder: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a backorder by its ID
+func (r *BackorderRepository) GetByID(ctx context.Context, id string) (*entity.Backorder, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+backorderColumns+` FROM backorders WHERE id = $1`, id)
+	b, err := scanBackorder(row)
+	if err != nil {
+		return nil, fmt.Errorf("select backorder: %w", mapError(err))
+	}
+	return b, nil
+}
+
+// GetByOrderID retrieves the backorders of a specific order, oldest first
+func (r *BackorderRepository) GetByOrderID(ctx context.Context, orderID string) ([]*entity.Backorder, error) {
+	return r.query(ctx,
+		`SELECT `+backorderColumns+` FROM backorders WHERE order_id = $1 ORDER BY created_at, id`, orderID)
+}
+
+// GetOpen retrieves the open backorders of a product that stock in a warehouse may
+// serve, oldest first. The rows are locked so concurrent replenishments of the product
+// fill each backorder once.
+func (r *BackorderRepository) GetOpen(ctx context.Context, productID, warehouseID string) ([]*entity.Backorder, error) {
+	return r.query(ctx, `
+		SELECT `+backorderColumns+` FROM backorders
+		WHERE product_id = $1 AND status = $2 AND (warehouse_id IS NULL OR warehouse_id = $3)
+		ORDER BY created_at, id
+		FOR UPDATE`,
+		productID, string(entity.BackorderStatusOpen), warehouseID,
+	)
+}
+
+func (r *BackorderRepository) query(ctx context.Context, sql string, args ...any) ([]*entity.Backorder, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select backorders: %w", mapError(err))
+	}
+	backorders, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Backorder, error) {
+		return scanBackorder(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan backorders: %w", mapError(err))
+	}
+	return backorders, nil
+}
+
+// Update persists changes to an existing backorder
+func (r *BackorderRepository) Update(ctx context.Context, b *entity.Backorder) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE backorders
+		SET quantity = $2, reserved_quantity = $3, priority = $4, status = $5, updated_at = $6
+		WHERE id = $1`,
+		b.ID, b.Quantity, b.ReservedQuantity, b.Priority, string(b.Status), b.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update backorder: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update backorder %s: %w", b.ID, repository.ErrNotFound)
+	}
+	return nil
+}
+
+func scanBackorder(row pgx.Row) (*entity.Backorder, error) {
+	var (
+		b      entity.Backorder
+		status string
+	)
+	err := row.Scan(&b.ID, &b.OrderID, &b.ProductID, &b.WarehouseID, &b.Quantity, &b.ReservedQuantity, &b.Priority,
+		&status, &b.CreatedAt, &b.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	b.Status = entity.BackorderStatus(status)
+	b.CreatedAt = b.CreatedAt.UTC()
+	b.UpdatedAt = b.UpdatedAt.UTC()
+	return &b, nil
+}
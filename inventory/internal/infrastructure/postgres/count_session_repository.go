@@ -0,0 +1,200 @@
+// file: internal/infrastructure/postgres/count_session_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const countSessionColumns = `id, warehouse_id, blind, freeze, status, notes, created_by, reviewed_by,
+	created_at, updated_at, submitted_at, posted_at`
+
+const countLineColumns = `session_id, stock_item_id, product_id, expected_quantity, counted_quantity, counted_by,
+	counted_at, approved, COALESCE(movement_id, '')`
+
+// CountSessionRepository implements repository.CountSessionRepository on PostgreSQL.
+// Count lines are stored as child rows in count_lines, ordered by line number.
+type CountSessionRepository struct {
+	db *DB
+}
+
+// NewCountSessionRepository creates a new CountSessionRepository
+func NewCountSessionRepository(db *DB) *CountSessionRepository {
+	return &CountSessionRepository{db: db}
+}
+
+var _ repository.CountSessionRepository = (*CountSessionRepository)(nil)
+
+// Create persists a new count session and its lines atomically
+func (r *CountSessionRepository) Create(ctx context.Context, c *entity.CountSession) error {
+	return r.db.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := r.db.conn(ctx).Exec(ctx, `
+			INSERT INTO count_sessions (`+countSessionColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+			c.ID, c.WarehouseID, c.Blind, c.Freeze, string(c.Status), c.Notes, c.CreatedBy, c.ReviewedBy,
+			c.CreatedAt, c.UpdatedAt, c.SubmittedAt, c.PostedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert count session: %w", mapError(err))
+		}
+		return r.insertLines(ctx, c)
+	})
+}
+
+// GetByID retrieves a count session and its lines by ID
+func (r *CountSessionRepository) GetByID(ctx context.Context, id string) (*entity.CountSession, error) {
+	return r.get(ctx, `SELECT `+countSessionColumns+` FROM count_sessions WHERE id = $1`, id)
+}
+
+// GetByIDForUpdate retrieves a count session and its lines by ID, locking the session
+// row until the surrounding transaction ends
+func (r *CountSessionRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.CountSession, error) {
+	return r.get(ctx, `SELECT `+countSessionColumns+` FROM count_sessions WHERE id = $1 FOR UPDATE`, id)
+}
+
+func (r *CountSessionRepository) get(ctx context.Context, sql, id string) (*entity.CountSession, error) {
+	c, err := scanCountSession(r.db.conn(ctx).QueryRow(ctx, sql, id))
+	if err != nil {
+		return nil, fmt.Errorf("select count session: %w", mapError(err))
+	}
+	if err := r.loadLines(ctx, []*entity.CountSession{c}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// List retrieves count sessions with optional filtering, newest first
+func (r *CountSessionRepository) List(ctx context.Context, filter repository.CountSessionFilter) ([]*entity.CountSession, int, error) {
+	var b whereBuilder
+	if filter.Status != nil {
+		b.add("status = ?", string(*filter.Status))
+	}
+	if filter.WarehouseID != nil {
+		b.add("warehouse_id = ?", *filter.WarehouseID)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM count_sessions`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count count sessions: %w", mapError(err))
+	}
+
+	query := `SELECT ` + countSessionColumns + ` FROM count_sessions` + b.where() + ` ORDER BY created_at DESC, id` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select count sessions: %w", mapError(err))
+	}
+	sessions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.CountSession, error) {
+		return scanCountSession(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan count sessions: %w", mapError(err))
+	}
+	if err := r.loadLines(ctx, sessions); err != nil {
+		return nil, 0, err
+	}
+	return sessions, total, nil
+}
+
+// Update persists changes to an existing count session and replaces its lines
+func (r *CountSessionRepository) Update(ctx context.Context, c *entity.CountSession) error {
+	return r.db.WithinTransaction(ctx, func(ctx context.Context) error {
+		tag, err := r.db.conn(ctx).Exec(ctx, `
+			UPDATE count_sessions
+			SET status = $2, notes = $3, reviewed_by = $4, updated_at = $5, submitted_at = $6, posted_at = $7
+			WHERE id = $1`,
+			c.ID, string(c.Status), c.Notes, c.ReviewedBy, c.UpdatedAt, c.SubmittedAt, c.PostedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("update count session: %w", mapError(err))
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("update count session %s: %w", c.ID, repository.ErrNotFound)
+		}
+
+		if _, err := r.db.conn(ctx).Exec(ctx, `DELETE FROM count_lines WHERE session_id = $1`, c.ID); err != nil {
+			return fmt.Errorf("delete count lines: %w", mapError(err))
+		}
+		return r.insertLines(ctx, c)
+	})
+}
+
+func (r *CountSessionRepository) insertLines(ctx context.Context, c *entity.CountSession) error {
+	rows := make([][]any, 0, len(c.Lines))
+	for i, line := range c.Lines {
+		var movementID *string
+		if line.MovementID != "" {
+			movementID = &line.MovementID
+		}
+		rows = append(rows, []any{c.ID, i + 1, line.StockItemID, line.ProductID, line.ExpectedQuantity,
+			line.CountedQuantity, line.CountedBy, line.CountedAt, line.Approved, movementID})
+	}
+	_, err := r.db.conn(ctx).CopyFrom(ctx,
+		pgx.Identifier{"count_lines"},
+		[]string{"session_id", "line_no", "stock_item_id", "product_id", "expected_quantity",
+			"counted_quantity", "counted_by", "counted_at", "approved", "movement_id"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("insert count lines: %w", mapError(err))
+	}
+	return nil
+}
+
+// loadLines fetches the lines of all given count sessions in a single query
+func (r *CountSessionRepository) loadLines(ctx context.Context, sessions []*entity.CountSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entity.CountSession, len(sessions))
+	ids := make([]string, 0, len(sessions))
+	for _, c := range sessions {
+		byID[c.ID] = c
+		ids = append(ids, c.ID)
+	}
+
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+countLineColumns+` FROM count_lines
+		WHERE session_id = ANY($1)
+		ORDER BY session_id, line_no`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("select count lines: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sessionID string
+		var line entity.CountLine
+		if err := rows.Scan(&sessionID, &line.StockItemID, &line.ProductID, &line.ExpectedQuantity,
+			&line.CountedQuantity, &line.CountedBy, &line.CountedAt, &line.Approved, &line.MovementID); err != nil {
+			return fmt.Errorf("scan count line: %w", mapError(err))
+		}
+		c := byID[sessionID]
+		c.Lines = append(c.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("scan count lines: %w", mapError(err))
+	}
+	return nil
+}
+
+func scanCountSession(row pgx.Row) (*entity.CountSession, error) {
+	var c entity.CountSession
+	var status string
+	err := row.Scan(&c.ID, &c.WarehouseID, &c.Blind, &c.Freeze, &status, &c.Notes, &c.CreatedBy, &c.ReviewedBy,
+		&c.CreatedAt, &c.UpdatedAt, &c.SubmittedAt, &c.PostedAt)
+	if err != nil {
+		return nil, err
+	}
+	c.Status = entity.CountSessionStatus(status)
+	c.CreatedAt = c.CreatedAt.UTC()
+	c.UpdatedAt = c.UpdatedAt.UTC()
+	return &c, nil
+}
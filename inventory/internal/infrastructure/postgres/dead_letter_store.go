@@ -0,0 +1,142 @@
+// file: internal/infrastructure/postgres/dead_letter_store.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+const deadLetterColumns = `id, source_topic, source_partition, source_offset, message_key, payload, headers,
+	message_timestamp, reason, attempts, status, dead_lettered_at, replay_count, replayed_at, COALESCE(replayed_by, '')`
+
+// DeadLetterStore implements port.DeadLetterStore on the dead_letters table
+type DeadLetterStore struct {
+	db *DB
+}
+
+// NewDeadLetterStore creates a new DeadLetterStore
+func NewDeadLetterStore(db *DB) *DeadLetterStore {
+	return &DeadLetterStore{db: db}
+}
+
+var _ port.DeadLetterStore = (*DeadLetterStore)(nil)
+
+// Save quarantines a message, or quarantines it again under its existing ID when the
+// same original message was dead-lettered before. dl.ID is set to the stored ID.
+func (s *DeadLetterStore) Save(ctx context.Context, dl *port.DeadLetter) error {
+	id := dl.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	var timestamp *time.Time
+	if dl.Timestamp > 0 {
+		t := time.UnixMilli(dl.Timestamp).UTC()
+		timestamp = &t
+	}
+	headers := dl.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	err := s.db.conn(ctx).QueryRow(ctx, `
+		INSERT INTO dead_letters (id, source_topic, source_partition, source_offset, message_key, payload, headers,
+			message_timestamp, reason, attempts, status, dead_lettered_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (source_topic, source_partition, source_offset) DO UPDATE SET
+			reason           = EXCLUDED.reason,
+			attempts         = dead_letters.attempts + EXCLUDED.attempts,
+			status           = EXCLUDED.status,
+			dead_lettered_at = EXCLUDED.dead_lettered_at
+		RETURNING id`,
+		id, dl.Topic, dl.Partition, dl.Offset, dl.Key, dl.Value, headers,
+		timestamp, dl.Reason, dl.Attempts, string(port.DeadLetterStatusQuarantined), dl.DeadLetteredAt.UTC(),
+	).Scan(&dl.ID)
+	if err != nil {
+		return fmt.Errorf("save dead letter: %w", mapError(err))
+	}
+	dl.Status = port.DeadLetterStatusQuarantined
+	return nil
+}
+
+// GetByID retrieves a dead letter by its ID
+func (s *DeadLetterStore) GetByID(ctx context.Context, id string) (*port.DeadLetter, error) {
+	row := s.db.conn(ctx).QueryRow(ctx, `SELECT `+deadLetterColumns+` FROM dead_letters WHERE id = $1`, id)
+	dl, err := scanDeadLetter(row)
+	if err != nil {
+		return nil, fmt.Errorf("select dead letter: %w", mapError(err))
+	}
+	return dl, nil
+}
+
+// List retrieves dead letters with optional filtering, most recently dead-lettered first
+func (s *DeadLetterStore) List(ctx context.Context, filter port.DeadLetterFilter) ([]*port.DeadLetter, int, error) {
+	var b whereBuilder
+	if filter.Topic != nil {
+		b.add("source_topic = ?", *filter.Topic)
+	}
+	if filter.Status != nil {
+		b.add("status = ?", string(*filter.Status))
+	}
+
+	var total int
+	if err := s.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM dead_letters`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count dead letters: %w", mapError(err))
+	}
+
+	query := `SELECT ` + deadLetterColumns + ` FROM dead_letters` + b.where() +
+		` ORDER BY dead_lettered_at DESC, id DESC` + b.page(filter.Limit, filter.Offset)
+	rows, err := s.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select dead letters: %w", mapError(err))
+	}
+	dls, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*port.DeadLetter, error) {
+		return scanDeadLetter(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan dead letters: %w", mapError(err))
+	}
+	return dls, total, nil
+}
+
+// MarkReplayed moves a quarantined dead letter to replayed. The row stays locked until
+// the caller's transaction ends, so a replay and a concurrent Save of the same message
+// are applied one after the other.
+func (s *DeadLetterStore) MarkReplayed(ctx context.Context, id, replayedBy string) (bool, error) {
+	tag, err := s.db.conn(ctx).Exec(ctx, `
+		UPDATE dead_letters
+		SET status = $2, replayed_at = NOW(), replayed_by = $3, replay_count = replay_count + 1
+		WHERE id = $1 AND status = $4`,
+		id, string(port.DeadLetterStatusReplayed), replayedBy, string(port.DeadLetterStatusQuarantined),
+	)
+	if err != nil {
+		return false, fmt.Errorf("mark dead letter replayed: %w", mapError(err))
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanDeadLetter(row pgx.Row) (*port.DeadLetter, error) {
+	var (
+		dl        port.DeadLetter
+		status    string
+		timestamp *time.Time
+	)
+	err := row.Scan(
+		&dl.ID, &dl.Topic, &dl.Partition, &dl.Offset, &dl.Key, &dl.Value, &dl.Headers,
+		&timestamp, &dl.Reason, &dl.Attempts, &status, &dl.DeadLetteredAt, &dl.ReplayCount, &dl.ReplayedAt, &dl.ReplayedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	dl.Status = port.DeadLetterStatus(status)
+	if timestamp != nil {
+		dl.Timestamp = timestamp.UnixMilli()
+	}
+	dl.DeadLetteredAt = dl.DeadLetteredAt.UTC()
+	return &dl, nil
+}
@@ -0,0 +1,141 @@
+// file: internal/infrastructure/postgres/idempotency_store.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// IdempotencyStore implements port.IdempotencyStore on the processed_events table.
+//
+// Claim and Fail should run outside the handler's transaction so other consumers see
+// them straight away. Complete should run inside it: the completed record commits
+// together with the handler's changes, and its row lock makes a concurrent Claim wait
+// for the outcome instead of taking the event over.
+type IdempotencyStore struct {
+	db *DB
+}
+
+// NewIdempotencyStore creates a new IdempotencyStore
+func NewIdempotencyStore(db *DB) *IdempotencyStore {
+	return &IdempotencyStore{db: db}
+}
+
+var _ port.IdempotencyStore = (*IdempotencyStore)(nil)
+
+const idempotencyColumns = `event_id, topic, status, lease_owner, lease_expires_at, attempts, result,
+	COALESCE(last_error, ''), created_at, updated_at`
+
+// Claim inserts an in-progress record, or takes over a failed one or one whose lease
+// expired. Otherwise the existing record is returned or reported as in progress.
+func (s *IdempotencyStore) Claim(ctx context.Context, eventID, topic, owner string, lease time.Duration) (*port.IdempotencyRecord, error) {
+	record, err := scanIdempotencyRecord(s.db.conn(ctx).QueryRow(ctx, `
+		INSERT INTO processed_events (event_id, topic, status, lease_owner, lease_expires_at, attempts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW() + make_interval(secs => $5), 1, NOW(), NOW())
+		ON CONFLICT (event_id) DO UPDATE SET
+			status           = EXCLUDED.status,
+			lease_owner      = EXCLUDED.lease_owner,
+			lease_expires_at = EXCLUDED.lease_expires_at,
+			attempts         = processed_events.attempts + 1,
+			updated_at       = NOW()
+		WHERE processed_events.status = $6
+		   OR (processed_events.status = $3 AND processed_events.lease_expires_at <= NOW())
+		RETURNING `+idempotencyColumns,
+		eventID, topic, string(port.IdempotencyStatusInProgress), owner, lease.Seconds(), string(port.IdempotencyStatusFailed),
+	))
+	if err == nil {
+		return record, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("claim event %s: %w", eventID, mapError(err))
+	}
+
+	// The record exists and could not be taken over
+	record, err = scanIdempotencyRecord(s.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+idempotencyColumns+` FROM processed_events WHERE event_id = $1`, eventID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Purged in between; the caller retries the claim
+			return nil, fmt.Errorf("claim event %s: %w", eventID, port.ErrEventInProgress)
+		}
+		return nil, fmt.Errorf("get processed event %s: %w", eventID, mapError(err))
+	}
+	if record.Status == port.IdempotencyStatusCompleted {
+		return record, nil
+	}
+	return nil, fmt.Errorf("event %s held by %s: %w", eventID, record.Owner, port.ErrEventInProgress)
+}
+
+// Complete marks the event completed with its result if owner still holds the lease
+func (s *IdempotencyStore) Complete(ctx context.Context, eventID, owner string, result []byte) error {
+	tag, err := s.db.conn(ctx).Exec(ctx, `
+		UPDATE processed_events
+		SET status = $3, result = $4, last_error = NULL, lease_owner = NULL, lease_expires_at = NULL,
+		    processed_at = NOW(), updated_at = NOW()
+		WHERE event_id = $1 AND lease_owner = $2 AND status = $5`,
+		eventID, owner, string(port.IdempotencyStatusCompleted), result, string(port.IdempotencyStatusInProgress),
+	)
+	if err != nil {
+		return fmt.Errorf("complete event %s: %w", eventID, mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("complete event %s: %w", eventID, port.ErrLeaseLost)
+	}
+	return nil
+}
+
+// Fail releases the lease and records the error if owner still holds the lease
+func (s *IdempotencyStore) Fail(ctx context.Context, eventID, owner, reason string) error {
+	tag, err := s.db.conn(ctx).Exec(ctx, `
+		UPDATE processed_events
+		SET status = $3, last_error = $4, lease_owner = NULL, lease_expires_at = NULL, updated_at = NOW()
+		WHERE event_id = $1 AND lease_owner = $2 AND status = $5`,
+		eventID, owner, string(port.IdempotencyStatusFailed), reason, string(port.IdempotencyStatusInProgress),
+	)
+	if err != nil {
+		return fmt.Errorf("fail event %s: %w", eventID, mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("fail event %s: %w", eventID, port.ErrLeaseLost)
+	}
+	return nil
+}
+
+// Purge deletes settled records last updated before the given time, and in-progress
+// records whose lease expired before it
+func (s *IdempotencyStore) Purge(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := s.db.conn(ctx).Exec(ctx, `
+		DELETE FROM processed_events
+		WHERE (status <> $2 AND updated_at < $1)
+		   OR (status = $2 AND lease_expires_at < $1)`,
+		before, string(port.IdempotencyStatusInProgress),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("purge processed events: %w", mapError(err))
+	}
+	return tag.RowsAffected(), nil
+}
+
+func scanIdempotencyRecord(row pgx.Row) (*port.IdempotencyRecord, error) {
+	var (
+		r      port.IdempotencyRecord
+		status string
+		owner  *string
+	)
+	if err := row.Scan(&r.EventID, &r.Topic, &status, &owner, &r.LeaseExpiresAt, &r.Attempts, &r.Result,
+		&r.LastError, &r.CreatedAt, &r.UpdatedAt); err != nil {
+		return nil, err
+	}
+	r.Status = port.IdempotencyStatus(status)
+	if owner != nil {
+		r.Owner = *owner
+	}
+	return &r, nil
+}
@@ -0,0 +1,137 @@
+// file: internal/infrastructure/postgres/kit_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+// kitWarehouseStock yields the stock of every live kit in each live warehouse stocking all
+// its components, counted in the kits the component stock there makes up
+const kitWarehouseStock = `
+	SELECT kc.kit_id, si.warehouse_id,
+	       MIN(si.quantity_on_hand / kc.quantity) AS on_hand,
+	       MIN(GREATEST(si.quantity_on_hand - si.quantity_reserved, 0) / kc.quantity) AS available
+	FROM kit_components kc
+	JOIN products k ON k.id = kc.kit_id
+	JOIN stock_items si ON si.product_id = kc.product_id
+	JOIN warehouses w ON w.id = si.warehouse_id
+	WHERE k.deleted_at IS NULL AND w.deleted_at IS NULL
+	GROUP BY kc.kit_id, si.warehouse_id
+	HAVING COUNT(*) = (SELECT COUNT(*) FROM kit_components c WHERE c.kit_id = kc.kit_id)`
+
+// KitRepository implements repository.KitRepository on PostgreSQL.
+// A kit is stored as one kit_components row per component.
+type KitRepository struct {
+	db *DB
+}
+
+// NewKitRepository creates a new KitRepository
+func NewKitRepository(db *DB) *KitRepository {
+	return &KitRepository{db: db}
+}
+
+var _ repository.KitRepository = (*KitRepository)(nil)
+
+// Create persists the components of a new kit
+func (r *KitRepository) Create(ctx context.Context, kit *entity.Kit) error {
+	rows := make([][]any, 0, len(kit.Components))
+	for _, c := range kit.Components {
+		rows = append(rows, []any{kit.ProductID, c.ProductID, c.Quantity})
+	}
+	_, err := r.db.conn(ctx).CopyFrom(ctx,
+		pgx.Identifier{"kit_components"},
+		[]string{"kit_id", "product_id", "quantity"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("insert kit components: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByProductID retrieves the composition of a kit product, its components ordered by product ID
+func (r *KitRepository) GetByProductID(ctx context.Context, productID string) (*entity.Kit, error) {
+	kits, err := r.query(ctx, `
+		SELECT kit_id, product_id, quantity
+		FROM kit_components
+		WHERE kit_id = $1
+		ORDER BY product_id`,
+		productID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(kits) == 0 {
+		return nil, fmt.Errorf("kit %s: %w", productID, repository.ErrNotFound)
+	}
+	return kits[0], nil
+}
+
+// ListByComponent retrieves the live kits a product is a component of
+func (r *KitRepository) ListByComponent(ctx context.Context, productID string) ([]*entity.Kit, error) {
+	return r.query(ctx, `
+		SELECT kc.kit_id, kc.product_id, kc.quantity
+		FROM kit_components kc
+		JOIN products k ON k.id = kc.kit_id
+		WHERE k.deleted_at IS NULL
+		  AND kc.kit_id IN (SELECT c.kit_id FROM kit_components c WHERE c.product_id = $1)
+		ORDER BY kc.kit_id, kc.product_id`,
+		productID,
+	)
+}
+
+// GetLowStock retrieves the stock of live kits in the live warehouses stocking all their
+// components, where the kits available are at or below the kit's minimum stock
+func (r *KitRepository) GetLowStock(ctx context.Context) ([]repository.KitStock, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, `
+		SELECT ks.kit_id, ks.warehouse_id, ks.on_hand, ks.available, p.min_stock
+		FROM (`+kitWarehouseStock+`) ks
+		JOIN products p ON p.id = ks.kit_id
+		WHERE ks.available <= p.min_stock
+		ORDER BY ks.available - p.min_stock, ks.kit_id, ks.warehouse_id`)
+	if err != nil {
+		return nil, fmt.Errorf("select low stock kits: %w", mapError(err))
+	}
+	stock, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.KitStock, error) {
+		var s repository.KitStock
+		err := row.Scan(&s.KitID, &s.WarehouseID, &s.QuantityOnHand, &s.Available, &s.MinStock)
+		return s, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan low stock kits: %w", mapError(err))
+	}
+	return stock, nil
+}
+
+// query collects kit component rows, ordered by kit, into kits
+func (r *KitRepository) query(ctx context.Context, sql string, args ...any) ([]*entity.Kit, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select kit components: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	var kits []*entity.Kit
+	for rows.Next() {
+		var kitID string
+		var c entity.KitComponent
+		if err := rows.Scan(&kitID, &c.ProductID, &c.Quantity); err != nil {
+			return nil, fmt.Errorf("scan kit component: %w", mapError(err))
+		}
+		if len(kits) == 0 || kits[len(kits)-1].ProductID != kitID {
+			kits = append(kits, &entity.Kit{ProductID: kitID})
+		}
+		kit := kits[len(kits)-1]
+		kit.Components = append(kit.Components, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scan kit components: %w", mapError(err))
+	}
+	return kits, nil
+}
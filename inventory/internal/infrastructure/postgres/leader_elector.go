@@ -0,0 +1,127 @@
+// file: internal/infrastructure/postgres/leader_elector.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// AdvisoryLockElector implements port.LeaderElector with a PostgreSQL session advisory
+// lock. The leader holds the lock on a connection taken out of the pool for as long as
+// it leads; the lock is freed when that connection ends, so a crashed leader is replaced
+// once the database notices the connection is gone.
+type AdvisoryLockElector struct {
+	db       *DB
+	name     string
+	key      int64
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewAdvisoryLockElector creates an elector for the named job. interval is how often a
+// follower retries to take the lock and how often the leader checks its connection.
+func NewAdvisoryLockElector(db *DB, name string, interval time.Duration, logger *slog.Logger) *AdvisoryLockElector {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return &AdvisoryLockElector{
+		db:       db,
+		name:     name,
+		key:      int64(h.Sum64()),
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+var _ port.LeaderElector = (*AdvisoryLockElector)(nil)
+
+// RunWhileLeader tries to take the lock every interval until ctx is done, running fn
+// while it holds it
+func (e *AdvisoryLockElector) RunWhileLeader(ctx context.Context, fn func(ctx context.Context)) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		if err := e.lead(ctx, fn); err != nil && ctx.Err() == nil {
+			e.logger.Error("leader election failed", slog.String("job", e.name), slog.String("error", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs fn if the lock can be taken, until fn returns, ctx is done or the
+// connection holding the lock fails
+func (e *AdvisoryLockElector) lead(ctx context.Context, fn func(ctx context.Context)) error {
+	conn, err := e.db.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	var acquired bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, e.key).Scan(&acquired); err != nil {
+		discard(conn)
+		return err
+	}
+	if !acquired {
+		conn.Release()
+		return nil
+	}
+	e.logger.Info("acquired leadership", slog.String("job", e.name))
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(leaderCtx)
+	}()
+
+	lost := e.hold(ctx, conn, done)
+	cancel()
+	<-done
+
+	if lost != nil {
+		discard(conn)
+		return errors.Join(errors.New("lost leadership"), lost)
+	}
+	if _, err := conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, e.key); err != nil {
+		// Closing the connection frees the lock as well
+		discard(conn)
+		return err
+	}
+	conn.Release()
+	e.logger.Info("released leadership", slog.String("job", e.name))
+	return nil
+}
+
+// hold checks the lock's connection every interval until fn is done or ctx is. It
+// returns the error that broke the connection, after which the lock is no longer held.
+func (e *AdvisoryLockElector) hold(ctx context.Context, conn *pgxpool.Conn, done <-chan struct{}) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := conn.Ping(ctx); err != nil && ctx.Err() == nil {
+				return err
+			}
+		}
+	}
+}
+
+// discard closes a pooled connection so it is not handed out again
+func discard(conn *pgxpool.Conn) {
+	_ = conn.Conn().Close(context.Background())
+	conn.Release()
+}
@@ -0,0 +1,236 @@
+// file: internal/infrastructure/postgres/location_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const locationColumns = `l.id, l.warehouse_id, COALESCE(l.parent_id, ''), l.location_type, l.code, l.path, l.capacity,
+	` + binQuantityStored + `, l.created_at, l.updated_at`
+
+// binQuantityStored sums the stock of all items held in a bin
+const binQuantityStored = `COALESCE((SELECT SUM(bs.quantity) FROM bin_stock bs WHERE bs.location_id = l.id), 0)`
+
+const binStockColumns = `bs.stock_item_id, bs.location_id, l.path, bs.quantity, bs.updated_at`
+
+const binMovementColumns = `id, location_id, stock_item_id, movement_id, movement_type, quantity, previous_quantity,
+	new_quantity, reference_id, reference_type, created_by, created_at`
+
+// LocationRepository implements repository.LocationRepository on PostgreSQL.
+// Bin movements are an append-only audit trail like stock movements.
+type LocationRepository struct {
+	db *DB
+}
+
+// NewLocationRepository creates a new LocationRepository
+func NewLocationRepository(db *DB) *LocationRepository {
+	return &LocationRepository{db: db}
+}
+
+var _ repository.LocationRepository = (*LocationRepository)(nil)
+
+// Create persists a new location
+func (r *LocationRepository) Create(ctx context.Context, l *entity.Location) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO warehouse_locations (id, warehouse_id, parent_id, location_type, code, path, capacity,
+			created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9)`,
+		l.ID, l.WarehouseID, l.ParentID, string(l.Type), l.Code, l.Path, l.Capacity, l.CreatedAt, l.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert location: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a location by its ID
+func (r *LocationRepository) GetByID(ctx context.Context, id string) (*entity.Location, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+locationColumns+` FROM warehouse_locations l WHERE l.id = $1`, id)
+	l, err := scanLocation(row)
+	if err != nil {
+		return nil, fmt.Errorf("select location: %w", mapError(err))
+	}
+	return l, nil
+}
+
+// GetForUpdate retrieves a location by its ID and locks it until the transaction ends.
+// The row is locked before it is read so the stored quantity includes stock put into
+// the bin by transactions that held the lock before.
+func (r *LocationRepository) GetForUpdate(ctx context.Context, id string) (*entity.Location, error) {
+	var locked string
+	err := r.db.conn(ctx).QueryRow(ctx, `SELECT id FROM warehouse_locations WHERE id = $1 FOR UPDATE`, id).Scan(&locked)
+	if err != nil {
+		return nil, fmt.Errorf("lock location: %w", mapError(err))
+	}
+	return r.GetByID(ctx, id)
+}
+
+// GetByPath retrieves the location of a warehouse with the given path
+func (r *LocationRepository) GetByPath(ctx context.Context, warehouseID, path string) (*entity.Location, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+locationColumns+` FROM warehouse_locations l WHERE l.warehouse_id = $1 AND l.path = $2`,
+		warehouseID, path,
+	)
+	l, err := scanLocation(row)
+	if err != nil {
+		return nil, fmt.Errorf("select location: %w", mapError(err))
+	}
+	return l, nil
+}
+
+// List retrieves locations with optional filtering, ordered by path
+func (r *LocationRepository) List(ctx context.Context, filter repository.LocationFilter) ([]*entity.Location, int, error) {
+	var b whereBuilder
+	if filter.WarehouseID != nil {
+		b.add("l.warehouse_id = ?", *filter.WarehouseID)
+	}
+	if filter.ParentID != nil {
+		b.add("l.parent_id = ?", *filter.ParentID)
+	}
+	if filter.Type != nil {
+		b.add("l.location_type = ?", string(*filter.Type))
+	}
+	if filter.PathPrefix != nil {
+		b.add("starts_with(l.path, ?)", *filter.PathPrefix)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM warehouse_locations l`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count locations: %w", mapError(err))
+	}
+
+	query := `SELECT ` + locationColumns + ` FROM warehouse_locations l` + b.where() +
+		` ORDER BY l.warehouse_id, l.path` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select locations: %w", mapError(err))
+	}
+	locations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Location, error) {
+		return scanLocation(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan locations: %w", mapError(err))
+	}
+	return locations, total, nil
+}
+
+// GetBinStock retrieves the bins holding stock of a stock item, in pick order (by path)
+func (r *LocationRepository) GetBinStock(ctx context.Context, stockItemID string) ([]*entity.BinStock, error) {
+	return r.queryBinStock(ctx, `
+		SELECT `+binStockColumns+`
+		FROM bin_stock bs
+		JOIN warehouse_locations l ON l.id = bs.location_id
+		WHERE bs.stock_item_id = $1 AND bs.quantity > 0
+		ORDER BY l.path`, stockItemID)
+}
+
+// GetBinContents retrieves the stock balances held in a bin
+func (r *LocationRepository) GetBinContents(ctx context.Context, locationID string) ([]*entity.BinStock, error) {
+	return r.queryBinStock(ctx, `
+		SELECT `+binStockColumns+`
+		FROM bin_stock bs
+		JOIN warehouse_locations l ON l.id = bs.location_id
+		WHERE bs.location_id = $1 AND bs.quantity > 0
+		ORDER BY bs.stock_item_id`, locationID)
+}
+
+// SaveBinStock creates or updates the balance of a stock item in a bin
+func (r *LocationRepository) SaveBinStock(ctx context.Context, s *entity.BinStock) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO bin_stock (stock_item_id, location_id, quantity, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (stock_item_id, location_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity, updated_at = EXCLUDED.updated_at`,
+		s.StockItemID, s.LocationID, s.Quantity, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save bin stock: %w", mapError(err))
+	}
+	return nil
+}
+
+// CreateMovement persists a bin movement record
+func (r *LocationRepository) CreateMovement(ctx context.Context, m *entity.BinMovement) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO bin_movements (`+binMovementColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		m.ID, m.LocationID, m.StockItemID, m.MovementID, string(m.MovementType), m.Quantity, m.PreviousQuantity,
+		m.NewQuantity, m.ReferenceID, m.ReferenceType, m.CreatedBy, m.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert bin movement: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetMovements retrieves the movements of a bin, newest first
+func (r *LocationRepository) GetMovements(ctx context.Context, locationID string, limit, offset int) ([]*entity.BinMovement, int, error) {
+	var b whereBuilder
+	b.add("location_id = ?", locationID)
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM bin_movements`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count bin movements: %w", mapError(err))
+	}
+
+	query := `SELECT ` + binMovementColumns + ` FROM bin_movements` + b.where() + ` ORDER BY created_at DESC, id` + b.page(limit, offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select bin movements: %w", mapError(err))
+	}
+	movements, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.BinMovement, error) {
+		var m entity.BinMovement
+		var movementType string
+		err := row.Scan(&m.ID, &m.LocationID, &m.StockItemID, &m.MovementID, &movementType, &m.Quantity,
+			&m.PreviousQuantity, &m.NewQuantity, &m.ReferenceID, &m.ReferenceType, &m.CreatedBy, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		m.MovementType = entity.MovementType(movementType)
+		m.CreatedAt = m.CreatedAt.UTC()
+		return &m, nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan bin movements: %w", mapError(err))
+	}
+	return movements, total, nil
+}
+
+func (r *LocationRepository) queryBinStock(ctx context.Context, sql string, args ...any) ([]*entity.BinStock, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select bin stock: %w", mapError(err))
+	}
+	stock, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.BinStock, error) {
+		var s entity.BinStock
+		if err := row.Scan(&s.StockItemID, &s.LocationID, &s.Path, &s.Quantity, &s.UpdatedAt); err != nil {
+			return nil, err
+		}
+		s.UpdatedAt = s.UpdatedAt.UTC()
+		return &s, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan bin stock: %w", mapError(err))
+	}
+	return stock, nil
+}
+
+func scanLocation(row pgx.Row) (*entity.Location, error) {
+	var l entity.Location
+	var locationType string
+	err := row.Scan(&l.ID, &l.WarehouseID, &l.ParentID, &locationType, &l.Code, &l.Path, &l.Capacity,
+		&l.QuantityStored, &l.CreatedAt, &l.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	l.Type = entity.LocationType(locationType)
+	l.CreatedAt = l.CreatedAt.UTC()
+	l.UpdatedAt = l.UpdatedAt.UTC()
+	return &l, nil
+}
@@ -0,0 +1,205 @@
+// file: internal/infrastructure/postgres/lot_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const lotColumns = `l.id, l.stock_item_id, l.lot_number, l.manufactured_at, l.expires_at, l.quantity_on_hand,
+	l.quantity_reserved, l.created_at, l.updated_at`
+
+const lotMovementColumns = `id, lot_id, stock_item_id, movement_id, movement_type, quantity, reference_id, reference_type,
+	previous_on_hand, new_on_hand, previous_reserved, new_reserved, created_by, created_at`
+
+// lotFEFOOrder sorts lots first expiring first; lots without an expiry date come last
+const lotFEFOOrder = ` ORDER BY l.expires_at NULLS LAST, l.manufactured_at NULLS LAST, l.created_at, l.id`
+
+// LotRepository implements repository.LotRepository on PostgreSQL.
+// Lot movements are an append-only audit trail like stock movements.
+type LotRepository struct {
+	db *DB
+}
+
+// NewLotRepository creates a new LotRepository
+func NewLotRepository(db *DB) *LotRepository {
+	return &LotRepository{db: db}
+}
+
+var _ repository.LotRepository = (*LotRepository)(nil)
+
+// Create persists a new lot
+func (r *LotRepository) Create(ctx context.Context, l *entity.Lot) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO stock_lots (id, stock_item_id, lot_number, manufactured_at, expires_at, quantity_on_hand,
+			quantity_reserved, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		l.ID, l.StockItemID, l.LotNumber, l.ManufacturedAt, l.ExpiresAt, l.QuantityOnHand,
+		l.QuantityReserved, l.CreatedAt, l.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert lot: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a lot by its ID
+func (r *LotRepository) GetByID(ctx context.Context, id string) (*entity.Lot, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+lotColumns+` FROM stock_lots l WHERE l.id = $1`, id)
+	l, err := scanLot(row)
+	if err != nil {
+		return nil, fmt.Errorf("select lot: %w", mapError(err))
+	}
+	return l, nil
+}
+
+// GetByLotNumber retrieves the lot of a stock item with the given lot number
+func (r *LotRepository) GetByLotNumber(ctx context.Context, stockItemID, lotNumber string) (*entity.Lot, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+lotColumns+` FROM stock_lots l WHERE l.stock_item_id = $1 AND l.lot_number = $2`,
+		stockItemID, lotNumber,
+	)
+	l, err := scanLot(row)
+	if err != nil {
+		return nil, fmt.Errorf("select lot: %w", mapError(err))
+	}
+	return l, nil
+}
+
+// GetByStockItem retrieves the lots of a stock item that hold stock, first expiring first
+func (r *LotRepository) GetByStockItem(ctx context.Context, stockItemID string) ([]*entity.Lot, error) {
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+lotColumns+` FROM stock_lots l WHERE l.stock_item_id = $1 AND l.quantity_on_hand > 0`+lotFEFOOrder,
+		stockItemID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select lots: %w", mapError(err))
+	}
+	lots, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Lot, error) {
+		return scanLot(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan lots: %w", mapError(err))
+	}
+	return lots, nil
+}
+
+// List retrieves lots with optional filtering, first expiring first
+func (r *LotRepository) List(ctx context.Context, filter repository.LotFilter) ([]*entity.Lot, int, error) {
+	var b whereBuilder
+	if filter.StockItemID != nil {
+		b.add("l.stock_item_id = ?", *filter.StockItemID)
+	}
+	if filter.ProductID != nil {
+		b.add("l.stock_item_id IN (SELECT id FROM stock_items WHERE product_id = ?)", *filter.ProductID)
+	}
+	if filter.LotNumber != nil {
+		b.add("l.lot_number = ?", *filter.LotNumber)
+	}
+	if filter.ExpiresBefore != nil {
+		b.add("l.expires_at < ?", *filter.ExpiresBefore)
+	}
+	if !filter.IncludeEmpty {
+		b.add("l.quantity_on_hand > 0")
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM stock_lots l`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count lots: %w", mapError(err))
+	}
+
+	query := `SELECT ` + lotColumns + ` FROM stock_lots l` + b.where() + lotFEFOOrder + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select lots: %w", mapError(err))
+	}
+	lots, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Lot, error) {
+		return scanLot(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan lots: %w", mapError(err))
+	}
+	return lots, total, nil
+}
+
+// Update persists the balances of an existing lot
+func (r *LotRepository) Update(ctx context.Context, l *entity.Lot) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE stock_lots
+		SET quantity_on_hand = $2, quantity_reserved = $3, updated_at = $4
+		WHERE id = $1`,
+		l.ID, l.QuantityOnHand, l.QuantityReserved, l.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update lot: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update lot %s: %w", l.ID, repository.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateMovement persists a lot movement record
+func (r *LotRepository) CreateMovement(ctx context.Context, m *entity.LotMovement) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO lot_movements (`+lotMovementColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		m.ID, m.LotID, m.StockItemID, m.MovementID, string(m.MovementType), m.Quantity, m.ReferenceID, m.ReferenceType,
+		m.PreviousOnHand, m.NewOnHand, m.PreviousReserved, m.NewReserved, m.CreatedBy, m.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert lot movement: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetMovements retrieves the movements of a lot, newest first
+func (r *LotRepository) GetMovements(ctx context.Context, lotID string, limit, offset int) ([]*entity.LotMovement, int, error) {
+	var b whereBuilder
+	b.add("lot_id = ?", lotID)
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM lot_movements`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count lot movements: %w", mapError(err))
+	}
+
+	query := `SELECT ` + lotMovementColumns + ` FROM lot_movements` + b.where() + ` ORDER BY created_at DESC, id` + b.page(limit, offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select lot movements: %w", mapError(err))
+	}
+	movements, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.LotMovement, error) {
+		var m entity.LotMovement
+		var movementType string
+		err := row.Scan(&m.ID, &m.LotID, &m.StockItemID, &m.MovementID, &movementType, &m.Quantity,
+			&m.ReferenceID, &m.ReferenceType, &m.PreviousOnHand, &m.NewOnHand, &m.PreviousReserved, &m.NewReserved,
+			&m.CreatedBy, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		m.MovementType = entity.MovementType(movementType)
+		m.CreatedAt = m.CreatedAt.UTC()
+		return &m, nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan lot movements: %w", mapError(err))
+	}
+	return movements, total, nil
+}
+
+func scanLot(row pgx.Row) (*entity.Lot, error) {
+	var l entity.Lot
+	err := row.Scan(&l.ID, &l.StockItemID, &l.LotNumber, &l.ManufacturedAt, &l.ExpiresAt, &l.QuantityOnHand,
+		&l.QuantityReserved, &l.CreatedAt, &l.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	l.CreatedAt = l.CreatedAt.UTC()
+	l.UpdatedAt = l.UpdatedAt.UTC()
+	return &l, nil
+}
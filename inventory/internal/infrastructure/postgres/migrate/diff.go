@@ -0,0 +1,166 @@
+// file: internal/infrastructure/postgres/migrate/diff.go
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// expectedSchema is the scratch schema the expected state is built in. It only ever
+// exists inside a transaction that is rolled back.
+const expectedSchema = "migrate_expected"
+
+// SchemaChange is one object whose definition differs between the expected and live schema.
+// Expected is empty for objects only present in the live schema; Live is empty for objects
+// missing from it.
+type SchemaChange struct {
+	Object   string // e.g. "column products.sku" or "index products_sku_key"
+	Expected string
+	Live     string
+}
+
+// SchemaDiff lists the differences between the schema this build expects and the live schema
+type SchemaDiff struct {
+	Changes []SchemaChange
+}
+
+// Empty reports whether the live schema matches the expected schema
+func (d SchemaDiff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// String renders the diff with "-" for objects missing from the live schema,
+// "+" for unexpected objects and "~" for objects with a different definition
+func (d SchemaDiff) String() string {
+	if d.Empty() {
+		return "live schema matches expected schema\n"
+	}
+	var b strings.Builder
+	for _, c := range d.Changes {
+		switch {
+		case c.Live == "":
+			fmt.Fprintf(&b, "- %s: %s\n", c.Object, c.Expected)
+		case c.Expected == "":
+			fmt.Fprintf(&b, "+ %s: %s\n", c.Object, c.Live)
+		default:
+			fmt.Fprintf(&b, "~ %s\n    expected: %s\n    live:     %s\n", c.Object, c.Expected, c.Live)
+		}
+	}
+	return b.String()
+}
+
+// Diff compares the live schema with the schema produced by applying every migration
+// of this build to an empty scratch schema. The scratch schema is created and
+// discarded in a single transaction, so Diff never modifies the database.
+func (m *Migrator) Diff(ctx context.Context) (SchemaDiff, error) {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return SchemaDiff{}, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var liveSchema string
+	if err := conn.QueryRow(ctx, `SELECT current_schema()`).Scan(&liveSchema); err != nil {
+		return SchemaDiff{}, fmt.Errorf("failed to resolve current schema: %w", err)
+	}
+	live, err := inspectSchema(ctx, conn.Conn(), liveSchema)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return SchemaDiff{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.WithoutCancel(ctx))
+	}()
+
+	if _, err := tx.Exec(ctx, `CREATE SCHEMA `+expectedSchema); err != nil {
+		return SchemaDiff{}, fmt.Errorf("failed to create scratch schema: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `SET LOCAL search_path TO `+expectedSchema); err != nil {
+		return SchemaDiff{}, fmt.Errorf("failed to switch to scratch schema: %w", err)
+	}
+	for _, mig := range m.migrations {
+		if _, err := tx.Exec(ctx, mig.Up); err != nil {
+			return SchemaDiff{}, fmt.Errorf("migration %s failed on scratch schema: %w", mig, err)
+		}
+	}
+	expected, err := inspectSchema(ctx, tx.Conn(), expectedSchema)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+
+	return compareSchemas(expected, live), nil
+}
+
+func compareSchemas(expected, live map[string]string) SchemaDiff {
+	var diff SchemaDiff
+	for object, def := range expected {
+		if liveDef, ok := live[object]; !ok || liveDef != def {
+			diff.Changes = append(diff.Changes, SchemaChange{Object: object, Expected: def, Live: liveDef})
+		}
+	}
+	for object, def := range live {
+		if _, ok := expected[object]; !ok {
+			diff.Changes = append(diff.Changes, SchemaChange{Object: object, Live: def})
+		}
+	}
+	sort.Slice(diff.Changes, func(i, j int) bool { return diff.Changes[i].Object < diff.Changes[j].Object })
+	return diff
+}
+
+// inspectSchema returns the definition of every column, constraint and index in schema,
+// keyed by object, with schema qualifiers removed so two schemas can be compared.
+// The migration history table is not part of the compared schema.
+func inspectSchema(ctx context.Context, conn *pgx.Conn, schema string) (map[string]string, error) {
+	objects := make(map[string]string)
+	unqualify := strings.NewReplacer(schema+".", "", `"`+schema+`".`, "")
+
+	queries := []struct {
+		kind string
+		sql  string
+	}{
+		{"column", `
+			SELECT t.relname || '.' || a.attname,
+			       format_type(a.atttypid, a.atttypmod)
+			       || CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END
+			       || COALESCE(' DEFAULT ' || pg_get_expr(d.adbin, d.adrelid), '')
+			FROM pg_attribute a
+			JOIN pg_class t ON t.oid = a.attrelid
+			JOIN pg_namespace n ON n.oid = t.relnamespace
+			LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+			WHERE n.nspname = $1 AND t.relkind = 'r' AND a.attnum > 0 AND NOT a.attisdropped
+			  AND t.relname <> '` + historyTable + `'`},
+		{"constraint", `
+			SELECT t.relname || '.' || c.conname, pg_get_constraintdef(c.oid)
+			FROM pg_constraint c
+			JOIN pg_class t ON t.oid = c.conrelid
+			JOIN pg_namespace n ON n.oid = t.relnamespace
+			WHERE n.nspname = $1 AND t.relname <> '` + historyTable + `'`},
+		{"index", `
+			SELECT indexname, indexdef FROM pg_indexes
+			WHERE schemaname = $1 AND tablename <> '` + historyTable + `'`},
+	}
+
+	for _, q := range queries {
+		rows, err := conn.Query(ctx, q.sql, schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect %ss of schema %s: %w", q.kind, schema, err)
+		}
+		var name, def string
+		_, err = pgx.ForEachRow(rows, []any{&name, &def}, func() error {
+			objects[q.kind+" "+name] = unqualify.Replace(def)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect %ss of schema %s: %w", q.kind, schema, err)
+		}
+	}
+	return objects, nil
+}
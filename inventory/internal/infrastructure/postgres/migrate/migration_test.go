@@ -0,0 +1,133 @@
+// file: internal/infrastructure/postgres/migrate/migration_test.go
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func sqlFile(body string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(body)}
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":      sqlFile("CREATE INDEX i ON t (c);"),
+		"0002_add_index.down.sql":    sqlFile("DROP INDEX i;"),
+		"0001_create_table.up.sql":   sqlFile("CREATE TABLE t (c INT);"),
+		"0001_create_table.down.sql": sqlFile("DROP TABLE t;"),
+		"README.md":                  sqlFile("not a migration"),
+		"archive/0003_old.up.sql":    sqlFile("SELECT 1;"),
+	}
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 {
+		t.Fatalf("migrations: got %v, want 2", migrations)
+	}
+
+	want := []struct {
+		name, up, down string
+	}{
+		{name: "0001_create_table", up: "CREATE TABLE t (c INT);", down: "DROP TABLE t;"},
+		{name: "0002_add_index", up: "CREATE INDEX i ON t (c);", down: "DROP INDEX i;"},
+	}
+	for i, w := range want {
+		m := migrations[i]
+		if m.String() != w.name || m.Up != w.up || m.Down != w.down {
+			t.Errorf("migration %d: got %s up %q down %q, want %s up %q down %q", i, m, m.Up, m.Down, w.name, w.up, w.down)
+		}
+		sum := sha256.Sum256([]byte(w.up))
+		if m.Checksum != hex.EncodeToString(sum[:]) {
+			t.Errorf("checksum of %s: got %s, want the SHA-256 of its up script", m, m.Checksum)
+		}
+	}
+}
+
+func TestLoadChecksumFollowsUpScript(t *testing.T) {
+	load := func(up, down string) Migration {
+		t.Helper()
+		migrations, err := Load(fstest.MapFS{
+			"0001_create_table.up.sql":   sqlFile(up),
+			"0001_create_table.down.sql": sqlFile(down),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return migrations[0]
+	}
+	original := load("CREATE TABLE t (c INT);", "DROP TABLE t;")
+	if got := load("CREATE TABLE t (c INT);", "DROP TABLE IF EXISTS t;"); got.Checksum != original.Checksum {
+		t.Error("editing the down script changed the checksum")
+	}
+	if got := load("CREATE TABLE t (c BIGINT);", "DROP TABLE t;"); got.Checksum == original.Checksum {
+		t.Error("editing the up script kept the checksum")
+	}
+}
+
+func TestLoadRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		wantErr string
+	}{
+		{
+			name:    "no down script",
+			fsys:    fstest.MapFS{"0001_create_table.up.sql": sqlFile("CREATE TABLE t (c INT);")},
+			wantErr: "has no down script",
+		},
+		{
+			name:    "no up script",
+			fsys:    fstest.MapFS{"0001_create_table.down.sql": sqlFile("DROP TABLE t;")},
+			wantErr: "has no up script",
+		},
+		{
+			name:    "invalid file name",
+			fsys:    fstest.MapFS{"create_table.up.sql": sqlFile("CREATE TABLE t (c INT);")},
+			wantErr: "invalid migration file name",
+		},
+		{
+			name: "version zero",
+			fsys: fstest.MapFS{
+				"0000_create_table.up.sql":   sqlFile("CREATE TABLE t (c INT);"),
+				"0000_create_table.down.sql": sqlFile("DROP TABLE t;"),
+			},
+			wantErr: "invalid migration version",
+		},
+		{
+			name: "conflicting names",
+			fsys: fstest.MapFS{
+				"0001_create_table.up.sql": sqlFile("CREATE TABLE t (c INT);"),
+				"0001_make_table.down.sql": sqlFile("DROP TABLE t;"),
+			},
+			wantErr: "conflicting names",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error: got %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEmbedded(t *testing.T) {
+	migrations, err := Embedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version <= migrations[i-1].Version {
+			t.Errorf("migration %s is ordered after %s", migrations[i], migrations[i-1])
+		}
+	}
+}
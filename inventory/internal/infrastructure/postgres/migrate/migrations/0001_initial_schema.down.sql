@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS processed_events;
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS reservation_items;
+DROP TABLE IF EXISTS reservations;
+DROP TABLE IF EXISTS stock_movements;
+DROP TABLE IF EXISTS stock_items;
+DROP TABLE IF EXISTS warehouses;
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,127 @@
+-- Core catalogue, stock and reservation tables.
+-- Identifiers are generated by the service (UUID strings) and stored as TEXT so that
+-- malformed identifiers in requests resolve to "not found" rather than cast errors.
+
+CREATE TABLE products (
+    id            TEXT PRIMARY KEY,
+    sku           TEXT        NOT NULL,
+    name          TEXT        NOT NULL,
+    description   TEXT        NOT NULL DEFAULT '',
+    variant_size  TEXT        NOT NULL DEFAULT '',
+    variant_color TEXT        NOT NULL DEFAULT '',
+    category      TEXT        NOT NULL DEFAULT '',
+    min_stock     INTEGER     NOT NULL DEFAULT 0 CHECK (min_stock >= 0),
+    is_active     BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at    TIMESTAMPTZ NOT NULL,
+    updated_at    TIMESTAMPTZ NOT NULL,
+    deleted_at    TIMESTAMPTZ
+);
+
+-- SKUs are unique among live products; a soft-deleted SKU may be reused
+CREATE UNIQUE INDEX products_sku_key ON products (sku) WHERE deleted_at IS NULL;
+CREATE INDEX products_category_idx ON products (category) WHERE deleted_at IS NULL;
+
+CREATE TABLE warehouses (
+    id          TEXT PRIMARY KEY,
+    code        TEXT        NOT NULL,
+    name        TEXT        NOT NULL,
+    street      TEXT        NOT NULL DEFAULT '',
+    city        TEXT        NOT NULL DEFAULT '',
+    state       TEXT        NOT NULL DEFAULT '',
+    country     TEXT        NOT NULL DEFAULT '',
+    postal_code TEXT        NOT NULL DEFAULT '',
+    is_active   BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    deleted_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX warehouses_code_key ON warehouses (code) WHERE deleted_at IS NULL;
+
+CREATE TABLE stock_items (
+    id                TEXT PRIMARY KEY,
+    product_id        TEXT        NOT NULL REFERENCES products (id),
+    warehouse_id      TEXT        NOT NULL REFERENCES warehouses (id),
+    quantity_on_hand  INTEGER     NOT NULL DEFAULT 0 CHECK (quantity_on_hand >= 0),
+    quantity_reserved INTEGER     NOT NULL DEFAULT 0 CHECK (quantity_reserved >= 0),
+    reorder_point     INTEGER     NOT NULL DEFAULT 0 CHECK (reorder_point >= 0),
+    reorder_quantity  INTEGER     NOT NULL DEFAULT 0 CHECK (reorder_quantity >= 0),
+    version           BIGINT      NOT NULL DEFAULT 1,
+    created_at        TIMESTAMPTZ NOT NULL,
+    updated_at        TIMESTAMPTZ NOT NULL,
+    CONSTRAINT stock_items_product_warehouse_key UNIQUE (product_id, warehouse_id),
+    CONSTRAINT stock_items_reserved_le_on_hand CHECK (quantity_reserved <= quantity_on_hand)
+);
+
+CREATE INDEX stock_items_warehouse_idx ON stock_items (warehouse_id);
+
+CREATE TABLE stock_movements (
+    id                TEXT PRIMARY KEY,
+    stock_item_id     TEXT        NOT NULL REFERENCES stock_items (id),
+    movement_type     TEXT        NOT NULL,
+    quantity          INTEGER     NOT NULL,
+    reference_id      TEXT        NOT NULL DEFAULT '',
+    reference_type    TEXT        NOT NULL DEFAULT '',
+    previous_on_hand  INTEGER     NOT NULL,
+    new_on_hand       INTEGER     NOT NULL,
+    previous_reserved INTEGER     NOT NULL,
+    new_reserved      INTEGER     NOT NULL,
+    reason            TEXT        NOT NULL DEFAULT '',
+    created_by        TEXT        NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX stock_movements_stock_item_idx ON stock_movements (stock_item_id, created_at DESC);
+CREATE INDEX stock_movements_reference_idx ON stock_movements (reference_id, reference_type);
+CREATE INDEX stock_movements_created_at_idx ON stock_movements (created_at DESC);
+
+CREATE TABLE reservations (
+    id           TEXT PRIMARY KEY,
+    order_id     TEXT        NOT NULL,
+    status       TEXT        NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL,
+    released_at  TIMESTAMPTZ,
+    fulfilled_at TIMESTAMPTZ
+);
+
+CREATE INDEX reservations_order_idx ON reservations (order_id);
+CREATE INDEX reservations_expiry_idx ON reservations (expires_at) WHERE status IN ('PENDING', 'CONFIRMED');
+
+CREATE TABLE reservation_items (
+    reservation_id TEXT    NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    line_no        INTEGER NOT NULL,
+    stock_item_id  TEXT    NOT NULL REFERENCES stock_items (id),
+    product_id     TEXT    NOT NULL,
+    warehouse_id   TEXT    NOT NULL,
+    quantity       INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (reservation_id, line_no)
+);
+
+-- Transactional outbox (port.OutboxEntry). sequence records insertion order so the
+-- events of one aggregate can be published in the order they were written.
+CREATE TABLE outbox (
+    id             TEXT PRIMARY KEY,
+    sequence       BIGSERIAL   NOT NULL UNIQUE,
+    aggregate_type TEXT        NOT NULL,
+    aggregate_id   TEXT        NOT NULL,
+    event_type     TEXT        NOT NULL,
+    payload        JSONB       NOT NULL,
+    correlation_id TEXT        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ NOT NULL,
+    published_at   TIMESTAMPTZ,
+    retry_count    INTEGER     NOT NULL DEFAULT 0,
+    last_error     TEXT
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (sequence) WHERE published_at IS NULL;
+
+-- Consumed event de-duplication (port.IdempotencyStore)
+CREATE TABLE processed_events (
+    event_id     TEXT PRIMARY KEY,
+    topic        TEXT        NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX processed_events_processed_at_idx ON processed_events (processed_at);
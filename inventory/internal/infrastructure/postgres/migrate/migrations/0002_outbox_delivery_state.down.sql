@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS outbox_published_at_idx;
+DROP INDEX IF EXISTS outbox_aggregate_pending_idx;
+DROP INDEX IF EXISTS outbox_pending_idx;
+
+ALTER TABLE outbox
+    DROP CONSTRAINT IF EXISTS outbox_status_check,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS status;
+
+CREATE INDEX outbox_pending_idx ON outbox (sequence) WHERE published_at IS NULL;
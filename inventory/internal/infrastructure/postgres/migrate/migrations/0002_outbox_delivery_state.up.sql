@@ -0,0 +1,16 @@
+-- Delivery state for the outbox processor. PENDING entries are retried with backoff
+-- until they are PUBLISHED, or PARKED once they exhaust their retries.
+
+ALTER TABLE outbox
+    ADD COLUMN status          TEXT        NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE outbox SET status = 'PUBLISHED' WHERE published_at IS NOT NULL;
+
+ALTER TABLE outbox
+    ADD CONSTRAINT outbox_status_check CHECK (status IN ('PENDING', 'PUBLISHED', 'PARKED'));
+
+DROP INDEX outbox_pending_idx;
+CREATE INDEX outbox_pending_idx ON outbox (sequence) WHERE status = 'PENDING';
+CREATE INDEX outbox_aggregate_pending_idx ON outbox (aggregate_type, aggregate_id, sequence) WHERE status = 'PENDING';
+CREATE INDEX outbox_published_at_idx ON outbox (published_at) WHERE status = 'PUBLISHED';
@@ -0,0 +1,19 @@
+DROP INDEX IF EXISTS processed_events_updated_at_idx;
+
+-- Events that never completed were not processed
+DELETE FROM processed_events WHERE status <> 'COMPLETED';
+
+ALTER TABLE processed_events
+    DROP CONSTRAINT IF EXISTS processed_events_status_check,
+    ALTER COLUMN processed_at SET DEFAULT NOW(),
+    ALTER COLUMN processed_at SET NOT NULL,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS result,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS lease_expires_at,
+    DROP COLUMN IF EXISTS lease_owner,
+    DROP COLUMN IF EXISTS status;
+
+CREATE INDEX processed_events_processed_at_idx ON processed_events (processed_at);
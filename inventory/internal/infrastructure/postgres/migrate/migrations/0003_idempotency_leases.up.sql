@@ -0,0 +1,23 @@
+-- Processing leases for consumed events. A consumer claims an event (IN_PROGRESS) before
+-- handling it and completes it in the handler's transaction together with its result.
+-- FAILED events and IN_PROGRESS events whose lease expired may be claimed again.
+
+ALTER TABLE processed_events
+    ADD COLUMN status           TEXT        NOT NULL DEFAULT 'COMPLETED',
+    ADD COLUMN lease_owner      TEXT,
+    ADD COLUMN lease_expires_at TIMESTAMPTZ,
+    ADD COLUMN attempts         INTEGER     NOT NULL DEFAULT 1,
+    ADD COLUMN result           BYTEA,
+    ADD COLUMN last_error       TEXT,
+    ADD COLUMN created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE processed_events SET created_at = processed_at, updated_at = processed_at;
+
+ALTER TABLE processed_events
+    ALTER COLUMN processed_at DROP NOT NULL,
+    ALTER COLUMN processed_at DROP DEFAULT,
+    ADD CONSTRAINT processed_events_status_check CHECK (status IN ('IN_PROGRESS', 'COMPLETED', 'FAILED'));
+
+DROP INDEX processed_events_processed_at_idx;
+CREATE INDEX processed_events_updated_at_idx ON processed_events (updated_at);
@@ -0,0 +1,25 @@
+-- Consumed messages that could not be processed, kept with their original position,
+-- key, payload and headers until an operator replays them.
+
+CREATE TABLE dead_letters (
+    id                TEXT PRIMARY KEY,
+    source_topic      TEXT        NOT NULL,
+    source_partition  INTEGER     NOT NULL,
+    source_offset     BIGINT      NOT NULL,
+    message_key       BYTEA,
+    payload           BYTEA,
+    headers           JSONB       NOT NULL DEFAULT '{}',
+    message_timestamp TIMESTAMPTZ,
+    reason            TEXT        NOT NULL,
+    attempts          INTEGER     NOT NULL,
+    status            TEXT        NOT NULL,
+    dead_lettered_at  TIMESTAMPTZ NOT NULL,
+    replay_count      INTEGER     NOT NULL DEFAULT 0,
+    replayed_at       TIMESTAMPTZ,
+    replayed_by       TEXT,
+    CONSTRAINT dead_letters_status_check CHECK (status IN ('QUARANTINED', 'REPLAYED')),
+    CONSTRAINT dead_letters_source_key UNIQUE (source_topic, source_partition, source_offset)
+);
+
+CREATE INDEX dead_letters_dead_lettered_at_idx ON dead_letters (dead_lettered_at DESC);
+CREATE INDEX dead_letters_status_idx ON dead_letters (status);
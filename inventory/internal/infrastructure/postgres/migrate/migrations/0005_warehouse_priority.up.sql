@@ -0,0 +1,6 @@
+-- Allocation priority of a warehouse. Reservations draw stock from warehouses with
+-- lower values first.
+
+ALTER TABLE warehouses
+    ADD COLUMN priority INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT warehouses_priority_check CHECK (priority >= 0);
@@ -0,0 +1,16 @@
+-- Quantities that could not be reserved for an order and are waiting for stock.
+
+CREATE TABLE backorders (
+    id           TEXT PRIMARY KEY,
+    order_id     TEXT        NOT NULL,
+    product_id   TEXT        NOT NULL REFERENCES products (id),
+    warehouse_id TEXT        REFERENCES warehouses (id),
+    quantity     INTEGER     NOT NULL CHECK (quantity > 0),
+    status       TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL,
+    CONSTRAINT backorders_status_check CHECK (status IN ('OPEN', 'CANCELLED'))
+);
+
+CREATE INDEX backorders_order_id_idx ON backorders (order_id);
+CREATE INDEX backorders_open_product_idx ON backorders (product_id, created_at) WHERE status = 'OPEN';
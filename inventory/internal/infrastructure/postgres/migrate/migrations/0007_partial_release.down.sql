@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS reservations_expiry_idx;
+CREATE INDEX reservations_expiry_idx ON reservations (expires_at) WHERE status IN ('PENDING', 'CONFIRMED');
+
+ALTER TABLE reservation_items
+    DROP CONSTRAINT IF EXISTS reservation_items_settled_check,
+    DROP COLUMN IF EXISTS fulfilled_quantity,
+    DROP COLUMN IF EXISTS released_quantity;
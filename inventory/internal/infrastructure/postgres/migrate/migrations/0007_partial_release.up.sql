@@ -0,0 +1,23 @@
+-- Per-line release and fulfillment. A line holds stock for quantity minus its
+-- released and fulfilled parts; partially released reservations stay subject to expiry.
+
+ALTER TABLE reservation_items
+    ADD COLUMN released_quantity INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN fulfilled_quantity INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT reservation_items_settled_check CHECK (
+        released_quantity >= 0 AND fulfilled_quantity >= 0
+        AND released_quantity + fulfilled_quantity <= quantity
+    );
+
+-- Lines of reservations settled before per-line tracking were settled in full
+UPDATE reservation_items i SET released_quantity = i.quantity
+FROM reservations r
+WHERE r.id = i.reservation_id AND r.status IN ('RELEASED', 'EXPIRED');
+
+UPDATE reservation_items i SET fulfilled_quantity = i.quantity
+FROM reservations r
+WHERE r.id = i.reservation_id AND r.status = 'FULFILLED';
+
+DROP INDEX IF EXISTS reservations_expiry_idx;
+CREATE INDEX reservations_expiry_idx ON reservations (expires_at)
+    WHERE status IN ('PENDING', 'CONFIRMED', 'PARTIALLY_RELEASED');
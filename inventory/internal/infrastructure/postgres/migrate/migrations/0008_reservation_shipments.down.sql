@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reservations_expiry_idx;
+CREATE INDEX reservations_expiry_idx ON reservations (expires_at)
+    WHERE status IN ('PENDING', 'CONFIRMED', 'PARTIALLY_RELEASED');
+
+DROP TABLE IF EXISTS reservation_shipments;
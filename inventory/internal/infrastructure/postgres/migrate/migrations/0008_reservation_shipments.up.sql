@@ -0,0 +1,16 @@
+-- Shipments that fulfilled part of a reservation (entity.Shipment), one row per
+-- reservation line a shipment took from. The primary key makes a shipment ID count
+-- once per reservation.
+
+CREATE TABLE reservation_shipments (
+    reservation_id TEXT        NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    shipment_id    TEXT        NOT NULL,
+    line_no        INTEGER     NOT NULL,
+    quantity       INTEGER     NOT NULL CHECK (quantity > 0),
+    shipped_at     TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (reservation_id, shipment_id, line_no)
+);
+
+DROP INDEX IF EXISTS reservations_expiry_idx;
+CREATE INDEX reservations_expiry_idx ON reservations (expires_at)
+    WHERE status IN ('PENDING', 'CONFIRMED', 'PARTIALLY_RELEASED', 'PARTIALLY_FULFILLED');
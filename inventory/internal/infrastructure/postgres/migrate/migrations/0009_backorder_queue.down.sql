@@ -0,0 +1,9 @@
+-- The old schema has no FILLED status; filled backorders no longer wait for stock
+UPDATE backorders SET status = 'CANCELLED' WHERE status = 'FILLED';
+
+ALTER TABLE backorders
+    DROP CONSTRAINT backorders_status_check,
+    ADD CONSTRAINT backorders_status_check CHECK (status IN ('OPEN', 'CANCELLED')),
+    DROP CONSTRAINT IF EXISTS backorders_reserved_quantity_check,
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS reserved_quantity;
@@ -0,0 +1,10 @@
+-- Backorder queue. Arriving stock is reserved for open backorders oldest first, or by
+-- descending priority when the queue is priority ordered; reserved_quantity records how
+-- much of a backorder has been converted into reservations.
+
+ALTER TABLE backorders
+    ADD COLUMN reserved_quantity INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN priority INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT backorders_reserved_quantity_check CHECK (reserved_quantity >= 0 AND reserved_quantity <= quantity),
+    DROP CONSTRAINT backorders_status_check,
+    ADD CONSTRAINT backorders_status_check CHECK (status IN ('OPEN', 'FILLED', 'CANCELLED'));
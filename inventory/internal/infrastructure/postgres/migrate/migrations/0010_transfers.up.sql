@@ -0,0 +1,34 @@
+-- Inter-warehouse transfers (entity.Transfer). Dispatch removes the items from their
+-- source stock items and receipt credits the destination stock items; in between the
+-- quantity is in transit and counted in no warehouse.
+
+CREATE TABLE transfers (
+    id                       TEXT PRIMARY KEY,
+    source_warehouse_id      TEXT        NOT NULL REFERENCES warehouses (id),
+    destination_warehouse_id TEXT        NOT NULL REFERENCES warehouses (id),
+    status                   TEXT        NOT NULL,
+    notes                    TEXT        NOT NULL DEFAULT '',
+    created_by               TEXT        NOT NULL DEFAULT '',
+    created_at               TIMESTAMPTZ NOT NULL,
+    updated_at               TIMESTAMPTZ NOT NULL,
+    dispatched_at            TIMESTAMPTZ,
+    received_at              TIMESTAMPTZ,
+    CONSTRAINT transfers_warehouses_check CHECK (source_warehouse_id <> destination_warehouse_id),
+    CONSTRAINT transfers_status_check
+        CHECK (status IN ('DRAFT', 'DISPATCHED', 'IN_TRANSIT', 'RECEIVED', 'DISCREPANCY'))
+);
+
+CREATE TABLE transfer_items (
+    transfer_id               TEXT    NOT NULL REFERENCES transfers (id) ON DELETE CASCADE,
+    line_no                   INTEGER NOT NULL,
+    product_id                TEXT    NOT NULL REFERENCES products (id),
+    source_stock_item_id      TEXT    NOT NULL REFERENCES stock_items (id),
+    destination_stock_item_id TEXT    NOT NULL REFERENCES stock_items (id),
+    quantity                  INTEGER NOT NULL CHECK (quantity > 0),
+    received_quantity         INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (transfer_id, line_no),
+    CONSTRAINT transfer_items_received_quantity_check CHECK (received_quantity >= 0 AND received_quantity <= quantity)
+);
+
+CREATE INDEX transfers_status_idx ON transfers (status, created_at);
+CREATE INDEX transfer_items_product_idx ON transfer_items (product_id);
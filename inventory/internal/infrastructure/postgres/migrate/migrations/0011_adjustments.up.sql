@@ -0,0 +1,25 @@
+-- Manual stock adjustments (entity.Adjustment). Adjustments above the approval
+-- thresholds wait in PENDING_APPROVAL until an inventory manager reviews them;
+-- movement_id links an applied adjustment to the ADJUSTMENT movement it recorded.
+
+CREATE TABLE adjustments (
+    id            TEXT PRIMARY KEY,
+    stock_item_id TEXT             NOT NULL REFERENCES stock_items (id),
+    quantity      INTEGER          NOT NULL CHECK (quantity <> 0),
+    reason_code   TEXT             NOT NULL,
+    notes         TEXT             NOT NULL DEFAULT '',
+    unit_cost     DOUBLE PRECISION CHECK (unit_cost >= 0),
+    status        TEXT             NOT NULL,
+    movement_id   TEXT             REFERENCES stock_movements (id),
+    requested_by  TEXT             NOT NULL DEFAULT '',
+    reviewed_by   TEXT             NOT NULL DEFAULT '',
+    review_notes  TEXT             NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ      NOT NULL,
+    updated_at    TIMESTAMPTZ      NOT NULL,
+    reviewed_at   TIMESTAMPTZ,
+    applied_at    TIMESTAMPTZ,
+    CONSTRAINT adjustments_status_check CHECK (status IN ('PENDING_APPROVAL', 'APPLIED', 'REJECTED'))
+);
+
+CREATE INDEX adjustments_stock_item_idx ON adjustments (stock_item_id, created_at);
+CREATE INDEX adjustments_pending_idx ON adjustments (created_at) WHERE status = 'PENDING_APPROVAL';
@@ -0,0 +1,3 @@
+ALTER TABLE stock_items DROP COLUMN IF EXISTS frozen_by;
+DROP TABLE IF EXISTS count_lines;
+DROP TABLE IF EXISTS count_sessions;
@@ -0,0 +1,41 @@
+-- Cycle counts (entity.CountSession). Counters record the quantity found for each
+-- stock item of a session; approved variances are posted as ADJUSTMENT movements that
+-- reference the session. While a freezing session is open, stock_items.frozen_by holds
+-- its ID and on-hand changes to the item are refused.
+
+CREATE TABLE count_sessions (
+    id           TEXT PRIMARY KEY,
+    warehouse_id TEXT        NOT NULL REFERENCES warehouses (id),
+    blind        BOOLEAN     NOT NULL DEFAULT FALSE,
+    freeze       BOOLEAN     NOT NULL DEFAULT FALSE,
+    status       TEXT        NOT NULL,
+    notes        TEXT        NOT NULL DEFAULT '',
+    created_by   TEXT        NOT NULL DEFAULT '',
+    reviewed_by  TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL,
+    submitted_at TIMESTAMPTZ,
+    posted_at    TIMESTAMPTZ,
+    CONSTRAINT count_sessions_status_check CHECK (status IN ('OPEN', 'SUBMITTED', 'POSTED', 'CANCELLED'))
+);
+
+CREATE TABLE count_lines (
+    session_id        TEXT        NOT NULL REFERENCES count_sessions (id) ON DELETE CASCADE,
+    line_no           INTEGER     NOT NULL,
+    stock_item_id     TEXT        NOT NULL REFERENCES stock_items (id),
+    product_id        TEXT        NOT NULL REFERENCES products (id),
+    expected_quantity INTEGER     NOT NULL,
+    counted_quantity  INTEGER     CHECK (counted_quantity >= 0),
+    counted_by        TEXT        NOT NULL DEFAULT '',
+    counted_at        TIMESTAMPTZ,
+    approved          BOOLEAN     NOT NULL DEFAULT FALSE,
+    movement_id       TEXT        REFERENCES stock_movements (id),
+    PRIMARY KEY (session_id, line_no),
+    CONSTRAINT count_lines_stock_item_unique UNIQUE (session_id, stock_item_id)
+);
+
+ALTER TABLE stock_items ADD COLUMN frozen_by TEXT REFERENCES count_sessions (id);
+
+CREATE INDEX count_sessions_warehouse_idx ON count_sessions (warehouse_id, created_at);
+CREATE INDEX count_sessions_status_idx ON count_sessions (status, created_at);
+CREATE INDEX count_lines_stock_item_idx ON count_lines (stock_item_id);
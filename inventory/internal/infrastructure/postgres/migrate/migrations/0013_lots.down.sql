@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lot_movements;
+DROP TABLE IF EXISTS stock_lots;
@@ -0,0 +1,41 @@
+-- Lot and batch sub-balances of stock items (entity.Lot). Stock of an item that is in
+-- no lot is untracked, so the lots of an item never hold more than the item itself.
+-- lot_movements records the share of each stock movement that changed a lot.
+
+CREATE TABLE stock_lots (
+    id                TEXT PRIMARY KEY,
+    stock_item_id     TEXT        NOT NULL REFERENCES stock_items (id),
+    lot_number        TEXT        NOT NULL,
+    manufactured_at   TIMESTAMPTZ,
+    expires_at        TIMESTAMPTZ,
+    quantity_on_hand  INTEGER     NOT NULL DEFAULT 0 CHECK (quantity_on_hand >= 0),
+    quantity_reserved INTEGER     NOT NULL DEFAULT 0 CHECK (quantity_reserved >= 0),
+    created_at        TIMESTAMPTZ NOT NULL,
+    updated_at        TIMESTAMPTZ NOT NULL,
+    CONSTRAINT stock_lots_number_unique UNIQUE (stock_item_id, lot_number),
+    CONSTRAINT stock_lots_reserved_le_on_hand CHECK (quantity_reserved <= quantity_on_hand),
+    CONSTRAINT stock_lots_dates_check CHECK (expires_at > manufactured_at)
+);
+
+CREATE TABLE lot_movements (
+    id                TEXT PRIMARY KEY,
+    lot_id            TEXT        NOT NULL REFERENCES stock_lots (id),
+    stock_item_id     TEXT        NOT NULL REFERENCES stock_items (id),
+    movement_id       TEXT        NOT NULL REFERENCES stock_movements (id),
+    movement_type     TEXT        NOT NULL,
+    quantity          INTEGER     NOT NULL,
+    reference_id      TEXT        NOT NULL DEFAULT '',
+    reference_type    TEXT        NOT NULL DEFAULT '',
+    previous_on_hand  INTEGER     NOT NULL,
+    new_on_hand       INTEGER     NOT NULL,
+    previous_reserved INTEGER     NOT NULL,
+    new_reserved      INTEGER     NOT NULL,
+    created_by        TEXT        NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX stock_lots_fefo_idx ON stock_lots (stock_item_id, expires_at NULLS LAST, created_at)
+    WHERE quantity_on_hand > 0;
+CREATE INDEX stock_lots_expires_idx ON stock_lots (expires_at) WHERE quantity_on_hand > 0;
+CREATE INDEX lot_movements_lot_idx ON lot_movements (lot_id, created_at);
+CREATE INDEX lot_movements_movement_idx ON lot_movements (movement_id);
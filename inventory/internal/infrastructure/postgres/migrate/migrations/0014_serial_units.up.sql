@@ -0,0 +1,38 @@
+-- Unit-level tracking of serialized products (entity.SerialUnit). Available, reserved
+-- and returned units are on hand at their stock item; serial_movements records every
+-- status change of a unit together with the stock movement that caused it.
+
+ALTER TABLE products ADD COLUMN serialized BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE serial_units (
+    id             TEXT PRIMARY KEY,
+    product_id     TEXT        NOT NULL REFERENCES products (id),
+    serial_number  TEXT        NOT NULL,
+    stock_item_id  TEXT        NOT NULL REFERENCES stock_items (id),
+    status         TEXT        NOT NULL,
+    reservation_id TEXT,
+    created_at     TIMESTAMPTZ NOT NULL,
+    updated_at     TIMESTAMPTZ NOT NULL,
+    CONSTRAINT serial_units_number_unique UNIQUE (product_id, serial_number),
+    CONSTRAINT serial_units_status_check
+        CHECK (status IN ('AVAILABLE', 'RESERVED', 'SHIPPED', 'RETURNED', 'SCRAPPED')),
+    CONSTRAINT serial_units_reservation_check CHECK ((status = 'RESERVED') = (reservation_id IS NOT NULL))
+);
+
+CREATE TABLE serial_movements (
+    id             TEXT PRIMARY KEY,
+    serial_unit_id TEXT        NOT NULL REFERENCES serial_units (id),
+    stock_item_id  TEXT        NOT NULL REFERENCES stock_items (id),
+    movement_id    TEXT        NOT NULL REFERENCES stock_movements (id),
+    movement_type  TEXT        NOT NULL,
+    from_status    TEXT        NOT NULL DEFAULT '',
+    to_status      TEXT        NOT NULL,
+    reference_id   TEXT        NOT NULL DEFAULT '',
+    reference_type TEXT        NOT NULL DEFAULT '',
+    created_by     TEXT        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX serial_units_stock_item_idx ON serial_units (stock_item_id, status, created_at);
+CREATE INDEX serial_units_reservation_idx ON serial_units (reservation_id) WHERE reservation_id IS NOT NULL;
+CREATE INDEX serial_movements_unit_idx ON serial_movements (serial_unit_id, created_at);
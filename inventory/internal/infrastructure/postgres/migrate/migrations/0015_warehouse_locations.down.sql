@@ -0,0 +1,4 @@
+ALTER TABLE stock_items DROP COLUMN IF EXISTS bin_location_id;
+DROP TABLE IF EXISTS bin_movements;
+DROP TABLE IF EXISTS bin_stock;
+DROP TABLE IF EXISTS warehouse_locations;
@@ -0,0 +1,52 @@
+-- Storage hierarchy of a warehouse (entity.Location): zones hold aisles, aisles hold
+-- racks and racks hold bins. A location's path joins its code to its ancestors' codes.
+-- bin_stock holds the part of a stock item's on-hand stock stored in each bin; stock in
+-- no bin has not been put away yet. bin_movements records the share of each stock
+-- movement that changed a bin balance.
+
+CREATE TABLE warehouse_locations (
+    id            TEXT PRIMARY KEY,
+    warehouse_id  TEXT        NOT NULL REFERENCES warehouses (id),
+    parent_id     TEXT        REFERENCES warehouse_locations (id),
+    location_type TEXT        NOT NULL,
+    code          TEXT        NOT NULL,
+    path          TEXT        NOT NULL,
+    capacity      INTEGER     NOT NULL DEFAULT 0 CHECK (capacity >= 0),
+    created_at    TIMESTAMPTZ NOT NULL,
+    updated_at    TIMESTAMPTZ NOT NULL,
+    CONSTRAINT warehouse_locations_path_unique UNIQUE (warehouse_id, path),
+    CONSTRAINT warehouse_locations_type_check CHECK (location_type IN ('ZONE', 'AISLE', 'RACK', 'BIN')),
+    CONSTRAINT warehouse_locations_parent_check CHECK ((location_type = 'ZONE') = (parent_id IS NULL)),
+    CONSTRAINT warehouse_locations_capacity_check CHECK (location_type = 'BIN' OR capacity = 0)
+);
+
+CREATE TABLE bin_stock (
+    stock_item_id TEXT        NOT NULL REFERENCES stock_items (id),
+    location_id   TEXT        NOT NULL REFERENCES warehouse_locations (id),
+    quantity      INTEGER     NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (stock_item_id, location_id)
+);
+
+CREATE TABLE bin_movements (
+    id                TEXT PRIMARY KEY,
+    location_id       TEXT        NOT NULL REFERENCES warehouse_locations (id),
+    stock_item_id     TEXT        NOT NULL REFERENCES stock_items (id),
+    movement_id       TEXT        NOT NULL REFERENCES stock_movements (id),
+    movement_type     TEXT        NOT NULL,
+    quantity          INTEGER     NOT NULL,
+    previous_quantity INTEGER     NOT NULL,
+    new_quantity      INTEGER     NOT NULL,
+    reference_id      TEXT        NOT NULL DEFAULT '',
+    reference_type    TEXT        NOT NULL DEFAULT '',
+    created_by        TEXT        NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ NOT NULL
+);
+
+-- Home bin replenishments of the item are put away to
+ALTER TABLE stock_items ADD COLUMN bin_location_id TEXT REFERENCES warehouse_locations (id);
+
+CREATE INDEX warehouse_locations_parent_idx ON warehouse_locations (parent_id);
+CREATE INDEX bin_stock_location_idx ON bin_stock (location_id) WHERE quantity > 0;
+CREATE INDEX bin_movements_location_idx ON bin_movements (location_id, created_at);
+CREATE INDEX bin_movements_movement_idx ON bin_movements (movement_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS products_variant_key;
+DROP INDEX IF EXISTS products_parent_idx;
+ALTER TABLE products DROP COLUMN IF EXISTS parent_id;
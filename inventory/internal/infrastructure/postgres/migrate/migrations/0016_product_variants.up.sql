@@ -0,0 +1,12 @@
+-- Product variants (entity.Product with a parent). A variant is a product of its own with
+-- its own SKU, stocked and reserved like any product; parent_id names its base product.
+-- SKUs stay unique across base products and variants through products_sku_key.
+
+ALTER TABLE products ADD COLUMN parent_id TEXT REFERENCES products (id);
+ALTER TABLE products ADD CONSTRAINT products_parent_check CHECK (parent_id <> id);
+
+CREATE INDEX products_parent_idx ON products (parent_id) WHERE parent_id IS NOT NULL;
+
+-- Siblings differ in size or color
+CREATE UNIQUE INDEX products_variant_key ON products (parent_id, variant_size, variant_color)
+    WHERE parent_id IS NOT NULL AND deleted_at IS NULL;
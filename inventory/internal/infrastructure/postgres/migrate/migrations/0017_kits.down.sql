@@ -0,0 +1,3 @@
+ALTER TABLE reservation_items DROP COLUMN IF EXISTS kit_id;
+DROP TABLE IF EXISTS kit_components;
+ALTER TABLE products DROP COLUMN IF EXISTS is_kit;
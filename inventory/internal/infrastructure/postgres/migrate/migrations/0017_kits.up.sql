@@ -0,0 +1,18 @@
+-- Kits (entity.Kit): products sold as a bundle of other products. A kit has no stock
+-- items of its own; kit_components lists the products that go into one kit and how
+-- many of each. A reserved kit is held by one reservation line per component, which
+-- kit_id ties back to the kit.
+
+ALTER TABLE products ADD COLUMN is_kit BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE kit_components (
+    kit_id     TEXT    NOT NULL REFERENCES products (id),
+    product_id TEXT    NOT NULL REFERENCES products (id),
+    quantity   INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (kit_id, product_id),
+    CONSTRAINT kit_components_self_check CHECK (kit_id <> product_id)
+);
+
+CREATE INDEX kit_components_product_idx ON kit_components (product_id);
+
+ALTER TABLE reservation_items ADD COLUMN kit_id TEXT REFERENCES products (id);
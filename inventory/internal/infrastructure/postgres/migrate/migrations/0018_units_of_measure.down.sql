@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS product_unit_conversions;
+ALTER TABLE products DROP COLUMN IF EXISTS base_unit;
+DROP TABLE IF EXISTS units_of_measure;
@@ -0,0 +1,26 @@
+-- Units of measure (entity.UnitOfMeasure): units quantities can be given in. Stock is
+-- kept in the base unit of each product; product_unit_conversions says how many base
+-- units a quantity of another unit makes up for the product, e.g. 1 CASE is 12 EA.
+
+CREATE TABLE units_of_measure (
+    code       TEXT        PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO units_of_measure (code, name) VALUES
+    ('EA', 'Each'),
+    ('CASE', 'Case'),
+    ('PALLET', 'Pallet');
+
+ALTER TABLE products ADD COLUMN base_unit TEXT NOT NULL DEFAULT 'EA' REFERENCES units_of_measure (code);
+
+CREATE TABLE product_unit_conversions (
+    product_id    TEXT        NOT NULL REFERENCES products (id),
+    unit_code     TEXT        NOT NULL REFERENCES units_of_measure (code),
+    quantity      INTEGER     NOT NULL CHECK (quantity > 0),
+    base_quantity INTEGER     NOT NULL CHECK (base_quantity > 0),
+    created_at    TIMESTAMPTZ NOT NULL,
+    updated_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (product_id, unit_code)
+);
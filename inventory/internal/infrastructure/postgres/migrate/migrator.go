@@ -0,0 +1,375 @@
+// file: internal/infrastructure/postgres/migrate/migrator.go
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// historyTable records every applied migration
+const historyTable = "schema_migrations"
+
+// advisoryLockID serializes migrators across service instances ("inventor" in ASCII)
+const advisoryLockID int64 = 0x696e76656e746f72
+
+// Schema state errors
+var (
+	ErrDirtySchema      = errors.New("schema is dirty")
+	ErrUnknownMigration = errors.New("schema has migrations unknown to this build")
+	ErrChecksumMismatch = errors.New("applied migration differs from this build")
+	ErrPendingMigration = errors.New("schema has pending migrations")
+	ErrUnmanagedSchema  = errors.New("schema has tables but no migration history")
+)
+
+// AppliedMigration is a row of the migration history table
+type AppliedMigration struct {
+	Version   int64
+	Name      string
+	Checksum  string
+	Dirty     bool
+	AppliedAt time.Time
+}
+
+// String returns the migration identifier, e.g. "0001_initial_schema"
+func (a AppliedMigration) String() string {
+	return fmt.Sprintf("%04d_%s", a.Version, a.Name)
+}
+
+// Status compares the live migration history with the migrations of this build
+type Status struct {
+	Applied    []AppliedMigration
+	Pending    []Migration
+	Unknown    []AppliedMigration // applied but not part of this build
+	Mismatched []AppliedMigration // applied with a different checksum than this build
+	Dirty      *AppliedMigration  // a migration that started but never completed
+	Unmanaged  bool               // tables exist but there is no history table
+}
+
+// Version returns the highest applied version, or 0 for an empty schema
+func (s Status) Version() int64 {
+	if len(s.Applied) == 0 {
+		return 0
+	}
+	return s.Applied[len(s.Applied)-1].Version
+}
+
+// Err reports why the live schema cannot be used by this build, if at all.
+// Pending migrations are reported only when nothing more serious is wrong.
+func (s Status) Err() error {
+	switch {
+	case s.Unmanaged:
+		return ErrUnmanagedSchema
+	case s.Dirty != nil:
+		return fmt.Errorf("%w: migration %s did not complete; repair it and run force", ErrDirtySchema, s.Dirty)
+	case len(s.Unknown) > 0:
+		return fmt.Errorf("%w: %s", ErrUnknownMigration, joinApplied(s.Unknown))
+	case len(s.Mismatched) > 0:
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, joinApplied(s.Mismatched))
+	case len(s.Pending) > 0:
+		return fmt.Errorf("%w: %d pending, first is %s", ErrPendingMigration, len(s.Pending), s.Pending[0])
+	}
+	return nil
+}
+
+func joinApplied(applied []AppliedMigration) string {
+	names := make([]string, len(applied))
+	for i, a := range applied {
+		names[i] = a.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// Migrator applies and rolls back migrations against the database's current schema.
+// Each migration runs in its own transaction; concurrent migrators are serialized
+// with an advisory lock.
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+	logger     *slog.Logger
+}
+
+// New creates a Migrator for the given migrations, which must be ordered by version
+func New(pool *pgxpool.Pool, migrations []Migration, logger *slog.Logger) *Migrator {
+	return &Migrator{pool: pool, migrations: migrations, logger: logger}
+}
+
+// Status reports the state of the live schema without modifying it
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return Status{}, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+	return m.status(ctx, conn.Conn())
+}
+
+// Verify returns an error unless the live schema exactly matches this build
+func (m *Migrator) Verify(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	return status.Err()
+}
+
+// Up applies all pending migrations and returns how many were applied.
+// It refuses to run on a dirty, unmanaged or diverged schema.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		status, err := m.status(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := status.Err(); err != nil && !errors.Is(err, ErrPendingMigration) {
+			return err
+		}
+		if err := ensureHistoryTable(ctx, conn); err != nil {
+			return err
+		}
+
+		for _, mig := range status.Pending {
+			if err := m.apply(ctx, conn, mig); err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the given number of most recently applied migrations
+// and returns how many were rolled back.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	rolledBack := 0
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		status, err := m.status(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := status.Err(); err != nil && !errors.Is(err, ErrPendingMigration) {
+			return err
+		}
+
+		byVersion := m.byVersion()
+		for i := len(status.Applied) - 1; i >= 0 && rolledBack < steps; i-- {
+			mig := byVersion[status.Applied[i].Version]
+			if err := m.revert(ctx, conn, mig); err != nil {
+				return err
+			}
+			rolledBack++
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Force records version as the cleanly applied head of the history, clearing a dirty
+// flag and forgetting any later versions. It does not run any SQL from the migrations
+// and is meant for use after the schema has been repaired by hand.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	var target *Migration
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			target = &m.migrations[i]
+		}
+	}
+	if target == nil && version != 0 {
+		return fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+	}
+
+	return m.withLock(ctx, func(conn *pgx.Conn) error {
+		if err := ensureHistoryTable(ctx, conn); err != nil {
+			return err
+		}
+		return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, `DELETE FROM `+historyTable+` WHERE version >= $1`, version); err != nil {
+				return err
+			}
+			if target == nil {
+				return nil
+			}
+			_, err := tx.Exec(ctx,
+				`INSERT INTO `+historyTable+` (version, name, checksum, dirty) VALUES ($1, $2, $3, FALSE)`,
+				target.Version, target.Name, target.Checksum,
+			)
+			return err
+		})
+	})
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *pgx.Conn, mig Migration) error {
+	m.logger.Info("applying migration", slog.String("migration", mig.String()))
+	start := time.Now()
+
+	// The attempt is recorded outside the migration transaction, so a process that dies
+	// mid-migration leaves the history marked dirty instead of silently clean.
+	_, err := conn.Exec(ctx,
+		`INSERT INTO `+historyTable+` (version, name, checksum, dirty) VALUES ($1, $2, $3, TRUE)`,
+		mig.Version, mig.Name, mig.Checksum,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", mig, err)
+	}
+
+	err = pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, mig.Up); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx,
+			`UPDATE `+historyTable+` SET dirty = FALSE, applied_at = NOW() WHERE version = $1`, mig.Version)
+		return err
+	})
+	if err != nil {
+		// The transaction rolled back, so the schema is unchanged and the marker can go
+		if _, cleanupErr := conn.Exec(context.WithoutCancel(ctx),
+			`DELETE FROM `+historyTable+` WHERE version = $1 AND dirty`, mig.Version); cleanupErr != nil {
+			m.logger.Error("failed to clear migration marker",
+				slog.String("migration", mig.String()),
+				slog.String("error", cleanupErr.Error()),
+			)
+		}
+		return fmt.Errorf("migration %s failed: %w", mig, err)
+	}
+
+	m.logger.Info("applied migration",
+		slog.String("migration", mig.String()),
+		slog.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *pgx.Conn, mig Migration) error {
+	m.logger.Info("rolling back migration", slog.String("migration", mig.String()))
+
+	err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, mig.Down); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `DELETE FROM `+historyTable+` WHERE version = $1`, mig.Version)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("rollback of migration %s failed: %w", mig, err)
+	}
+	return nil
+}
+
+func (m *Migrator) status(ctx context.Context, conn *pgx.Conn) (Status, error) {
+	var status Status
+
+	var hasHistory bool
+	if err := conn.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, historyTable).Scan(&hasHistory); err != nil {
+		return status, fmt.Errorf("failed to inspect migration history: %w", err)
+	}
+	if !hasHistory {
+		var tables int
+		err := conn.QueryRow(ctx, `
+			SELECT COUNT(*) FROM information_schema.tables
+			WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'`,
+		).Scan(&tables)
+		if err != nil {
+			return status, fmt.Errorf("failed to inspect schema: %w", err)
+		}
+		status.Unmanaged = tables > 0
+		status.Pending = m.migrations
+		return status, nil
+	}
+
+	rows, err := conn.Query(ctx,
+		`SELECT version, name, checksum, dirty, applied_at FROM `+historyTable+` ORDER BY version`)
+	if err != nil {
+		return status, fmt.Errorf("failed to read migration history: %w", err)
+	}
+	applied, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (AppliedMigration, error) {
+		var a AppliedMigration
+		err := row.Scan(&a.Version, &a.Name, &a.Checksum, &a.Dirty, &a.AppliedAt)
+		return a, err
+	})
+	if err != nil {
+		return status, fmt.Errorf("failed to read migration history: %w", err)
+	}
+	return m.classify(applied), nil
+}
+
+// classify compares the applied migrations, ordered by version, with the migrations
+// of this build
+func (m *Migrator) classify(applied []AppliedMigration) Status {
+	var status Status
+	byVersion := m.byVersion()
+	seen := make(map[int64]bool, len(applied))
+	for i := range applied {
+		a := applied[i]
+		seen[a.Version] = true
+		status.Applied = append(status.Applied, a)
+
+		if a.Dirty && status.Dirty == nil {
+			status.Dirty = &a
+		}
+		mig, ok := byVersion[a.Version]
+		switch {
+		case !ok:
+			status.Unknown = append(status.Unknown, a)
+		case mig.Checksum != a.Checksum:
+			status.Mismatched = append(status.Mismatched, a)
+		}
+	}
+
+	for _, mig := range m.migrations {
+		if !seen[mig.Version] {
+			status.Pending = append(status.Pending, mig)
+		}
+	}
+	return status
+}
+
+func (m *Migrator) byVersion() map[int64]Migration {
+	byVersion := make(map[int64]Migration, len(m.migrations))
+	for _, mig := range m.migrations {
+		byVersion[mig.Version] = mig
+	}
+	return byVersion
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory lock
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, advisoryLockID); err != nil {
+			m.logger.Error("failed to release migration lock", slog.String("error", err.Error()))
+		}
+	}()
+
+	return fn(conn.Conn())
+}
+
+func ensureHistoryTable(ctx context.Context, conn *pgx.Conn) error {
+	_, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS `+historyTable+` (
+			version    BIGINT PRIMARY KEY,
+			name       TEXT        NOT NULL,
+			checksum   TEXT        NOT NULL,
+			dirty      BOOLEAN     NOT NULL DEFAULT FALSE,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`)
+	if err != nil {
+		return fmt.Errorf("failed to create migration history table: %w", err)
+	}
+	return nil
+}
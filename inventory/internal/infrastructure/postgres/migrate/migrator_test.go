@@ -0,0 +1,94 @@
+// file: internal/infrastructure/postgres/migrate/migrator_test.go
+package migrate
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+)
+
+func TestMigratorClassify(t *testing.T) {
+	migrations, err := Load(fstest.MapFS{
+		"0001_create_table.up.sql":   sqlFile("CREATE TABLE t (c INT);"),
+		"0001_create_table.down.sql": sqlFile("DROP TABLE t;"),
+		"0002_add_index.up.sql":      sqlFile("CREATE INDEX i ON t (c);"),
+		"0002_add_index.down.sql":    sqlFile("DROP INDEX i;"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &Migrator{migrations: migrations}
+	applied := func(mig Migration) AppliedMigration {
+		return AppliedMigration{Version: mig.Version, Name: mig.Name, Checksum: mig.Checksum}
+	}
+	first, second := applied(migrations[0]), applied(migrations[1])
+	dirty := second
+	dirty.Dirty = true
+	edited := first
+	edited.Checksum = "edited"
+	unknown := AppliedMigration{Version: 3, Name: "drop_table", Checksum: "unknown"}
+
+	tests := []struct {
+		name           string
+		applied        []AppliedMigration
+		wantVersion    int64
+		wantPending    int
+		wantUnknown    int
+		wantMismatched int
+		wantDirty      bool
+		wantErr        error
+	}{
+		{name: "empty schema", wantPending: 2, wantErr: ErrPendingMigration},
+		{name: "pending migration", applied: []AppliedMigration{first}, wantVersion: 1, wantPending: 1, wantErr: ErrPendingMigration},
+		{name: "up to date", applied: []AppliedMigration{first, second}, wantVersion: 2},
+		{name: "dirty marker", applied: []AppliedMigration{first, dirty}, wantVersion: 2, wantDirty: true, wantErr: ErrDirtySchema},
+		{
+			name:        "unknown version",
+			applied:     []AppliedMigration{first, second, unknown},
+			wantVersion: 3, wantUnknown: 1,
+			wantErr: ErrUnknownMigration,
+		},
+		{
+			name:        "edited migration",
+			applied:     []AppliedMigration{edited},
+			wantVersion: 1, wantPending: 1, wantMismatched: 1,
+			wantErr: ErrChecksumMismatch,
+		},
+		{
+			name:        "dirty marker reported before an unknown version",
+			applied:     []AppliedMigration{first, dirty, unknown},
+			wantVersion: 3, wantUnknown: 1, wantDirty: true,
+			wantErr: ErrDirtySchema,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := m.classify(tt.applied)
+			if status.Version() != tt.wantVersion {
+				t.Errorf("version: got %d, want %d", status.Version(), tt.wantVersion)
+			}
+			if len(status.Pending) != tt.wantPending {
+				t.Errorf("pending: got %v, want %d", status.Pending, tt.wantPending)
+			}
+			if len(status.Unknown) != tt.wantUnknown {
+				t.Errorf("unknown: got %v, want %d", status.Unknown, tt.wantUnknown)
+			}
+			if len(status.Mismatched) != tt.wantMismatched {
+				t.Errorf("mismatched: got %v, want %d", status.Mismatched, tt.wantMismatched)
+			}
+			if (status.Dirty != nil) != tt.wantDirty {
+				t.Errorf("dirty: got %v, want %v", status.Dirty, tt.wantDirty)
+			}
+			if err := status.Err(); !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("error: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStatusUnmanagedSchema(t *testing.T) {
+	status := Status{Unmanaged: true, Pending: []Migration{{Version: 1, Name: "create_table"}}}
+	if err := status.Err(); !errors.Is(err, ErrUnmanagedSchema) {
+		t.Errorf("error: got %v, want %v", err, ErrUnmanagedSchema)
+	}
+}
@@ -0,0 +1,42 @@
+// file: internal/infrastructure/postgres/outbox_publisher.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// OutboxPublisher implements port.EventPublisher by writing events to the outbox table.
+// Entries are written on the transaction carried by the context, so they commit or roll
+// back together with the state change that produced them.
+type OutboxPublisher struct {
+	db *DB
+}
+
+// NewOutboxPublisher creates a new OutboxPublisher
+func NewOutboxPublisher(db *DB) *OutboxPublisher {
+	return &OutboxPublisher{db: db}
+}
+
+var _ port.EventPublisher = (*OutboxPublisher)(nil)
+
+// PublishToOutbox stores an event in the outbox table within the current transaction
+func (p *OutboxPublisher) PublishToOutbox(ctx context.Context, entry port.OutboxEntry) error {
+	createdAt := time.UnixMilli(entry.CreatedAt).UTC()
+	if entry.CreatedAt == 0 {
+		createdAt = time.Now().UTC()
+	}
+
+	_, err := p.db.conn(ctx).Exec(ctx, `
+		INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, correlation_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		entry.ID, entry.AggregateType, entry.AggregateID, entry.EventType, entry.Payload, entry.CorrelationID, createdAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert outbox entry: %w", mapError(err))
+	}
+	return nil
+}
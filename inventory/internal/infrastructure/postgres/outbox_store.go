@@ -0,0 +1,123 @@
+// file: internal/infrastructure/postgres/outbox_store.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// Outbox delivery states
+const (
+	outboxStatusPending   = "PENDING"
+	outboxStatusPublished = "PUBLISHED"
+	outboxStatusParked    = "PARKED"
+)
+
+// OutboxStore implements port.OutboxStore on the outbox table
+type OutboxStore struct {
+	db *DB
+}
+
+// NewOutboxStore creates a new OutboxStore
+func NewOutboxStore(db *DB) *OutboxStore {
+	return &OutboxStore{db: db}
+}
+
+var _ port.OutboxStore = (*OutboxStore)(nil)
+
+// ClaimPending locks due entries with FOR UPDATE SKIP LOCKED so several processors can
+// run side by side. Claimed entries preceded by an earlier pending entry of the same
+// aggregate that this claim did not get (locked elsewhere, not yet due, or beyond the
+// limit) are left out, which keeps delivery ordered per aggregate.
+func (s *OutboxStore) ClaimPending(ctx context.Context, limit int) ([]port.PendingOutboxEntry, error) {
+	rows, err := s.db.conn(ctx).Query(ctx, `
+		WITH claimed AS (
+			SELECT id FROM outbox
+			WHERE status = $1 AND next_attempt_at <= NOW()
+			ORDER BY sequence
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		SELECT o.id, o.aggregate_type, o.aggregate_id, o.event_type, o.payload, o.correlation_id,
+		       o.created_at, o.sequence, o.retry_count
+		FROM outbox o
+		JOIN claimed c ON c.id = o.id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM outbox earlier
+			WHERE earlier.aggregate_type = o.aggregate_type
+			  AND earlier.aggregate_id = o.aggregate_id
+			  AND earlier.status = $1
+			  AND earlier.sequence < o.sequence
+			  AND earlier.id NOT IN (SELECT id FROM claimed)
+		)
+		ORDER BY o.sequence`,
+		outboxStatusPending, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("claim outbox entries: %w", mapError(err))
+	}
+
+	entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (port.PendingOutboxEntry, error) {
+		var e port.PendingOutboxEntry
+		var createdAt time.Time
+		err := row.Scan(&e.ID, &e.AggregateType, &e.AggregateID, &e.EventType, &e.Payload, &e.CorrelationID,
+			&createdAt, &e.Sequence, &e.Attempts)
+		e.CreatedAt = createdAt.UnixMilli()
+		return e, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan outbox entries: %w", mapError(err))
+	}
+	return entries, nil
+}
+
+// MarkPublished records that the given entries were delivered
+func (s *OutboxStore) MarkPublished(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := s.db.conn(ctx).Exec(ctx, `
+		UPDATE outbox SET status = $2, published_at = NOW(), last_error = NULL
+		WHERE id = ANY($1)`,
+		ids, outboxStatusPublished,
+	)
+	if err != nil {
+		return fmt.Errorf("mark outbox entries published: %w", mapError(err))
+	}
+	return nil
+}
+
+// MarkFailed records a failed delivery attempt and schedules or parks the entry
+func (s *OutboxStore) MarkFailed(ctx context.Context, id string, reason string, nextAttemptAt time.Time, park bool) error {
+	status := outboxStatusPending
+	if park {
+		status = outboxStatusParked
+	}
+	_, err := s.db.conn(ctx).Exec(ctx, `
+		UPDATE outbox
+		SET status = $2, retry_count = retry_count + 1, last_error = $3, next_attempt_at = $4
+		WHERE id = $1`,
+		id, status, reason, nextAttemptAt,
+	)
+	if err != nil {
+		return fmt.Errorf("mark outbox entry failed: %w", mapError(err))
+	}
+	return nil
+}
+
+// PurgePublished deletes entries delivered before the given time
+func (s *OutboxStore) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := s.db.conn(ctx).Exec(ctx,
+		`DELETE FROM outbox WHERE status = $1 AND published_at < $2`,
+		outboxStatusPublished, before,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("purge outbox entries: %w", mapError(err))
+	}
+	return tag.RowsAffected(), nil
+}
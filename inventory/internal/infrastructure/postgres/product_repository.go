@@ -0,0 +1,185 @@
+// file: internal/infrastructure/postgres/product_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const productColumns = `id, COALESCE(parent_id, ''), sku, name, description, variant_size, variant_color, category,
+	base_unit, min_stock, serialized, is_kit, is_active, created_at, updated_at, deleted_at`
+
+// ProductRepository implements repository.ProductRepository on PostgreSQL
+type ProductRepository struct {
+	db *DB
+}
+
+// NewProductRepository creates a new ProductRepository
+func NewProductRepository(db *DB) *ProductRepository {
+	return &ProductRepository{db: db}
+}
+
+var _ repository.ProductRepository = (*ProductRepository)(nil)
+
+// Create persists a new product
+func (r *ProductRepository) Create(ctx context.Context, p *entity.Product) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO products (id, parent_id, sku, name, description, variant_size, variant_color, category,
+			base_unit, min_stock, serialized, is_kit, is_active, created_at, updated_at, deleted_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		p.ID, p.ParentID, p.SKU, p.Name, p.Description, p.Variant.Size, p.Variant.Color, p.Category,
+		p.BaseUnit, p.MinStock, p.Serialized, p.IsKit, p.IsActive, p.CreatedAt, p.UpdatedAt, p.DeletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert product: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a product by its ID, including soft deleted products
+func (r *ProductRepository) GetByID(ctx context.Context, id string) (*entity.Product, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+productColumns+` FROM products WHERE id = $1`, id)
+	p, err := scanProduct(row)
+	if err != nil {
+		return nil, fmt.Errorf("select product: %w", mapError(err))
+	}
+	return p, nil
+}
+
+// GetBySKU retrieves a live product by its SKU
+func (r *ProductRepository) GetBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+productColumns+` FROM products WHERE sku = $1 AND deleted_at IS NULL`, sku)
+	p, err := scanProduct(row)
+	if err != nil {
+		return nil, fmt.Errorf("select product by sku: %w", mapError(err))
+	}
+	return p, nil
+}
+
+// List retrieves live base products with optional filtering
+func (r *ProductRepository) List(ctx context.Context, filter repository.ProductFilter) ([]*entity.Product, int, error) {
+	var b whereBuilder
+	b.add("deleted_at IS NULL AND parent_id IS NULL")
+	if filter.SKU != nil {
+		b.add(`(sku = ? OR id IN (SELECT v.parent_id FROM products v WHERE v.sku = ? AND v.deleted_at IS NULL))`,
+			*filter.SKU, *filter.SKU)
+	}
+	if filter.Name != nil {
+		b.add(`name ILIKE ? ESCAPE '\'`, "%"+escapeLike(*filter.Name)+"%")
+	}
+	if filter.Category != nil {
+		b.add("category = ?", *filter.Category)
+	}
+	if filter.IsActive != nil {
+		b.add("is_active = ?", *filter.IsActive)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM products`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count products: %w", mapError(err))
+	}
+
+	query := `SELECT ` + productColumns + ` FROM products` + b.where() + ` ORDER BY created_at, id` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select products: %w", mapError(err))
+	}
+	products, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Product, error) {
+		return scanProduct(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan products: %w", mapError(err))
+	}
+	return products, total, nil
+}
+
+// ListVariants retrieves the live variants of a base product, ordered by SKU
+func (r *ProductRepository) ListVariants(ctx context.Context, productID string) ([]*entity.Product, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, `
+		SELECT `+productColumns+`
+		FROM products
+		WHERE parent_id = $1 AND deleted_at IS NULL
+		ORDER BY sku`,
+		productID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select product variants: %w", mapError(err))
+	}
+	variants, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Product, error) {
+		return scanProduct(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan product variants: %w", mapError(err))
+	}
+	return variants, nil
+}
+
+// Update persists changes to an existing product
+func (r *ProductRepository) Update(ctx context.Context, p *entity.Product) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE products
+		SET name = $2, description = $3, variant_size = $4, variant_color = $5, category = $6,
+		    min_stock = $7, is_active = $8, updated_at = $9, deleted_at = $10
+		WHERE id = $1`,
+		p.ID, p.Name, p.Description, p.Variant.Size, p.Variant.Color, p.Category,
+		p.MinStock, p.IsActive, p.UpdatedAt, p.DeletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update product: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update product %s: %w", p.ID, repository.ErrNotFound)
+	}
+	return nil
+}
+
+// Delete soft deletes a product, mirroring entity.Product.SoftDelete
+func (r *ProductRepository) Delete(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE products
+		SET deleted_at = $2, is_active = FALSE, updated_at = $2
+		WHERE id = $1 AND deleted_at IS NULL`,
+		id, now,
+	)
+	if err != nil {
+		return fmt.Errorf("delete product: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("delete product %s: %w", id, repository.ErrNotFound)
+	}
+	return nil
+}
+
+// ExistsBySKU checks if a live product with the given SKU exists
+func (r *ProductRepository) ExistsBySKU(ctx context.Context, sku string) (bool, error) {
+	var exists bool
+	err := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM products WHERE sku = $1 AND deleted_at IS NULL)`, sku,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check product sku: %w", mapError(err))
+	}
+	return exists, nil
+}
+
+func scanProduct(row pgx.Row) (*entity.Product, error) {
+	var p entity.Product
+	err := row.Scan(
+		&p.ID, &p.ParentID, &p.SKU, &p.Name, &p.Description, &p.Variant.Size, &p.Variant.Color, &p.Category,
+		&p.BaseUnit, &p.MinStock, &p.Serialized, &p.IsKit, &p.IsActive, &p.CreatedAt, &p.UpdatedAt, &p.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.CreatedAt = p.CreatedAt.UTC()
+	p.UpdatedAt = p.UpdatedAt.UTC()
+	return &p, nil
+}
@@ -0,0 +1,50 @@
+// file: internal/infrastructure/postgres/query.go
+package postgres
+
+import (
+	"strconv"
+	"strings"
+)
+
+// whereBuilder accumulates SQL conditions with positional arguments
+type whereBuilder struct {
+	conditions []string
+	args       []any
+}
+
+// add appends a condition; each "?" in cond is replaced by the next positional parameter
+func (b *whereBuilder) add(cond string, args ...any) {
+	for _, arg := range args {
+		b.args = append(b.args, arg)
+		cond = strings.Replace(cond, "?", "$"+strconv.Itoa(len(b.args)), 1)
+	}
+	b.conditions = append(b.conditions, cond)
+}
+
+// where renders the WHERE clause, or an empty string when there are no conditions
+func (b *whereBuilder) where() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// page renders LIMIT and OFFSET clauses, appending their arguments.
+// A non-positive limit returns all rows.
+func (b *whereBuilder) page(limit, offset int) string {
+	var sb strings.Builder
+	if limit > 0 {
+		b.args = append(b.args, limit)
+		sb.WriteString(" LIMIT $" + strconv.Itoa(len(b.args)))
+	}
+	if offset > 0 {
+		b.args = append(b.args, offset)
+		sb.WriteString(" OFFSET $" + strconv.Itoa(len(b.args)))
+	}
+	return sb.String()
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,373 @@
+// file: internal/infrastructure/postgres/repository_test.go
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/infrastructure/postgres/migrate"
+)
+
+// newTestDB connects to the database named by DATABASE_URL and migrates a schema of
+// its own, dropped when the test ends. Tests using it are skipped when DATABASE_URL
+// is not set.
+func newTestDB(t *testing.T) *DB {
+	t.Helper()
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		t.Skip("DATABASE_URL is not set; skipping PostgreSQL repository tests")
+	}
+	ctx := context.Background()
+
+	schema := "repo_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	admin, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if _, err := admin.Exec(ctx, `CREATE SCHEMA `+schema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(ctx, `DROP SCHEMA `+schema+` CASCADE`); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+		_ = admin.Close(ctx)
+	})
+
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("parse DSN: %v", err)
+	}
+	poolConfig.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		t.Fatalf("create pool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrations, err := migrate.Embedded()
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if _, err := migrate.New(pool, migrations, slog.New(slog.NewTextHandler(io.Discard, nil))).Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return NewDB(pool)
+}
+
+func newTestProduct(t *testing.T, ctx context.Context, products *ProductRepository) *entity.Product {
+	t.Helper()
+	p, err := entity.NewProduct(uuid.NewString(), "SKU-"+uuid.NewString()[:8], "Widget", "A widget", "tools",
+		entity.ProductVariant{}, 5)
+	if err != nil {
+		t.Fatalf("new product: %v", err)
+	}
+	if err := products.Create(ctx, p); err != nil {
+		t.Fatalf("create product: %v", err)
+	}
+	return p
+}
+
+func newTestWarehouse(t *testing.T, ctx context.Context, warehouses *WarehouseRepository) *entity.Warehouse {
+	t.Helper()
+	w, err := entity.NewWarehouse(uuid.NewString(), "WH-"+uuid.NewString()[:8], "Main", entity.WarehouseAddress{
+		Street: "1 Dock Road", City: "Springfield", Country: "US", PostalCode: "12345",
+	}, 1)
+	if err != nil {
+		t.Fatalf("new warehouse: %v", err)
+	}
+	if err := warehouses.Create(ctx, w); err != nil {
+		t.Fatalf("create warehouse: %v", err)
+	}
+	return w
+}
+
+func newTestStockItem(t *testing.T, ctx context.Context, stockItems *StockItemRepository, productID, warehouseID string, onHand int) *entity.StockItem {
+	t.Helper()
+	s, err := entity.NewStockItem(uuid.NewString(), productID, warehouseID, 10, 50)
+	if err != nil {
+		t.Fatalf("new stock item: %v", err)
+	}
+	s.QuantityOnHand = onHand
+	if err := stockItems.Create(ctx, s); err != nil {
+		t.Fatalf("create stock item: %v", err)
+	}
+	return s
+}
+
+func TestProductRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	products := NewProductRepository(db)
+
+	p := newTestProduct(t, ctx, products)
+	if err := products.Create(ctx, p); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Fatalf("create duplicate: got %v, want ErrAlreadyExists", err)
+	}
+
+	got, err := products.GetByID(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("get product: %v", err)
+	}
+	if got.SKU != p.SKU || got.Name != p.Name || got.Category != p.Category || got.MinStock != p.MinStock ||
+		got.BaseUnit != entity.DefaultBaseUnit || !got.IsActive || got.IsDeleted() {
+		t.Fatalf("get product: got %+v, want %+v", got, p)
+	}
+
+	if err := got.Update("Widget XL", "A bigger widget", "hardware", entity.ProductVariant{}, 8); err != nil {
+		t.Fatalf("update entity: %v", err)
+	}
+	if err := products.Update(ctx, got); err != nil {
+		t.Fatalf("update product: %v", err)
+	}
+	updated, err := products.GetByID(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("get updated product: %v", err)
+	}
+	if updated.Name != "Widget XL" || updated.Category != "hardware" || updated.MinStock != 8 {
+		t.Fatalf("update product: got %+v", updated)
+	}
+
+	if err := products.Delete(ctx, p.ID); err != nil {
+		t.Fatalf("delete product: %v", err)
+	}
+	deleted, err := products.GetByID(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("get deleted product: %v", err)
+	}
+	if !deleted.IsDeleted() || deleted.IsActive {
+		t.Fatalf("soft delete: got deleted_at %v, active %v", deleted.DeletedAt, deleted.IsActive)
+	}
+	if _, err := products.GetBySKU(ctx, p.SKU); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("get deleted product by SKU: got %v, want ErrNotFound", err)
+	}
+	if exists, err := products.ExistsBySKU(ctx, p.SKU); err != nil || exists {
+		t.Fatalf("SKU of deleted product: exists %v, err %v", exists, err)
+	}
+	if err := products.Delete(ctx, p.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("delete twice: got %v, want ErrNotFound", err)
+	}
+
+	// The SKU of a deleted product can be reused
+	reused, err := entity.NewProduct(uuid.NewString(), p.SKU, "Widget", "", "", entity.ProductVariant{}, 0)
+	if err != nil {
+		t.Fatalf("new product: %v", err)
+	}
+	if err := products.Create(ctx, reused); err != nil {
+		t.Fatalf("reuse SKU of deleted product: %v", err)
+	}
+}
+
+func TestWarehouseRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	warehouses := NewWarehouseRepository(db)
+
+	w := newTestWarehouse(t, ctx, warehouses)
+	got, err := warehouses.GetByID(ctx, w.ID)
+	if err != nil {
+		t.Fatalf("get warehouse: %v", err)
+	}
+	if got.Code != w.Code || got.Name != w.Name || got.Address != w.Address || got.Priority != w.Priority || !got.IsActive {
+		t.Fatalf("get warehouse: got %+v, want %+v", got, w)
+	}
+
+	address := entity.WarehouseAddress{Street: "2 Quay Street", City: "Shelbyville", Country: "US", PostalCode: "54321"}
+	if err := got.Update("Overflow", address, 3); err != nil {
+		t.Fatalf("update entity: %v", err)
+	}
+	if err := warehouses.Update(ctx, got); err != nil {
+		t.Fatalf("update warehouse: %v", err)
+	}
+	updated, err := warehouses.GetByID(ctx, w.ID)
+	if err != nil {
+		t.Fatalf("get updated warehouse: %v", err)
+	}
+	if updated.Name != "Overflow" || updated.Address != address || updated.Priority != 3 {
+		t.Fatalf("update warehouse: got %+v", updated)
+	}
+
+	if err := warehouses.Delete(ctx, w.ID); err != nil {
+		t.Fatalf("delete warehouse: %v", err)
+	}
+	deleted, err := warehouses.GetByID(ctx, w.ID)
+	if err != nil {
+		t.Fatalf("get deleted warehouse: %v", err)
+	}
+	if !deleted.IsDeleted() || deleted.IsActive {
+		t.Fatalf("soft delete: got deleted_at %v, active %v", deleted.DeletedAt, deleted.IsActive)
+	}
+	if exists, err := warehouses.ExistsByCode(ctx, w.Code); err != nil || exists {
+		t.Fatalf("code of deleted warehouse: exists %v, err %v", exists, err)
+	}
+	if err := warehouses.Delete(ctx, w.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("delete twice: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestStockItemRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	product := newTestProduct(t, ctx, NewProductRepository(db))
+	warehouse := newTestWarehouse(t, ctx, NewWarehouseRepository(db))
+	stockItems := NewStockItemRepository(db)
+
+	s := newTestStockItem(t, ctx, stockItems, product.ID, warehouse.ID, 100)
+	if _, err := stockItems.GetByID(ctx, uuid.NewString()); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("get missing stock item: got %v, want ErrNotFound", err)
+	}
+	got, err := stockItems.GetByProductAndWarehouse(ctx, product.ID, warehouse.ID)
+	if err != nil {
+		t.Fatalf("get stock item: %v", err)
+	}
+	if got.ID != s.ID || got.QuantityOnHand != 100 || got.QuantityReserved != 0 || got.ReorderPoint != 10 {
+		t.Fatalf("get stock item: got %+v, want %+v", got, s)
+	}
+
+	if err := got.Reserve(30); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	version := got.Version
+	if err := stockItems.Update(ctx, got); err != nil {
+		t.Fatalf("update stock item: %v", err)
+	}
+	if got.Version != version+1 {
+		t.Fatalf("update: version %d, want %d", got.Version, version+1)
+	}
+
+	// A compare-and-swap against a stale version is refused and changes nothing
+	stale := *got
+	stale.QuantityReserved = 99
+	err = stockItems.UpdateWithLock(ctx, &stale, version)
+	var conflict *repository.ConcurrentModificationError
+	if !errors.As(err, &conflict) || !errors.Is(err, repository.ErrConcurrentModification) {
+		t.Fatalf("stale update: got %v, want ConcurrentModificationError", err)
+	}
+	if err := got.Fulfill(10); err != nil {
+		t.Fatalf("fulfill: %v", err)
+	}
+	if err := stockItems.UpdateWithLock(ctx, got, got.Version); err != nil {
+		t.Fatalf("update with lock: %v", err)
+	}
+
+	stored, err := stockItems.GetByID(ctx, s.ID)
+	if err != nil {
+		t.Fatalf("get updated stock item: %v", err)
+	}
+	if stored.QuantityOnHand != 90 || stored.QuantityReserved != 20 || stored.Version != got.Version {
+		t.Fatalf("updated stock item: got on hand %d, reserved %d, version %d; want 90, 20, %d",
+			stored.QuantityOnHand, stored.QuantityReserved, stored.Version, got.Version)
+	}
+	missing := *stored
+	missing.ID = uuid.NewString()
+	if err := stockItems.UpdateWithLock(ctx, &missing, missing.Version); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("update missing stock item: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestReservationRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	products := NewProductRepository(db)
+	warehouse := newTestWarehouse(t, ctx, NewWarehouseRepository(db))
+	stockItems := NewStockItemRepository(db)
+	reservations := NewReservationRepository(db)
+
+	first, second := newTestProduct(t, ctx, products), newTestProduct(t, ctx, products)
+	firstItem := newTestStockItem(t, ctx, stockItems, first.ID, warehouse.ID, 50)
+	secondItem := newTestStockItem(t, ctx, stockItems, second.ID, warehouse.ID, 50)
+
+	orderID := "order-" + uuid.NewString()[:8]
+	items := []entity.ReservationItem{
+		{StockItemID: firstItem.ID, ProductID: first.ID, WarehouseID: warehouse.ID, Quantity: 4},
+		{StockItemID: secondItem.ID, ProductID: second.ID, WarehouseID: warehouse.ID, Quantity: 7},
+	}
+	res, err := entity.NewReservation(uuid.NewString(), orderID, items, time.Now().UTC().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("new reservation: %v", err)
+	}
+	if err := res.Confirm(); err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	if err := reservations.Create(ctx, res); err != nil {
+		t.Fatalf("create reservation: %v", err)
+	}
+
+	got, err := reservations.GetByID(ctx, res.ID)
+	if err != nil {
+		t.Fatalf("get reservation: %v", err)
+	}
+	if got.OrderID != orderID || got.Status != entity.ReservationStatusConfirmed {
+		t.Fatalf("get reservation: got %+v", got)
+	}
+	assertReservationItems(t, got.Items, items)
+
+	if err := got.ReleaseProduct(second.ID, 3); err != nil {
+		t.Fatalf("release product: %v", err)
+	}
+	if err := reservations.Update(ctx, got); err != nil {
+		t.Fatalf("update reservation: %v", err)
+	}
+	byOrder, err := reservations.GetByOrderID(ctx, orderID)
+	if err != nil {
+		t.Fatalf("get reservations by order: %v", err)
+	}
+	if len(byOrder) != 1 {
+		t.Fatalf("reservations by order: got %d, want 1", len(byOrder))
+	}
+	if byOrder[0].Status != got.Status {
+		t.Fatalf("updated reservation: status %s, want %s", byOrder[0].Status, got.Status)
+	}
+	assertReservationItems(t, byOrder[0].Items, got.Items)
+	if byOrder[0].Items[1].ReleasedQuantity != 3 {
+		t.Fatalf("released quantity: got %d, want 3", byOrder[0].Items[1].ReleasedQuantity)
+	}
+
+	// A reservation locked for update blocks other writers until the lock is released
+	err = db.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := reservations.GetByIDForUpdate(ctx, res.ID); err != nil {
+			return err
+		}
+		// Outside the transaction, so it needs a lock of its own
+		blocked, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		if _, err := reservations.GetByIDForUpdate(blocked, res.ID); err == nil {
+			t.Error("second lock on a locked reservation: got no error, want it to wait")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("lock reservation: %v", err)
+	}
+	if _, err := reservations.GetByIDForUpdate(ctx, res.ID); err != nil {
+		t.Fatalf("lock released reservation: %v", err)
+	}
+
+	if _, err := reservations.GetByID(ctx, uuid.NewString()); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("get missing reservation: got %v, want ErrNotFound", err)
+	}
+}
+
+func assertReservationItems(t *testing.T, got, want []entity.ReservationItem) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("reservation items: got %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("reservation item %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,309 @@
+// file: internal/infrastructure/postgres/reservation_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const reservationColumns = `id, order_id, status, expires_at, created_at, updated_at, released_at, fulfilled_at`
+
+const reservationItemColumns = `reservation_id, stock_item_id, product_id, warehouse_id, COALESCE(kit_id, ''), quantity,
+	released_quantity, fulfilled_quantity`
+
+// ReservationRepository implements repository.ReservationRepository on PostgreSQL.
+// Reservation items are stored as child rows in reservation_items, ordered by line number,
+// and shipments as one reservation_shipments row per line they took from.
+type ReservationRepository struct {
+	db *DB
+}
+
+// NewReservationRepository creates a new ReservationRepository
+func NewReservationRepository(db *DB) *ReservationRepository {
+	return &ReservationRepository{db: db}
+}
+
+var _ repository.ReservationRepository = (*ReservationRepository)(nil)
+
+// Create persists a new reservation and its items atomically
+func (r *ReservationRepository) Create(ctx context.Context, res *entity.Reservation) error {
+	return r.db.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := r.db.conn(ctx).Exec(ctx, `
+			INSERT INTO reservations (`+reservationColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			res.ID, res.OrderID, string(res.Status), res.ExpiresAt, res.CreatedAt, res.UpdatedAt, res.ReleasedAt, res.FulfilledAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert reservation: %w", mapError(err))
+		}
+		return r.insertItems(ctx, res)
+	})
+}
+
+// GetByID retrieves a reservation and its items by ID
+func (r *ReservationRepository) GetByID(ctx context.Context, id string) (*entity.Reservation, error) {
+	return r.get(ctx, `SELECT `+reservationColumns+` FROM reservations WHERE id = $1`, id)
+}
+
+// GetByIDForUpdate retrieves a reservation and its items by ID, locking the reservation
+// row until the surrounding transaction ends
+func (r *ReservationRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.Reservation, error) {
+	return r.get(ctx, `SELECT `+reservationColumns+` FROM reservations WHERE id = $1 FOR UPDATE`, id)
+}
+
+func (r *ReservationRepository) get(ctx context.Context, sql, id string) (*entity.Reservation, error) {
+	res, err := scanReservation(r.db.conn(ctx).QueryRow(ctx, sql, id))
+	if err != nil {
+		return nil, fmt.Errorf("select reservation: %w", mapError(err))
+	}
+	if err := r.loadItems(ctx, []*entity.Reservation{res}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetByOrderID retrieves reservations for a specific order, oldest first
+func (r *ReservationRepository) GetByOrderID(ctx context.Context, orderID string) ([]*entity.Reservation, error) {
+	return r.query(ctx,
+		`SELECT `+reservationColumns+` FROM reservations WHERE order_id = $1 ORDER BY created_at, id`, orderID)
+}
+
+// List retrieves reservations with optional filtering, newest first
+func (r *ReservationRepository) List(ctx context.Context, filter repository.ReservationFilter) ([]*entity.Reservation, int, error) {
+	var b whereBuilder
+	if filter.OrderID != nil {
+		b.add("order_id = ?", *filter.OrderID)
+	}
+	if filter.Status != nil {
+		b.add("status = ?", string(*filter.Status))
+	}
+	if filter.StartDate != nil {
+		b.add("created_at >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		b.add("created_at <= ?", *filter.EndDate)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM reservations`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count reservations: %w", mapError(err))
+	}
+
+	query := `SELECT ` + reservationColumns + ` FROM reservations` + b.where() + ` ORDER BY created_at DESC, id` + b.page(filter.Limit, filter.Offset)
+	reservations, err := r.query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reservations, total, nil
+}
+
+// Update persists changes to an existing reservation and replaces its items
+func (r *ReservationRepository) Update(ctx context.Context, res *entity.Reservation) error {
+	return r.db.WithinTransaction(ctx, func(ctx context.Context) error {
+		tag, err := r.db.conn(ctx).Exec(ctx, `
+			UPDATE reservations
+			SET status = $2, expires_at = $3, updated_at = $4, released_at = $5, fulfilled_at = $6
+			WHERE id = $1`,
+			res.ID, string(res.Status), res.ExpiresAt, res.UpdatedAt, res.ReleasedAt, res.FulfilledAt,
+		)
+		if err != nil {
+			return fmt.Errorf("update reservation: %w", mapError(err))
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("update reservation %s: %w", res.ID, repository.ErrNotFound)
+		}
+
+		if _, err := r.db.conn(ctx).Exec(ctx, `DELETE FROM reservation_items WHERE reservation_id = $1`, res.ID); err != nil {
+			return fmt.Errorf("delete reservation items: %w", mapError(err))
+		}
+		if err := r.insertItems(ctx, res); err != nil {
+			return err
+		}
+		return r.insertShipments(ctx, res)
+	})
+}
+
+// GetExpiredReservations retrieves reservations still holding stock whose expiry has passed
+func (r *ReservationRepository) GetExpiredReservations(ctx context.Context) ([]*entity.Reservation, error) {
+	return r.query(ctx, `
+		SELECT `+reservationColumns+` FROM reservations
+		WHERE status IN ($1, $2, $3, $4) AND expires_at < NOW()
+		ORDER BY expires_at, id`,
+		string(entity.ReservationStatusPending), string(entity.ReservationStatusConfirmed),
+		string(entity.ReservationStatusPartiallyReleased), string(entity.ReservationStatusPartiallyFulfilled),
+	)
+}
+
+func (r *ReservationRepository) query(ctx context.Context, sql string, args ...any) ([]*entity.Reservation, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select reservations: %w", mapError(err))
+	}
+	reservations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Reservation, error) {
+		return scanReservation(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan reservations: %w", mapError(err))
+	}
+	if err := r.loadItems(ctx, reservations); err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (r *ReservationRepository) insertItems(ctx context.Context, res *entity.Reservation) error {
+	rows := make([][]any, 0, len(res.Items))
+	for i, item := range res.Items {
+		var kitID any
+		if item.KitID != "" {
+			kitID = item.KitID
+		}
+		rows = append(rows, []any{res.ID, i + 1, item.StockItemID, item.ProductID, item.WarehouseID, kitID, item.Quantity,
+			item.ReleasedQuantity, item.FulfilledQuantity})
+	}
+	_, err := r.db.conn(ctx).CopyFrom(ctx,
+		pgx.Identifier{"reservation_items"},
+		[]string{"reservation_id", "line_no", "stock_item_id", "product_id", "warehouse_id", "kit_id", "quantity",
+			"released_quantity", "fulfilled_quantity"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("insert reservation items: %w", mapError(err))
+	}
+	return nil
+}
+
+// loadItems fetches the items and shipments of all given reservations
+func (r *ReservationRepository) loadItems(ctx context.Context, reservations []*entity.Reservation) error {
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entity.Reservation, len(reservations))
+	ids := make([]string, 0, len(reservations))
+	for _, res := range reservations {
+		byID[res.ID] = res
+		ids = append(ids, res.ID)
+	}
+
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+reservationItemColumns+` FROM reservation_items
+		WHERE reservation_id = ANY($1)
+		ORDER BY reservation_id, line_no`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("select reservation items: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reservationID string
+		var item entity.ReservationItem
+		if err := rows.Scan(&reservationID, &item.StockItemID, &item.ProductID, &item.WarehouseID, &item.KitID, &item.Quantity,
+			&item.ReleasedQuantity, &item.FulfilledQuantity); err != nil {
+			return fmt.Errorf("scan reservation item: %w", mapError(err))
+		}
+		res := byID[reservationID]
+		res.Items = append(res.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("scan reservation items: %w", mapError(err))
+	}
+	return r.loadShipments(ctx, byID, ids)
+}
+
+// insertShipments records shipments not yet stored. Shipments are never changed once
+// recorded, so existing rows are left alone.
+func (r *ReservationRepository) insertShipments(ctx context.Context, res *entity.Reservation) error {
+	var (
+		ids        []string
+		lines      []int
+		quantities []int
+		shippedAt  []time.Time
+	)
+	for _, shipment := range res.Shipments {
+		for _, line := range shipment.Lines {
+			ids = append(ids, shipment.ID)
+			lines = append(lines, line.Line+1)
+			quantities = append(quantities, line.Quantity)
+			shippedAt = append(shippedAt, shipment.ShippedAt)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO reservation_shipments (reservation_id, shipment_id, line_no, quantity, shipped_at)
+		SELECT $1, s.shipment_id, s.line_no, s.quantity, s.shipped_at
+		FROM unnest($2::text[], $3::int[], $4::int[], $5::timestamptz[]) AS s(shipment_id, line_no, quantity, shipped_at)
+		ON CONFLICT (reservation_id, shipment_id, line_no) DO NOTHING`,
+		res.ID, ids, lines, quantities, shippedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert reservation shipments: %w", mapError(err))
+	}
+	return nil
+}
+
+// loadShipments fetches the shipments of all given reservations in a single query
+func (r *ReservationRepository) loadShipments(ctx context.Context, byID map[string]*entity.Reservation, ids []string) error {
+	rows, err := r.db.conn(ctx).Query(ctx, `
+		SELECT reservation_id, shipment_id, line_no, quantity, shipped_at FROM reservation_shipments
+		WHERE reservation_id = ANY($1)
+		ORDER BY reservation_id, shipped_at, shipment_id, line_no`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("select reservation shipments: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			reservationID, shipmentID string
+			line                      entity.ShipmentLine
+			shippedAt                 time.Time
+		)
+		if err := rows.Scan(&reservationID, &shipmentID, &line.Line, &line.Quantity, &shippedAt); err != nil {
+			return fmt.Errorf("scan reservation shipment: %w", mapError(err))
+		}
+		line.Line--
+
+		res := byID[reservationID]
+		if n := len(res.Shipments); n > 0 && res.Shipments[n-1].ID == shipmentID {
+			res.Shipments[n-1].Lines = append(res.Shipments[n-1].Lines, line)
+			continue
+		}
+		res.Shipments = append(res.Shipments, entity.Shipment{
+			ID:        shipmentID,
+			Lines:     []entity.ShipmentLine{line},
+			ShippedAt: shippedAt.UTC(),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("scan reservation shipments: %w", mapError(err))
+	}
+	return nil
+}
+
+func scanReservation(row pgx.Row) (*entity.Reservation, error) {
+	var res entity.Reservation
+	var status string
+	err := row.Scan(&res.ID, &res.OrderID, &status, &res.ExpiresAt, &res.CreatedAt, &res.UpdatedAt, &res.ReleasedAt, &res.FulfilledAt)
+	if err != nil {
+		return nil, err
+	}
+	res.Status = entity.ReservationStatus(status)
+	res.ExpiresAt = res.ExpiresAt.UTC()
+	res.CreatedAt = res.CreatedAt.UTC()
+	res.UpdatedAt = res.UpdatedAt.UTC()
+	return &res, nil
+}
@@ -0,0 +1,186 @@
+// file: internal/infrastructure/postgres/serial_unit_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const serialUnitColumns = `id, product_id, serial_number, stock_item_id, status, reservation_id, created_at, updated_at`
+
+const serialMovementColumns = `id, serial_unit_id, stock_item_id, movement_id, movement_type, from_status, to_status,
+	reference_id, reference_type, created_by, created_at`
+
+// SerialUnitRepository implements repository.SerialUnitRepository on PostgreSQL.
+// Serial movements are an append-only history like stock movements.
+type SerialUnitRepository struct {
+	db *DB
+}
+
+// NewSerialUnitRepository creates a new SerialUnitRepository
+func NewSerialUnitRepository(db *DB) *SerialUnitRepository {
+	return &SerialUnitRepository{db: db}
+}
+
+var _ repository.SerialUnitRepository = (*SerialUnitRepository)(nil)
+
+// Create persists a new serial unit
+func (r *SerialUnitRepository) Create(ctx context.Context, u *entity.SerialUnit) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO serial_units (`+serialUnitColumns+`)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8)`,
+		u.ID, u.ProductID, u.SerialNumber, u.StockItemID, string(u.Status), u.ReservationID, u.CreatedAt, u.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert serial unit: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a serial unit by its ID
+func (r *SerialUnitRepository) GetByID(ctx context.Context, id string) (*entity.SerialUnit, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+serialUnitColumns+` FROM serial_units WHERE id = $1`, id)
+	u, err := scanSerialUnit(row)
+	if err != nil {
+		return nil, fmt.Errorf("select serial unit: %w", mapError(err))
+	}
+	return u, nil
+}
+
+// GetBySerialNumber retrieves the unit of a product with the given serial number
+func (r *SerialUnitRepository) GetBySerialNumber(ctx context.Context, productID, serialNumber string) (*entity.SerialUnit, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+serialUnitColumns+` FROM serial_units WHERE product_id = $1 AND serial_number = $2`,
+		productID, serialNumber,
+	)
+	u, err := scanSerialUnit(row)
+	if err != nil {
+		return nil, fmt.Errorf("select serial unit: %w", mapError(err))
+	}
+	return u, nil
+}
+
+// List retrieves serial units with optional filtering, oldest first
+func (r *SerialUnitRepository) List(ctx context.Context, filter repository.SerialUnitFilter) ([]*entity.SerialUnit, int, error) {
+	var b whereBuilder
+	if filter.ProductID != nil {
+		b.add("product_id = ?", *filter.ProductID)
+	}
+	if filter.StockItemID != nil {
+		b.add("stock_item_id = ?", *filter.StockItemID)
+	}
+	if filter.SerialNumber != nil {
+		b.add("serial_number = ?", *filter.SerialNumber)
+	}
+	if filter.ReservationID != nil {
+		b.add("reservation_id = ?", *filter.ReservationID)
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, string(s))
+		}
+		b.add("status = ANY(?)", statuses)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM serial_units`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count serial units: %w", mapError(err))
+	}
+
+	query := `SELECT ` + serialUnitColumns + ` FROM serial_units` + b.where() + ` ORDER BY created_at, id` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select serial units: %w", mapError(err))
+	}
+	units, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.SerialUnit, error) {
+		return scanSerialUnit(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan serial units: %w", mapError(err))
+	}
+	return units, total, nil
+}
+
+// Update persists the location and status of an existing serial unit
+func (r *SerialUnitRepository) Update(ctx context.Context, u *entity.SerialUnit) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE serial_units
+		SET stock_item_id = $2, status = $3, reservation_id = NULLIF($4, ''), updated_at = $5
+		WHERE id = $1`,
+		u.ID, u.StockItemID, string(u.Status), u.ReservationID, u.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update serial unit: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update serial unit %s: %w", u.ID, repository.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateMovement persists a serial movement record
+func (r *SerialUnitRepository) CreateMovement(ctx context.Context, m *entity.SerialMovement) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO serial_movements (`+serialMovementColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		m.ID, m.SerialUnitID, m.StockItemID, m.MovementID, string(m.MovementType), string(m.FromStatus),
+		string(m.ToStatus), m.ReferenceID, m.ReferenceType, m.CreatedBy, m.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert serial movement: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetMovements retrieves the full history of a serial unit, oldest first
+func (r *SerialUnitRepository) GetMovements(ctx context.Context, serialUnitID string) ([]*entity.SerialMovement, error) {
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+serialMovementColumns+` FROM serial_movements WHERE serial_unit_id = $1 ORDER BY created_at, id`,
+		serialUnitID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select serial movements: %w", mapError(err))
+	}
+	movements, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.SerialMovement, error) {
+		var m entity.SerialMovement
+		var movementType, fromStatus, toStatus string
+		err := row.Scan(&m.ID, &m.SerialUnitID, &m.StockItemID, &m.MovementID, &movementType, &fromStatus,
+			&toStatus, &m.ReferenceID, &m.ReferenceType, &m.CreatedBy, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		m.MovementType = entity.MovementType(movementType)
+		m.FromStatus = entity.SerialStatus(fromStatus)
+		m.ToStatus = entity.SerialStatus(toStatus)
+		m.CreatedAt = m.CreatedAt.UTC()
+		return &m, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan serial movements: %w", mapError(err))
+	}
+	return movements, nil
+}
+
+func scanSerialUnit(row pgx.Row) (*entity.SerialUnit, error) {
+	var u entity.SerialUnit
+	var status string
+	var reservationID *string
+	err := row.Scan(&u.ID, &u.ProductID, &u.SerialNumber, &u.StockItemID, &status, &reservationID,
+		&u.CreatedAt, &u.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	u.Status = entity.SerialStatus(status)
+	if reservationID != nil {
+		u.ReservationID = *reservationID
+	}
+	u.CreatedAt = u.CreatedAt.UTC()
+	u.UpdatedAt = u.UpdatedAt.UTC()
+	return &u, nil
+}
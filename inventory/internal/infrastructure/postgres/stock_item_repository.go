@@ -0,0 +1,285 @@
+// file: internal/infrastructure/postgres/stock_item_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const stockItemColumns = `si.id, si.product_id, si.warehouse_id, si.quantity_on_hand, si.quantity_reserved,
+	` + expiredLotQuantity + `, si.reorder_point, si.reorder_quantity, COALESCE(si.frozen_by, ''),
+	COALESCE(si.bin_location_id, ''), ` + homeBinPath + `, si.version, si.created_at, si.updated_at`
+
+// expiredLotQuantity sums the unreserved stock of an item's lots that have expired
+const expiredLotQuantity = `COALESCE((SELECT SUM(l.quantity_on_hand - l.quantity_reserved) FROM stock_lots l
+	WHERE l.stock_item_id = si.id AND l.expires_at <= now()), 0)`
+
+// homeBinPath is the path of an item's home bin
+const homeBinPath = `COALESCE((SELECT wl.path FROM warehouse_locations wl WHERE wl.id = si.bin_location_id), '')`
+
+// lowStockCondition matches items whose available quantity is at or below the reorder point
+const lowStockCondition = `(si.quantity_on_hand - si.quantity_reserved) <= si.reorder_point`
+
+// StockItemRepository implements repository.StockItemRepository on PostgreSQL
+type StockItemRepository struct {
+	db *DB
+}
+
+// NewStockItemRepository creates a new StockItemRepository
+func NewStockItemRepository(db *DB) *StockItemRepository {
+	return &StockItemRepository{db: db}
+}
+
+var _ repository.StockItemRepository = (*StockItemRepository)(nil)
+
+// Create persists a new stock item
+func (r *StockItemRepository) Create(ctx context.Context, s *entity.StockItem) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO stock_items (id, product_id, warehouse_id, quantity_on_hand, quantity_reserved,
+			reorder_point, reorder_quantity, bin_location_id, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10, $11)`,
+		s.ID, s.ProductID, s.WarehouseID, s.QuantityOnHand, s.QuantityReserved,
+		s.ReorderPoint, s.ReorderQuantity, s.BinLocationID, s.Version, s.CreatedAt, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert stock item: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a stock item by its ID
+func (r *StockItemRepository) GetByID(ctx context.Context, id string) (*entity.StockItem, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+stockItemColumns+` FROM stock_items si WHERE si.id = $1`, id)
+	s, err := scanStockItem(row)
+	if err != nil {
+		return nil, fmt.Errorf("select stock item: %w", mapError(err))
+	}
+	return s, nil
+}
+
+// GetByProductAndWarehouse retrieves a stock item by product and warehouse
+func (r *StockItemRepository) GetByProductAndWarehouse(ctx context.Context, productID, warehouseID string) (*entity.StockItem, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+stockItemColumns+` FROM stock_items si WHERE si.product_id = $1 AND si.warehouse_id = $2`,
+		productID, warehouseID)
+	s, err := scanStockItem(row)
+	if err != nil {
+		return nil, fmt.Errorf("select stock item by product and warehouse: %w", mapError(err))
+	}
+	return s, nil
+}
+
+// List retrieves stock items with optional filtering
+func (r *StockItemRepository) List(ctx context.Context, filter repository.StockItemFilter) ([]*entity.StockItem, int, error) {
+	var b whereBuilder
+	if filter.ProductID != nil {
+		b.add("si.product_id = ?", *filter.ProductID)
+	}
+	if filter.WarehouseID != nil {
+		b.add("si.warehouse_id = ?", *filter.WarehouseID)
+	}
+	if filter.LowStock != nil {
+		if *filter.LowStock {
+			b.add(lowStockCondition)
+		} else {
+			b.add("NOT " + lowStockCondition)
+		}
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM stock_items si`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count stock items: %w", mapError(err))
+	}
+
+	query := `SELECT ` + stockItemColumns + ` FROM stock_items si` + b.where() + ` ORDER BY si.created_at, si.id` + b.page(filter.Limit, filter.Offset)
+	items, err := r.query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// Update persists changes to an existing stock item regardless of its stored version.
+// Quantity changes should use UpdateWithLock.
+func (r *StockItemRepository) Update(ctx context.Context, s *entity.StockItem) error {
+	err := r.db.conn(ctx).QueryRow(ctx, `
+		UPDATE stock_items
+		SET quantity_on_hand = $2, quantity_reserved = $3, reorder_point = $4, reorder_quantity = $5,
+		    bin_location_id = NULLIF($6, ''), version = version + 1, updated_at = $7
+		WHERE id = $1
+		RETURNING version`,
+		s.ID, s.QuantityOnHand, s.QuantityReserved, s.ReorderPoint, s.ReorderQuantity, s.BinLocationID, s.UpdatedAt,
+	).Scan(&s.Version)
+	if err != nil {
+		return fmt.Errorf("update stock item %s: %w", s.ID, mapError(err))
+	}
+	return nil
+}
+
+// UpdateWithLock updates a stock item only if its stored version still equals expectedVersion.
+// It is the only update that changes the item's count freeze.
+func (r *StockItemRepository) UpdateWithLock(ctx context.Context, s *entity.StockItem, expectedVersion int) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE stock_items
+		SET quantity_on_hand = $2, quantity_reserved = $3, reorder_point = $4, reorder_quantity = $5,
+		    frozen_by = NULLIF($6, ''), bin_location_id = NULLIF($7, ''), version = version + 1, updated_at = $8
+		WHERE id = $1 AND version = $9`,
+		s.ID, s.QuantityOnHand, s.QuantityReserved, s.ReorderPoint, s.ReorderQuantity, s.FrozenBy, s.BinLocationID,
+		s.UpdatedAt, expectedVersion,
+	)
+	if err != nil {
+		return fmt.Errorf("update stock item: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		// Distinguish a missing item from a stale version
+		if _, err := r.GetByID(ctx, s.ID); err != nil {
+			return err
+		}
+		return &repository.ConcurrentModificationError{Entity: "stock item", ID: s.ID, ExpectedVersion: expectedVersion}
+	}
+	s.Version = expectedVersion + 1
+	return nil
+}
+
+// GetAggregatedStock retrieves total stock for a product and its live variants across all
+// warehouses. A kit's stock in a warehouse counts the kits its components there make up:
+// reserved are the kits on hand that component reservations leave unavailable.
+func (r *StockItemRepository) GetAggregatedStock(ctx context.Context, productID string) (*repository.AggregatedStock, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, `
+		SELECT s.warehouse_id, w.name, SUM(s.on_hand), SUM(s.reserved)
+		FROM (
+			SELECT si.warehouse_id, si.quantity_on_hand AS on_hand, si.quantity_reserved AS reserved
+			FROM stock_items si
+			JOIN products p ON p.id = si.product_id
+			WHERE si.product_id = $1 OR (p.parent_id = $1 AND p.deleted_at IS NULL)
+			UNION ALL
+			SELECT ks.warehouse_id, ks.on_hand, ks.on_hand - ks.available
+			FROM (`+kitWarehouseStock+`) ks
+			WHERE ks.kit_id = $1
+		) s
+		JOIN warehouses w ON w.id = s.warehouse_id
+		WHERE w.deleted_at IS NULL
+		GROUP BY s.warehouse_id, w.name
+		ORDER BY w.name, s.warehouse_id`,
+		productID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select aggregated stock: %w", mapError(err))
+	}
+	details, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.WarehouseStockDetail, error) {
+		var d repository.WarehouseStockDetail
+		err := row.Scan(&d.WarehouseID, &d.WarehouseName, &d.QuantityOnHand, &d.QuantityReserved)
+		d.Available = d.QuantityOnHand - d.QuantityReserved
+		return d, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan aggregated stock: %w", mapError(err))
+	}
+
+	agg := &repository.AggregatedStock{
+		ProductID:        productID,
+		WarehouseCount:   len(details),
+		WarehouseDetails: details,
+	}
+	for _, d := range details {
+		agg.TotalOnHand += d.QuantityOnHand
+		agg.TotalReserved += d.QuantityReserved
+		agg.TotalAvailable += d.Available
+	}
+
+	rows, err = r.db.conn(ctx).Query(ctx, `
+		SELECT p.id, p.sku, p.variant_size, p.variant_color,
+		       COALESCE(SUM(si.quantity_on_hand), 0), COALESCE(SUM(si.quantity_reserved), 0)
+		FROM products p
+		LEFT JOIN stock_items si ON si.product_id = p.id
+		     AND si.warehouse_id IN (SELECT w.id FROM warehouses w WHERE w.deleted_at IS NULL)
+		WHERE p.parent_id = $1 AND p.deleted_at IS NULL
+		GROUP BY p.id, p.sku, p.variant_size, p.variant_color
+		ORDER BY p.sku`,
+		productID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select variant stock: %w", mapError(err))
+	}
+	agg.VariantDetails, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.VariantStockDetail, error) {
+		var d repository.VariantStockDetail
+		err := row.Scan(&d.ProductID, &d.SKU, &d.Variant.Size, &d.Variant.Color, &d.QuantityOnHand, &d.QuantityReserved)
+		d.Available = d.QuantityOnHand - d.QuantityReserved
+		return d, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan variant stock: %w", mapError(err))
+	}
+
+	// Dispatched transfers no longer count in their source and not yet in their destination
+	err = r.db.conn(ctx).QueryRow(ctx, `
+		SELECT COALESCE(SUM(ti.quantity), 0)
+		FROM transfer_items ti
+		JOIN transfers t ON t.id = ti.transfer_id
+		JOIN products p ON p.id = ti.product_id
+		WHERE (ti.product_id = $1 OR (p.parent_id = $1 AND p.deleted_at IS NULL)) AND t.status IN ($2, $3)`,
+		productID, string(entity.TransferStatusDispatched), string(entity.TransferStatusInTransit),
+	).Scan(&agg.TotalInTransit)
+	if err != nil {
+		return nil, fmt.Errorf("select in-transit stock: %w", mapError(err))
+	}
+	return agg, nil
+}
+
+// GetLowStockItems retrieves all stock items of live products and warehouses at or below reorder point
+func (r *StockItemRepository) GetLowStockItems(ctx context.Context) ([]*entity.StockItem, error) {
+	return r.query(ctx, `
+		SELECT `+stockItemColumns+`
+		FROM stock_items si
+		JOIN products p ON p.id = si.product_id
+		JOIN warehouses w ON w.id = si.warehouse_id
+		WHERE p.deleted_at IS NULL AND w.deleted_at IS NULL AND `+lowStockCondition+`
+		ORDER BY (si.quantity_on_hand - si.quantity_reserved) - si.reorder_point, si.id`)
+}
+
+// ExistsByProductAndWarehouse checks if a stock item exists for the given product and warehouse
+func (r *StockItemRepository) ExistsByProductAndWarehouse(ctx context.Context, productID, warehouseID string) (bool, error) {
+	var exists bool
+	err := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM stock_items WHERE product_id = $1 AND warehouse_id = $2)`,
+		productID, warehouseID,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check stock item: %w", mapError(err))
+	}
+	return exists, nil
+}
+
+func (r *StockItemRepository) query(ctx context.Context, sql string, args ...any) ([]*entity.StockItem, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select stock items: %w", mapError(err))
+	}
+	items, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.StockItem, error) {
+		return scanStockItem(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan stock items: %w", mapError(err))
+	}
+	return items, nil
+}
+
+func scanStockItem(row pgx.Row) (*entity.StockItem, error) {
+	var s entity.StockItem
+	err := row.Scan(
+		&s.ID, &s.ProductID, &s.WarehouseID, &s.QuantityOnHand, &s.QuantityReserved,
+		&s.QuantityExpired, &s.ReorderPoint, &s.ReorderQuantity, &s.FrozenBy, &s.BinLocationID, &s.BinLocation,
+		&s.Version, &s.CreatedAt, &s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.CreatedAt = s.CreatedAt.UTC()
+	s.UpdatedAt = s.UpdatedAt.UTC()
+	return &s, nil
+}
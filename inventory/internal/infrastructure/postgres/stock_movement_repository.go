@@ -0,0 +1,140 @@
+// file: internal/infrastructure/postgres/stock_movement_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const stockMovementColumns = `id, stock_item_id, movement_type, quantity, reference_id, reference_type,
+	previous_on_hand, new_on_hand, previous_reserved, new_reserved, reason, created_by, created_at`
+
+// StockMovementRepository implements repository.StockMovementRepository on PostgreSQL.
+// Movements are an append-only audit trail and are never updated or deleted.
+type StockMovementRepository struct {
+	db *DB
+}
+
+// NewStockMovementRepository creates a new StockMovementRepository
+func NewStockMovementRepository(db *DB) *StockMovementRepository {
+	return &StockMovementRepository{db: db}
+}
+
+var _ repository.StockMovementRepository = (*StockMovementRepository)(nil)
+
+// Create persists a new stock movement record
+func (r *StockMovementRepository) Create(ctx context.Context, m *entity.StockMovement) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO stock_movements (`+stockMovementColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		m.ID, m.StockItemID, string(m.MovementType), m.Quantity, m.ReferenceID, m.ReferenceType,
+		m.PreviousOnHand, m.NewOnHand, m.PreviousReserved, m.NewReserved, m.Reason, m.CreatedBy, m.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert stock movement: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a stock movement by its ID
+func (r *StockMovementRepository) GetByID(ctx context.Context, id string) (*entity.StockMovement, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+stockMovementColumns+` FROM stock_movements WHERE id = $1`, id)
+	m, err := scanStockMovement(row)
+	if err != nil {
+		return nil, fmt.Errorf("select stock movement: %w", mapError(err))
+	}
+	return m, nil
+}
+
+// List retrieves stock movements with optional filtering, newest first
+func (r *StockMovementRepository) List(ctx context.Context, filter repository.StockMovementFilter) ([]*entity.StockMovement, int, error) {
+	var b whereBuilder
+	if filter.StockItemID != nil {
+		b.add("stock_item_id = ?", *filter.StockItemID)
+	}
+	if filter.MovementType != nil {
+		b.add("movement_type = ?", string(*filter.MovementType))
+	}
+	if filter.ReferenceID != nil {
+		b.add("reference_id = ?", *filter.ReferenceID)
+	}
+	if filter.ReferenceType != nil {
+		b.add("reference_type = ?", *filter.ReferenceType)
+	}
+	if filter.StartDate != nil {
+		b.add("created_at >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		b.add("created_at <= ?", *filter.EndDate)
+	}
+	return r.list(ctx, &b, filter.Limit, filter.Offset)
+}
+
+// GetByStockItem retrieves all movements for a specific stock item, newest first
+func (r *StockMovementRepository) GetByStockItem(ctx context.Context, stockItemID string, limit, offset int) ([]*entity.StockMovement, int, error) {
+	var b whereBuilder
+	b.add("stock_item_id = ?", stockItemID)
+	return r.list(ctx, &b, limit, offset)
+}
+
+// GetByReference retrieves movements by reference (e.g., order ID), oldest first
+func (r *StockMovementRepository) GetByReference(ctx context.Context, referenceID, referenceType string) ([]*entity.StockMovement, error) {
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+stockMovementColumns+` FROM stock_movements
+		WHERE reference_id = $1 AND reference_type = $2
+		ORDER BY created_at, id`,
+		referenceID, referenceType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select stock movements by reference: %w", mapError(err))
+	}
+	return collectStockMovements(rows)
+}
+
+func (r *StockMovementRepository) list(ctx context.Context, b *whereBuilder, limit, offset int) ([]*entity.StockMovement, int, error) {
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM stock_movements`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count stock movements: %w", mapError(err))
+	}
+
+	query := `SELECT ` + stockMovementColumns + ` FROM stock_movements` + b.where() + ` ORDER BY created_at DESC, id DESC` + b.page(limit, offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select stock movements: %w", mapError(err))
+	}
+	movements, err := collectStockMovements(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return movements, total, nil
+}
+
+func collectStockMovements(rows pgx.Rows) ([]*entity.StockMovement, error) {
+	movements, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.StockMovement, error) {
+		return scanStockMovement(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan stock movements: %w", mapError(err))
+	}
+	return movements, nil
+}
+
+func scanStockMovement(row pgx.Row) (*entity.StockMovement, error) {
+	var m entity.StockMovement
+	var movementType string
+	err := row.Scan(
+		&m.ID, &m.StockItemID, &movementType, &m.Quantity, &m.ReferenceID, &m.ReferenceType,
+		&m.PreviousOnHand, &m.NewOnHand, &m.PreviousReserved, &m.NewReserved, &m.Reason, &m.CreatedBy, &m.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	m.MovementType = entity.MovementType(movementType)
+	m.CreatedAt = m.CreatedAt.UTC()
+	return &m, nil
+}
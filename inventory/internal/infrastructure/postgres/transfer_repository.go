@@ -0,0 +1,198 @@
+// file: internal/infrastructure/postgres/transfer_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const transferColumns = `id, source_warehouse_id, destination_warehouse_id, status, notes, created_by,
+	created_at, updated_at, dispatched_at, received_at`
+
+const transferItemColumns = `transfer_id, product_id, source_stock_item_id, destination_stock_item_id, quantity, received_quantity`
+
+// TransferRepository implements repository.TransferRepository on PostgreSQL.
+// Transfer items are stored as child rows in transfer_items, ordered by line number.
+type TransferRepository struct {
+	db *DB
+}
+
+// NewTransferRepository creates a new TransferRepository
+func NewTransferRepository(db *DB) *TransferRepository {
+	return &TransferRepository{db: db}
+}
+
+var _ repository.TransferRepository = (*TransferRepository)(nil)
+
+// Create persists a new transfer and its items atomically
+func (r *TransferRepository) Create(ctx context.Context, t *entity.Transfer) error {
+	return r.db.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, err := r.db.conn(ctx).Exec(ctx, `
+			INSERT INTO transfers (`+transferColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			t.ID, t.SourceWarehouseID, t.DestinationWarehouseID, string(t.Status), t.Notes, t.CreatedBy,
+			t.CreatedAt, t.UpdatedAt, t.DispatchedAt, t.ReceivedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert transfer: %w", mapError(err))
+		}
+		return r.insertItems(ctx, t)
+	})
+}
+
+// GetByID retrieves a transfer and its items by ID
+func (r *TransferRepository) GetByID(ctx context.Context, id string) (*entity.Transfer, error) {
+	return r.get(ctx, `SELECT `+transferColumns+` FROM transfers WHERE id = $1`, id)
+}
+
+// GetByIDForUpdate retrieves a transfer and its items by ID, locking the transfer row
+// until the surrounding transaction ends
+func (r *TransferRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.Transfer, error) {
+	return r.get(ctx, `SELECT `+transferColumns+` FROM transfers WHERE id = $1 FOR UPDATE`, id)
+}
+
+func (r *TransferRepository) get(ctx context.Context, sql, id string) (*entity.Transfer, error) {
+	t, err := scanTransfer(r.db.conn(ctx).QueryRow(ctx, sql, id))
+	if err != nil {
+		return nil, fmt.Errorf("select transfer: %w", mapError(err))
+	}
+	if err := r.loadItems(ctx, []*entity.Transfer{t}); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// List retrieves transfers with optional filtering, newest first
+func (r *TransferRepository) List(ctx context.Context, filter repository.TransferFilter) ([]*entity.Transfer, int, error) {
+	var b whereBuilder
+	if filter.Status != nil {
+		b.add("status = ?", string(*filter.Status))
+	}
+	if filter.SourceWarehouseID != nil {
+		b.add("source_warehouse_id = ?", *filter.SourceWarehouseID)
+	}
+	if filter.DestinationWarehouseID != nil {
+		b.add("destination_warehouse_id = ?", *filter.DestinationWarehouseID)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM transfers`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count transfers: %w", mapError(err))
+	}
+
+	query := `SELECT ` + transferColumns + ` FROM transfers` + b.where() + ` ORDER BY created_at DESC, id` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select transfers: %w", mapError(err))
+	}
+	transfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Transfer, error) {
+		return scanTransfer(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan transfers: %w", mapError(err))
+	}
+	if err := r.loadItems(ctx, transfers); err != nil {
+		return nil, 0, err
+	}
+	return transfers, total, nil
+}
+
+// Update persists changes to an existing transfer and replaces its items
+func (r *TransferRepository) Update(ctx context.Context, t *entity.Transfer) error {
+	return r.db.WithinTransaction(ctx, func(ctx context.Context) error {
+		tag, err := r.db.conn(ctx).Exec(ctx, `
+			UPDATE transfers
+			SET status = $2, notes = $3, updated_at = $4, dispatched_at = $5, received_at = $6
+			WHERE id = $1`,
+			t.ID, string(t.Status), t.Notes, t.UpdatedAt, t.DispatchedAt, t.ReceivedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("update transfer: %w", mapError(err))
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("update transfer %s: %w", t.ID, repository.ErrNotFound)
+		}
+
+		if _, err := r.db.conn(ctx).Exec(ctx, `DELETE FROM transfer_items WHERE transfer_id = $1`, t.ID); err != nil {
+			return fmt.Errorf("delete transfer items: %w", mapError(err))
+		}
+		return r.insertItems(ctx, t)
+	})
+}
+
+func (r *TransferRepository) insertItems(ctx context.Context, t *entity.Transfer) error {
+	rows := make([][]any, 0, len(t.Items))
+	for i, item := range t.Items {
+		rows = append(rows, []any{t.ID, i + 1, item.ProductID, item.SourceStockItemID, item.DestinationStockItemID,
+			item.Quantity, item.ReceivedQuantity})
+	}
+	_, err := r.db.conn(ctx).CopyFrom(ctx,
+		pgx.Identifier{"transfer_items"},
+		[]string{"transfer_id", "line_no", "product_id", "source_stock_item_id", "destination_stock_item_id",
+			"quantity", "received_quantity"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("insert transfer items: %w", mapError(err))
+	}
+	return nil
+}
+
+// loadItems fetches the items of all given transfers in a single query
+func (r *TransferRepository) loadItems(ctx context.Context, transfers []*entity.Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entity.Transfer, len(transfers))
+	ids := make([]string, 0, len(transfers))
+	for _, t := range transfers {
+		byID[t.ID] = t
+		ids = append(ids, t.ID)
+	}
+
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+transferItemColumns+` FROM transfer_items
+		WHERE transfer_id = ANY($1)
+		ORDER BY transfer_id, line_no`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("select transfer items: %w", mapError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var transferID string
+		var item entity.TransferItem
+		if err := rows.Scan(&transferID, &item.ProductID, &item.SourceStockItemID, &item.DestinationStockItemID,
+			&item.Quantity, &item.ReceivedQuantity); err != nil {
+			return fmt.Errorf("scan transfer item: %w", mapError(err))
+		}
+		t := byID[transferID]
+		t.Items = append(t.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("scan transfer items: %w", mapError(err))
+	}
+	return nil
+}
+
+func scanTransfer(row pgx.Row) (*entity.Transfer, error) {
+	var t entity.Transfer
+	var status string
+	err := row.Scan(&t.ID, &t.SourceWarehouseID, &t.DestinationWarehouseID, &status, &t.Notes, &t.CreatedBy,
+		&t.CreatedAt, &t.UpdatedAt, &t.DispatchedAt, &t.ReceivedAt)
+	if err != nil {
+		return nil, err
+	}
+	t.Status = entity.TransferStatus(status)
+	t.CreatedAt = t.CreatedAt.UTC()
+	t.UpdatedAt = t.UpdatedAt.UTC()
+	return &t, nil
+}
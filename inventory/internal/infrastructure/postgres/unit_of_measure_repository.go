@@ -0,0 +1,128 @@
+// file: internal/infrastructure/postgres/unit_of_measure_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const unitConversionColumns = `product_id, unit_code, quantity, base_quantity, created_at, updated_at`
+
+// UnitOfMeasureRepository implements repository.UnitOfMeasureRepository on PostgreSQL
+type UnitOfMeasureRepository struct {
+	db *DB
+}
+
+// NewUnitOfMeasureRepository creates a new UnitOfMeasureRepository
+func NewUnitOfMeasureRepository(db *DB) *UnitOfMeasureRepository {
+	return &UnitOfMeasureRepository{db: db}
+}
+
+var _ repository.UnitOfMeasureRepository = (*UnitOfMeasureRepository)(nil)
+
+// Create persists a new unit of measure
+func (r *UnitOfMeasureRepository) Create(ctx context.Context, u *entity.UnitOfMeasure) error {
+	_, err := r.db.conn(ctx).Exec(ctx,
+		`INSERT INTO units_of_measure (code, name, created_at) VALUES ($1, $2, $3)`,
+		u.Code, u.Name, u.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert unit of measure: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByCode retrieves a unit of measure by its code
+func (r *UnitOfMeasureRepository) GetByCode(ctx context.Context, code string) (*entity.UnitOfMeasure, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT code, name, created_at FROM units_of_measure WHERE code = $1`, code)
+	u, err := scanUnitOfMeasure(row)
+	if err != nil {
+		return nil, fmt.Errorf("select unit of measure: %w", mapError(err))
+	}
+	return u, nil
+}
+
+// List retrieves all units of measure ordered by code
+func (r *UnitOfMeasureRepository) List(ctx context.Context) ([]*entity.UnitOfMeasure, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, `SELECT code, name, created_at FROM units_of_measure ORDER BY code`)
+	if err != nil {
+		return nil, fmt.Errorf("select units of measure: %w", mapError(err))
+	}
+	units, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.UnitOfMeasure, error) {
+		return scanUnitOfMeasure(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan units of measure: %w", mapError(err))
+	}
+	return units, nil
+}
+
+// SaveConversion creates or replaces the conversion of a unit for a product
+func (r *UnitOfMeasureRepository) SaveConversion(ctx context.Context, c *entity.UnitConversion) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO product_unit_conversions (`+unitConversionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (product_id, unit_code) DO UPDATE
+		SET quantity = EXCLUDED.quantity, base_quantity = EXCLUDED.base_quantity, updated_at = EXCLUDED.updated_at`,
+		c.ProductID, c.UnitCode, c.Quantity, c.BaseQuantity, c.CreatedAt, c.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save unit conversion: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetConversion retrieves the conversion of a unit for a product
+func (r *UnitOfMeasureRepository) GetConversion(ctx context.Context, productID, unitCode string) (*entity.UnitConversion, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+unitConversionColumns+` FROM product_unit_conversions WHERE product_id = $1 AND unit_code = $2`,
+		productID, unitCode,
+	)
+	c, err := scanUnitConversion(row)
+	if err != nil {
+		return nil, fmt.Errorf("select unit conversion: %w", mapError(err))
+	}
+	return c, nil
+}
+
+// ListConversions retrieves the conversions of a product ordered by unit code
+func (r *UnitOfMeasureRepository) ListConversions(ctx context.Context, productID string) ([]*entity.UnitConversion, error) {
+	rows, err := r.db.conn(ctx).Query(ctx,
+		`SELECT `+unitConversionColumns+` FROM product_unit_conversions WHERE product_id = $1 ORDER BY unit_code`,
+		productID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select unit conversions: %w", mapError(err))
+	}
+	conversions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.UnitConversion, error) {
+		return scanUnitConversion(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan unit conversions: %w", mapError(err))
+	}
+	return conversions, nil
+}
+
+func scanUnitOfMeasure(row pgx.Row) (*entity.UnitOfMeasure, error) {
+	var u entity.UnitOfMeasure
+	if err := row.Scan(&u.Code, &u.Name, &u.CreatedAt); err != nil {
+		return nil, err
+	}
+	u.CreatedAt = u.CreatedAt.UTC()
+	return &u, nil
+}
+
+func scanUnitConversion(row pgx.Row) (*entity.UnitConversion, error) {
+	var c entity.UnitConversion
+	if err := row.Scan(&c.ProductID, &c.UnitCode, &c.Quantity, &c.BaseQuantity, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	c.CreatedAt = c.CreatedAt.UTC()
+	c.UpdatedAt = c.UpdatedAt.UTC()
+	return &c, nil
+}
@@ -0,0 +1,159 @@
+// file: internal/infrastructure/postgres/warehouse_repository.go
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+)
+
+const warehouseColumns = `id, code, name, street, city, state, country, postal_code,
+	priority, is_active, created_at, updated_at, deleted_at`
+
+// WarehouseRepository implements repository.WarehouseRepository on PostgreSQL
+type WarehouseRepository struct {
+	db *DB
+}
+
+// NewWarehouseRepository creates a new WarehouseRepository
+func NewWarehouseRepository(db *DB) *WarehouseRepository {
+	return &WarehouseRepository{db: db}
+}
+
+var _ repository.WarehouseRepository = (*WarehouseRepository)(nil)
+
+// Create persists a new warehouse
+func (r *WarehouseRepository) Create(ctx context.Context, w *entity.Warehouse) error {
+	_, err := r.db.conn(ctx).Exec(ctx, `
+		INSERT INTO warehouses (`+warehouseColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		w.ID, w.Code, w.Name, w.Address.Street, w.Address.City, w.Address.State, w.Address.Country, w.Address.PostalCode,
+		w.Priority, w.IsActive, w.CreatedAt, w.UpdatedAt, w.DeletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert warehouse: %w", mapError(err))
+	}
+	return nil
+}
+
+// GetByID retrieves a warehouse by its ID, including soft deleted warehouses
+func (r *WarehouseRepository) GetByID(ctx context.Context, id string) (*entity.Warehouse, error) {
+	row := r.db.conn(ctx).QueryRow(ctx, `SELECT `+warehouseColumns+` FROM warehouses WHERE id = $1`, id)
+	w, err := scanWarehouse(row)
+	if err != nil {
+		return nil, fmt.Errorf("select warehouse: %w", mapError(err))
+	}
+	return w, nil
+}
+
+// GetByCode retrieves a live warehouse by its code
+func (r *WarehouseRepository) GetByCode(ctx context.Context, code string) (*entity.Warehouse, error) {
+	row := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT `+warehouseColumns+` FROM warehouses WHERE code = $1 AND deleted_at IS NULL`, code)
+	w, err := scanWarehouse(row)
+	if err != nil {
+		return nil, fmt.Errorf("select warehouse by code: %w", mapError(err))
+	}
+	return w, nil
+}
+
+// List retrieves live warehouses with optional filtering
+func (r *WarehouseRepository) List(ctx context.Context, filter repository.WarehouseFilter) ([]*entity.Warehouse, int, error) {
+	var b whereBuilder
+	b.add("deleted_at IS NULL")
+	if filter.Code != nil {
+		b.add("code = ?", *filter.Code)
+	}
+	if filter.Name != nil {
+		b.add(`name ILIKE ? ESCAPE '\'`, "%"+escapeLike(*filter.Name)+"%")
+	}
+	if filter.IsActive != nil {
+		b.add("is_active = ?", *filter.IsActive)
+	}
+
+	var total int
+	if err := r.db.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM warehouses`+b.where(), b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count warehouses: %w", mapError(err))
+	}
+
+	query := `SELECT ` + warehouseColumns + ` FROM warehouses` + b.where() + ` ORDER BY created_at, id` + b.page(filter.Limit, filter.Offset)
+	rows, err := r.db.conn(ctx).Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("select warehouses: %w", mapError(err))
+	}
+	warehouses, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*entity.Warehouse, error) {
+		return scanWarehouse(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("scan warehouses: %w", mapError(err))
+	}
+	return warehouses, total, nil
+}
+
+// Update persists changes to an existing warehouse
+func (r *WarehouseRepository) Update(ctx context.Context, w *entity.Warehouse) error {
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE warehouses
+		SET name = $2, street = $3, city = $4, state = $5, country = $6, postal_code = $7,
+		    priority = $8, is_active = $9, updated_at = $10, deleted_at = $11
+		WHERE id = $1`,
+		w.ID, w.Name, w.Address.Street, w.Address.City, w.Address.State, w.Address.Country, w.Address.PostalCode,
+		w.Priority, w.IsActive, w.UpdatedAt, w.DeletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update warehouse: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("update warehouse %s: %w", w.ID, repository.ErrNotFound)
+	}
+	return nil
+}
+
+// Delete soft deletes a warehouse, mirroring entity.Warehouse.SoftDelete
+func (r *WarehouseRepository) Delete(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	tag, err := r.db.conn(ctx).Exec(ctx, `
+		UPDATE warehouses
+		SET deleted_at = $2, is_active = FALSE, updated_at = $2
+		WHERE id = $1 AND deleted_at IS NULL`,
+		id, now,
+	)
+	if err != nil {
+		return fmt.Errorf("delete warehouse: %w", mapError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("delete warehouse %s: %w", id, repository.ErrNotFound)
+	}
+	return nil
+}
+
+// ExistsByCode checks if a live warehouse with the given code exists
+func (r *WarehouseRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	var exists bool
+	err := r.db.conn(ctx).QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM warehouses WHERE code = $1 AND deleted_at IS NULL)`, code,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check warehouse code: %w", mapError(err))
+	}
+	return exists, nil
+}
+
+func scanWarehouse(row pgx.Row) (*entity.Warehouse, error) {
+	var w entity.Warehouse
+	err := row.Scan(
+		&w.ID, &w.Code, &w.Name, &w.Address.Street, &w.Address.City, &w.Address.State, &w.Address.Country, &w.Address.PostalCode,
+		&w.Priority, &w.IsActive, &w.CreatedAt, &w.UpdatedAt, &w.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	w.CreatedAt = w.CreatedAt.UTC()
+	w.UpdatedAt = w.UpdatedAt.UTC()
+	return &w, nil
+}
@@ -0,0 +1,115 @@
+// file: internal/infrastructure/scheduler/reservation_sweeper.go
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+)
+
+// ReservationExpirer expires reservations whose expiry has passed
+type ReservationExpirer interface {
+	ExpireOverdue(ctx context.Context) (int, error)
+}
+
+// ReservationSweeper periodically expires overdue reservations, returning their stock to
+// available. Every replica runs a sweeper but only the elected leader sweeps, so replicas
+// do not race each other over the same reservations.
+type ReservationSweeper struct {
+	expirer  ReservationExpirer
+	elector  port.LeaderElector
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped bool
+}
+
+// NewReservationSweeper creates a sweeper that sweeps every interval while leader
+func NewReservationSweeper(expirer ReservationExpirer, elector port.LeaderElector, interval time.Duration, logger *slog.Logger) *ReservationSweeper {
+	return &ReservationSweeper{
+		expirer:  expirer,
+		elector:  elector,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Start campaigns for leadership and sweeps in the background until Stop is called or ctx is done
+func (s *ReservationSweeper) Start(ctx context.Context) error {
+	if s.interval <= 0 {
+		return errors.New("reservation sweep interval must be positive")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return errors.New("reservation sweeper has been stopped")
+	}
+	if s.done != nil {
+		return errors.New("reservation sweeper already started")
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	go s.run(ctx)
+	return nil
+}
+
+// Stop waits for a sweep in progress to finish and leadership to be given up, or for ctx
+func (s *ReservationSweeper) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.stopped || s.done == nil {
+		s.stopped = true
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	s.cancel()
+	done := s.done
+	s.mu.Unlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("reservation sweeper did not stop in time: %w", ctx.Err())
+	}
+}
+
+func (s *ReservationSweeper) run(ctx context.Context) {
+	defer close(s.done)
+	if err := s.elector.RunWhileLeader(ctx, s.lead); err != nil {
+		s.logger.Error("reservation sweeper stopped campaigning", slog.String("error", err.Error()))
+	}
+}
+
+// lead sweeps straight away and then every interval until leadership is lost
+func (s *ReservationSweeper) lead(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ReservationSweeper) sweep(ctx context.Context) {
+	expired, err := s.expirer.ExpireOverdue(ctx)
+	if expired > 0 {
+		s.logger.Info("expired overdue reservations", slog.Int("count", expired))
+	}
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("failed to expire overdue reservations", slog.String("error", err.Error()))
+	}
+}
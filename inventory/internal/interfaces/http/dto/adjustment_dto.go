@@ -0,0 +1,88 @@
+// file: internal/interfaces/http/dto/adjustment_dto.go
+package dto
+
+import "time"
+
+// CreateAdjustmentRequest represents the request body for adjusting on-hand stock.
+// @Description Request payload for a manual stock adjustment (shrinkage, damage, found stock)
+type CreateAdjustmentRequest struct {
+	// StockItemID is the stock item to adjust
+	StockItemID string `json:"stock_item_id" validate:"required,uuid"`
+	// Quantity is the change to on-hand stock: positive for found stock, negative for losses
+	Quantity int `json:"quantity" validate:"required,ne=0"`
+	// Unit is the unit of measure of Quantity and UnitCost; the product's base unit when omitted
+	Unit string `json:"unit,omitempty" validate:"max=20"`
+	// ReasonCode is one of the configured adjustment reason codes
+	ReasonCode string `json:"reason_code" validate:"required,max=50"`
+	// Notes are optional adjustment notes
+	Notes string `json:"notes,omitempty" validate:"max=500"`
+	// UnitCost is the cost per unit, used for the approval value threshold (optional)
+	UnitCost *float64 `json:"unit_cost,omitempty" validate:"omitempty,min=0"`
+}
+
+// ReviewAdjustmentRequest represents the request body for approving or rejecting an adjustment.
+// @Description Request payload for reviewing a pending stock adjustment
+type ReviewAdjustmentRequest struct {
+	// Notes are optional review notes
+	Notes string `json:"notes,omitempty" validate:"max=500"`
+}
+
+// AdjustmentResponse represents a stock adjustment in API responses.
+// @Description Stock adjustment information returned by the API
+type AdjustmentResponse struct {
+	// ID is the unique adjustment identifier
+	ID string `json:"id"`
+	// StockItemID is the adjusted stock item
+	StockItemID string `json:"stock_item_id"`
+	// Quantity is the change to on-hand stock
+	Quantity int `json:"quantity"`
+	// ReasonCode is the adjustment reason code
+	ReasonCode string `json:"reason_code"`
+	// Notes are the adjustment notes
+	Notes string `json:"notes,omitempty"`
+	// UnitCost is the cost per unit, if given
+	UnitCost *float64 `json:"unit_cost,omitempty"`
+	// Status is the adjustment status (pending_approval, applied, rejected)
+	Status string `json:"status"`
+	// MovementID is the stock movement that applied the adjustment
+	MovementID string `json:"movement_id,omitempty"`
+	// RequestedBy is who requested the adjustment
+	RequestedBy string `json:"requested_by,omitempty"`
+	// ReviewedBy is who approved or rejected the adjustment
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// ReviewNotes are the reviewer's notes
+	ReviewNotes string `json:"review_notes,omitempty"`
+	// CreatedAt is when the adjustment was requested
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the adjustment was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+	// ReviewedAt is when the adjustment was reviewed
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// AppliedAt is when the adjustment changed the stock
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	// FilledBackorders are the backorders found stock was reserved for
+	FilledBackorders []BackorderResponse `json:"filled_backorders,omitempty"`
+}
+
+// ListAdjustmentsResponse represents the response for listing adjustments.
+// @Description Paginated list of stock adjustments
+type ListAdjustmentsResponse struct {
+	// Adjustments is the list of adjustments
+	Adjustments []AdjustmentResponse `json:"adjustments"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// AdjustmentReasonCodesResponse lists the reason codes adjustments may be made for.
+// @Description Configured adjustment reason codes
+type AdjustmentReasonCodesResponse struct {
+	// ReasonCodes are the accepted reason codes
+	ReasonCodes []string `json:"reason_codes"`
+}
+
+// Adjustment status constants
+const (
+	AdjustmentStatusPendingApproval = "pending_approval"
+	AdjustmentStatusApplied         = "applied"
+	AdjustmentStatusRejected        = "rejected"
+)
@@ -0,0 +1,36 @@
+// file: internal/interfaces/http/dto/alert_dto.go
+package dto
+
+import "time"
+
+// LowStockAlertResponse represents a low stock alert.
+// @Description Low stock alert information
+type LowStockAlertResponse struct {
+	// StockItemID is the affected stock item
+	StockItemID string `json:"stock_item_id"`
+	// ProductID is the product identifier
+	ProductID string `json:"product_id"`
+	// ProductName is the product name
+	ProductName string `json:"product_name"`
+	// SKU is the product SKU
+	SKU string `json:"sku"`
+	// WarehouseID is the warehouse identifier
+	WarehouseID string `json:"warehouse_id"`
+	// WarehouseName is the warehouse name
+	WarehouseName string `json:"warehouse_name"`
+	// CurrentQuantity is the current on-hand stock level
+	CurrentQuantity int `json:"current_quantity"`
+	// AvailableQuantity is the on-hand stock not held by reservations
+	AvailableQuantity int `json:"available_quantity"`
+	// Threshold is the low stock threshold (reorder point)
+	Threshold int `json:"threshold"`
+	// ReorderQuantity is the suggested quantity to reorder
+	ReorderQuantity int `json:"reorder_quantity"`
+	// Kit indicates the stock of a kit, counted in the kits its components make up; the
+	// alert then has no stock item and Threshold is the kit's low stock threshold
+	Kit bool `json:"kit,omitempty"`
+	// Severity is the alert severity (WARNING, CRITICAL, OUT_OF_STOCK)
+	Severity string `json:"severity"`
+	// UpdatedAt is when the stock level last changed
+	UpdatedAt time.Time `json:"updated_at"`
+}
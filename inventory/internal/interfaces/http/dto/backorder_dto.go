@@ -0,0 +1,47 @@
+// file: internal/interfaces/http/dto/backorder_dto.go
+package dto
+
+import "time"
+
+// BackorderResponse represents a backorder in API responses.
+// @Description Backorder information returned by the API
+type BackorderResponse struct {
+	// ID is the unique backorder identifier
+	ID string `json:"id"`
+	// OrderID is the external order identifier
+	OrderID string `json:"order_id"`
+	// ProductID is the backordered product
+	ProductID string `json:"product_id"`
+	// WarehouseID is the preferred warehouse, if any
+	WarehouseID string `json:"warehouse_id,omitempty"`
+	// Quantity is the backordered amount
+	Quantity int `json:"quantity"`
+	// ReservedQuantity is the part of Quantity arriving stock has been reserved for
+	ReservedQuantity int `json:"reserved_quantity"`
+	// RemainingQuantity is the part of Quantity still waiting for stock
+	RemainingQuantity int `json:"remaining_quantity"`
+	// Priority is the backorder's queue priority; higher is served first
+	Priority int `json:"priority"`
+	// Status is the backorder status (open, filled, cancelled)
+	Status string `json:"status"`
+	// CreatedAt is when the backorder was created
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the backorder was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UpdateBackorderPriorityRequest represents the request body for reprioritizing a backorder.
+// @Description Request payload for changing the queue priority of an open backorder
+type UpdateBackorderPriorityRequest struct {
+	// Priority is the new queue priority; higher is served first
+	Priority *int `json:"priority" validate:"required"`
+}
+
+// ListBackordersResponse represents the response for listing backorders.
+// @Description List of backorders
+type ListBackordersResponse struct {
+	// Backorders is the list of backorders
+	Backorders []BackorderResponse `json:"backorders"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
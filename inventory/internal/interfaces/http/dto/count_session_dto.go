@@ -0,0 +1,117 @@
+// file: internal/interfaces/http/dto/count_session_dto.go
+package dto
+
+import "time"
+
+// CreateCountSessionRequest represents the request body for opening a cycle count.
+// @Description Request payload for counting a warehouse or some of its stock items
+type CreateCountSessionRequest struct {
+	// WarehouseID is the warehouse to count
+	WarehouseID string `json:"warehouse_id" validate:"required,uuid"`
+	// StockItemIDs limits the count to these stock items; every stock item of the warehouse when empty
+	StockItemIDs []string `json:"stock_item_ids,omitempty" validate:"omitempty,dive,uuid"`
+	// Blind hides expected quantities from counters until the count is submitted
+	Blind bool `json:"blind"`
+	// Freeze refuses on-hand changes to the counted stock items until the count is posted or cancelled
+	Freeze bool `json:"freeze"`
+	// Notes are optional count notes
+	Notes string `json:"notes,omitempty" validate:"max=500"`
+}
+
+// CountEntry represents the quantity counted for one stock item.
+type CountEntry struct {
+	// StockItemID is the counted stock item
+	StockItemID string `json:"stock_item_id" validate:"required,uuid"`
+	// Quantity is the amount found on the shelf
+	Quantity *int `json:"quantity" validate:"required,min=0"`
+}
+
+// RecordCountsRequest represents the request body for recording counted quantities.
+// @Description Request payload for recording counts; recounting a stock item replaces its count
+type RecordCountsRequest struct {
+	// Counts are the counted quantities
+	Counts []CountEntry `json:"counts" validate:"required,min=1,dive"`
+}
+
+// ApproveCountSessionRequest represents the request body for approving a submitted count.
+// @Description Request payload for posting the variances of a count session
+type ApproveCountSessionRequest struct {
+	// StockItemIDs limits posting to the variances of these stock items; every variance when empty
+	StockItemIDs []string `json:"stock_item_ids,omitempty" validate:"omitempty,dive,uuid"`
+}
+
+// CountLineResponse represents a count line in the response.
+type CountLineResponse struct {
+	// StockItemID is the counted stock item
+	StockItemID string `json:"stock_item_id"`
+	// ProductID is the counted product
+	ProductID string `json:"product_id"`
+	// ExpectedQuantity is the recorded on-hand quantity (hidden during blind counts)
+	ExpectedQuantity *int `json:"expected_quantity,omitempty"`
+	// CountedQuantity is the amount found on the shelf, once counted
+	CountedQuantity *int `json:"counted_quantity,omitempty"`
+	// Variance is counted minus expected (hidden during blind counts)
+	Variance *int `json:"variance,omitempty"`
+	// CountedBy is who last counted the stock item
+	CountedBy string `json:"counted_by,omitempty"`
+	// CountedAt is when the stock item was last counted
+	CountedAt *time.Time `json:"counted_at,omitempty"`
+	// Approved indicates the variance was approved for posting
+	Approved bool `json:"approved"`
+	// MovementID is the adjustment movement that posted the variance
+	MovementID string `json:"movement_id,omitempty"`
+}
+
+// CountSessionResponse represents a count session in API responses.
+// @Description Count session information returned by the API
+type CountSessionResponse struct {
+	// ID is the unique count session identifier
+	ID string `json:"id"`
+	// WarehouseID is the counted warehouse
+	WarehouseID string `json:"warehouse_id"`
+	// Blind indicates expected quantities are hidden until the count is submitted
+	Blind bool `json:"blind"`
+	// Freeze indicates on-hand changes to the counted stock items are refused
+	Freeze bool `json:"freeze"`
+	// Status is the count status (open, submitted, posted, cancelled)
+	Status string `json:"status"`
+	// Lines are the counted stock items
+	Lines []CountLineResponse `json:"lines"`
+	// CountedLines is the number of stock items counted so far
+	CountedLines int `json:"counted_lines"`
+	// TotalVariance is the sum of all variances (hidden during blind counts)
+	TotalVariance *int `json:"total_variance,omitempty"`
+	// Notes are the count notes
+	Notes string `json:"notes,omitempty"`
+	// CreatedBy is who opened the count
+	CreatedBy string `json:"created_by,omitempty"`
+	// ReviewedBy is who approved the variances
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// CreatedAt is when the count was opened
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the count was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+	// SubmittedAt is when counting ended
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// PostedAt is when the approved variances were posted
+	PostedAt *time.Time `json:"posted_at,omitempty"`
+	// FilledBackorders are the backorders found stock was reserved for (on approval only)
+	FilledBackorders []BackorderResponse `json:"filled_backorders,omitempty"`
+}
+
+// ListCountSessionsResponse represents the response for listing count sessions.
+// @Description Paginated list of count sessions
+type ListCountSessionsResponse struct {
+	// CountSessions is the list of count sessions
+	CountSessions []CountSessionResponse `json:"count_sessions"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// Count session status constants
+const (
+	CountSessionStatusOpen      = "open"
+	CountSessionStatusSubmitted = "submitted"
+	CountSessionStatusPosted    = "posted"
+	CountSessionStatusCancelled = "cancelled"
+)
@@ -0,0 +1,60 @@
+// file: internal/interfaces/http/dto/dead_letter_dto.go
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ReplayDeadLetterRequest represents the request body for replaying a dead letter.
+// @Description Request payload for sending a dead-lettered message back into processing
+type ReplayDeadLetterRequest struct {
+	// ReplayedBy is the operator replaying the message
+	ReplayedBy string `json:"replayed_by" validate:"required,max=255"`
+}
+
+// DeadLetterResponse represents a dead-lettered message in API responses.
+// @Description Consumed message that could not be processed, with its original position
+type DeadLetterResponse struct {
+	// ID is the unique dead letter identifier
+	ID string `json:"id"`
+	// Topic is the topic the message was originally consumed from
+	Topic string `json:"topic"`
+	// Partition is the original partition
+	Partition int32 `json:"partition"`
+	// Offset is the original offset
+	Offset int64 `json:"offset"`
+	// Key is the message key
+	Key string `json:"key,omitempty"`
+	// Headers are the original message headers
+	Headers map[string]string `json:"headers"`
+	// Payload is the message value when it is valid JSON
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// PayloadBase64 is the message value when it is not valid JSON
+	PayloadBase64 []byte `json:"payload_base64,omitempty"`
+	// MessageTimestamp is when the message was originally produced
+	MessageTimestamp *time.Time `json:"message_timestamp,omitempty"`
+	// Reason is the error that caused the message to be dead-lettered
+	Reason string `json:"reason"`
+	// Attempts is the number of delivery attempts made
+	Attempts int `json:"attempts"`
+	// Status is QUARANTINED or REPLAYED
+	Status string `json:"status"`
+	// DeadLetteredAt is when the message was last dead-lettered
+	DeadLetteredAt time.Time `json:"dead_lettered_at"`
+	// ReplayCount is how often the message was replayed
+	ReplayCount int `json:"replay_count"`
+	// ReplayedAt is when the message was last replayed
+	ReplayedAt *time.Time `json:"replayed_at,omitempty"`
+	// ReplayedBy is who last replayed the message
+	ReplayedBy string `json:"replayed_by,omitempty"`
+}
+
+// ListDeadLettersResponse represents the response for listing dead letters.
+// @Description Paginated list of dead-lettered messages
+type ListDeadLettersResponse struct {
+	// DeadLetters is the list of dead letters
+	DeadLetters []DeadLetterResponse `json:"dead_letters"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
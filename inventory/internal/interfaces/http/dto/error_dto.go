@@ -39,6 +39,7 @@ const (
 	ErrCodeConflict         = "CONFLICT"
 	ErrCodeInsufficientStock = "INSUFFICIENT_STOCK"
 	ErrCodeInvalidState     = "INVALID_STATE"
+	ErrCodeConcurrentModification = "CONCURRENT_MODIFICATION"
 	ErrCodeInternal         = "INTERNAL_ERROR"
 	ErrCodeUnauthorized     = "UNAUTHORIZED"
 	ErrCodeForbidden        = "FORBIDDEN"
@@ -0,0 +1,159 @@
+// file: internal/interfaces/http/dto/location_dto.go
+package dto
+
+import "time"
+
+// CreateLocationRequest represents the request body for creating a warehouse location.
+// @Description Request payload for adding a zone, aisle, rack or bin to a warehouse
+type CreateLocationRequest struct {
+	// WarehouseID is the warehouse the location is in
+	WarehouseID string `json:"warehouse_id" validate:"required,uuid"`
+	// ParentID is the location of the level above; omitted for zones
+	ParentID string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+	// Type is the level of the location
+	Type string `json:"type" validate:"required,oneof=zone aisle rack bin"`
+	// Code identifies the location among its siblings; it is appended to the parent's path
+	Code string `json:"code" validate:"required,min=1,max=50,excludes=-"`
+	// Capacity is how many units a bin holds across all stock items; 0 or omitted means unlimited
+	Capacity int `json:"capacity,omitempty" validate:"min=0"`
+}
+
+// LocationResponse represents a warehouse location in API responses.
+// @Description Zone, aisle, rack or bin of a warehouse
+type LocationResponse struct {
+	// ID is the unique location identifier
+	ID string `json:"id"`
+	// WarehouseID is the warehouse the location is in
+	WarehouseID string `json:"warehouse_id"`
+	// ParentID is the location of the level above (empty for zones)
+	ParentID string `json:"parent_id,omitempty"`
+	// Type is the level of the location
+	Type string `json:"type"`
+	// Code identifies the location among its siblings
+	Code string `json:"code"`
+	// Path is the codes of the location and its ancestors, e.g. "A-03-R2-B05"
+	Path string `json:"path"`
+	// Capacity is how many units a bin holds (0 means unlimited)
+	Capacity int `json:"capacity"`
+	// QuantityStored is how many units a bin holds now
+	QuantityStored int `json:"quantity_stored"`
+	// FreeCapacity is how many more units a bin holds (omitted when unlimited)
+	FreeCapacity *int `json:"free_capacity,omitempty"`
+	// Contents are the stock balances held in a bin (single bin lookups only)
+	Contents []BinStockResponse `json:"contents,omitempty"`
+	// CreatedAt is when the location was created
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the location was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListLocationsResponse represents the response for listing locations.
+// @Description Paginated list of locations, ordered by path
+type ListLocationsResponse struct {
+	// Locations is the list of locations
+	Locations []LocationResponse `json:"locations"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// BinStockResponse represents the stock of a stock item in one bin.
+// @Description Bin balance of a stock item
+type BinStockResponse struct {
+	// StockItemID is the stock item stored
+	StockItemID string `json:"stock_item_id"`
+	// LocationID is the bin
+	LocationID string `json:"location_id"`
+	// Path is the path of the bin
+	Path string `json:"path"`
+	// Quantity is the on-hand quantity stored in the bin
+	Quantity int `json:"quantity"`
+	// UpdatedAt is when the balance last changed
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StockItemBinsResponse represents the stock of a stock item split across its bins.
+// @Description Bin balances of a stock item, in pick order
+type StockItemBinsResponse struct {
+	// StockItemID is the stock item
+	StockItemID string `json:"stock_item_id"`
+	// Quantity is the item's on-hand quantity
+	Quantity int `json:"quantity"`
+	// UnbinnedQuantity is the on-hand quantity that has not been put away to a bin
+	UnbinnedQuantity int `json:"unbinned_quantity"`
+	// Bins are the bins holding the item, in pick order
+	Bins []BinStockResponse `json:"bins"`
+}
+
+// MoveBinStockRequest represents the request body for moving stock between bins.
+// @Description Request payload for a bin-to-bin move of a stock item
+type MoveBinStockRequest struct {
+	// StockItemID is the stock item to move
+	StockItemID string `json:"stock_item_id" validate:"required,uuid"`
+	// FromLocationID is the bin to move from; omitted to put away stock that is in no bin
+	FromLocationID string `json:"from_location_id,omitempty" validate:"omitempty,uuid"`
+	// ToLocationID is the bin to move to
+	ToLocationID string `json:"to_location_id" validate:"required,uuid"`
+	// Quantity is the amount to move
+	Quantity int `json:"quantity" validate:"required,min=1"`
+	// Reason explains the move
+	Reason string `json:"reason,omitempty" validate:"max=1000"`
+}
+
+// BinPickResponse represents a quantity taken from, or put into, one bin.
+// @Description Bin a quantity of a stock item is picked from or put away to
+type BinPickResponse struct {
+	// StockItemID is the stock item
+	StockItemID string `json:"stock_item_id"`
+	// LocationID is the bin (omitted for stock that is in no bin)
+	LocationID string `json:"location_id,omitempty"`
+	// Path is the path of the bin (omitted for stock that is in no bin)
+	Path string `json:"path,omitempty"`
+	// Quantity is the quantity picked or put away
+	Quantity int `json:"quantity"`
+}
+
+// BinMovementResponse represents a bin movement in API responses.
+// @Description The part of a stock movement that changed a bin balance
+type BinMovementResponse struct {
+	// ID is the unique bin movement identifier
+	ID string `json:"id"`
+	// LocationID is the affected bin
+	LocationID string `json:"location_id"`
+	// StockItemID is the stock item moved
+	StockItemID string `json:"stock_item_id"`
+	// MovementID is the stock movement the bin movement is part of
+	MovementID string `json:"movement_id"`
+	// MovementType is the type of movement
+	MovementType string `json:"movement_type"`
+	// Quantity is the quantity changed (positive for in, negative for out)
+	Quantity int `json:"quantity"`
+	// QuantityBefore is the item's quantity in the bin before the movement
+	QuantityBefore int `json:"quantity_before"`
+	// QuantityAfter is the item's quantity in the bin after the movement
+	QuantityAfter int `json:"quantity_after"`
+	// ReferenceType is the type of reference
+	ReferenceType string `json:"reference_type"`
+	// ReferenceID is the reference identifier
+	ReferenceID string `json:"reference_id"`
+	// PerformedBy is who performed the movement
+	PerformedBy string `json:"performed_by"`
+	// CreatedAt is when the movement occurred
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListBinMovementsResponse represents the response for listing bin movements.
+// @Description Paginated list of the movements of a bin
+type ListBinMovementsResponse struct {
+	// Movements is the list of bin movements
+	Movements []BinMovementResponse `json:"movements"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// Location type constants
+const (
+	LocationTypeZone  = "zone"
+	LocationTypeAisle = "aisle"
+	LocationTypeRack  = "rack"
+	LocationTypeBin   = "bin"
+)
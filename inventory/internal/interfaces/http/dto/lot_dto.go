@@ -0,0 +1,78 @@
+// file: internal/interfaces/http/dto/lot_dto.go
+package dto
+
+import "time"
+
+// LotResponse represents a lot in API responses.
+// @Description Lot balance of a stock item
+type LotResponse struct {
+	// ID is the unique lot identifier
+	ID string `json:"id"`
+	// StockItemID is the stock item the lot belongs to
+	StockItemID string `json:"stock_item_id"`
+	// LotNumber is the lot or batch number
+	LotNumber string `json:"lot_number"`
+	// ManufacturedAt is when the lot was manufactured
+	ManufacturedAt *time.Time `json:"manufactured_at,omitempty"`
+	// ExpiresAt is when the lot expires
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Expired indicates the lot has expired and cannot be reserved
+	Expired bool `json:"expired"`
+	// Quantity is the lot's on-hand quantity
+	Quantity int `json:"quantity"`
+	// ReservedQuantity is the lot's reserved quantity
+	ReservedQuantity int `json:"reserved_quantity"`
+	// AvailableQuantity is Quantity minus ReservedQuantity
+	AvailableQuantity int `json:"available_quantity"`
+	// CreatedAt is when the lot was first received
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the lot was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListLotsResponse represents the response for listing lots.
+// @Description Paginated list of lots, first expiring first
+type ListLotsResponse struct {
+	// Lots is the list of lots
+	Lots []LotResponse `json:"lots"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// LotMovementResponse represents a lot movement in API responses.
+// @Description The part of a stock movement that changed a lot's balance
+type LotMovementResponse struct {
+	// ID is the unique lot movement identifier
+	ID string `json:"id"`
+	// LotID is the affected lot
+	LotID string `json:"lot_id"`
+	// StockItemID is the stock item the lot belongs to
+	StockItemID string `json:"stock_item_id"`
+	// MovementID is the stock movement the lot movement is part of
+	MovementID string `json:"movement_id"`
+	// MovementType is the type of movement
+	MovementType string `json:"movement_type"`
+	// Quantity is the quantity changed (positive for in, negative for out)
+	Quantity int `json:"quantity"`
+	// QuantityBefore is the lot quantity before the movement
+	QuantityBefore int `json:"quantity_before"`
+	// QuantityAfter is the lot quantity after the movement
+	QuantityAfter int `json:"quantity_after"`
+	// ReferenceType is the type of reference
+	ReferenceType string `json:"reference_type"`
+	// ReferenceID is the reference identifier
+	ReferenceID string `json:"reference_id"`
+	// PerformedBy is who performed the movement
+	PerformedBy string `json:"performed_by"`
+	// CreatedAt is when the movement occurred
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListLotMovementsResponse represents the response for listing lot movements.
+// @Description Paginated list of the movements of a lot
+type ListLotMovementsResponse struct {
+	// Movements is the list of lot movements
+	Movements []LotMovementResponse `json:"movements"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
@@ -4,7 +4,10 @@ package dto
 import "time"
 
 // ProductVariant represents a product variant (size, color combination).
+// @Description Variant of a product; also the request payload for adding one
 type ProductVariant struct {
+	// ID is the variant's product identifier (set in responses only)
+	ID string `json:"id,omitempty"`
 	// Size of the product variant (e.g., "S", "M", "L", "XL")
 	Size string `json:"size,omitempty"`
 	// Color of the product variant (e.g., "Red", "Blue")
@@ -13,6 +16,15 @@ type ProductVariant struct {
 	SKU string `json:"sku" validate:"required,min=1,max=100"`
 }
 
+// KitComponent is a product that goes into a kit and how many of it one kit takes.
+// @Description Component of a kit
+type KitComponent struct {
+	// ProductID is the component product; a variant for products with variants
+	ProductID string `json:"product_id" validate:"required"`
+	// Quantity is how many of the component one kit takes
+	Quantity int `json:"quantity" validate:"required,min=1"`
+}
+
 // CreateProductRequest represents the request body for creating a product.
 // @Description Request payload for creating a new product
 type CreateProductRequest struct {
@@ -20,14 +32,23 @@ type CreateProductRequest struct {
 	Name string `json:"name" validate:"required,min=1,max=255"`
 	// Description is the product description
 	Description string `json:"description,omitempty" validate:"max=2000"`
-	// BaseSKU is the base SKU for the product (variants will extend this)
+	// BaseSKU is the SKU of the base product; each variant has a SKU of its own
 	BaseSKU string `json:"base_sku" validate:"required,min=1,max=100"`
 	// Category is the product category
 	Category string `json:"category,omitempty" validate:"max=100"`
-	// Variants are the product variants (size, color combinations)
+	// Variants are the product variants (size, color combinations); they are stocked and
+	// reserved by their own SKU
 	Variants []ProductVariant `json:"variants,omitempty" validate:"dive"`
+	// Components make the product a kit, sold as a bundle of these products. A kit is not
+	// stocked itself; reserving it reserves its components.
+	Components []KitComponent `json:"components,omitempty" validate:"dive"`
+	// BaseUnit is the unit of measure the product is stocked in; "EA" when omitted
+	BaseUnit string `json:"base_unit,omitempty" validate:"max=20"`
 	// LowStockThreshold is the quantity below which low-stock alerts trigger
 	LowStockThreshold int `json:"low_stock_threshold" validate:"min=0"`
+	// Serialized tracks the product's stock unit by unit; serial numbers are then required
+	// to replenish and ship it. It cannot be changed later.
+	Serialized bool `json:"serialized"`
 	// Metadata contains additional product attributes
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
@@ -56,15 +77,25 @@ type ProductResponse struct {
 	Name string `json:"name"`
 	// Description is the product description
 	Description string `json:"description,omitempty"`
-	// BaseSKU is the base SKU for the product
+	// BaseSKU is the base SKU for the product (the variant's own SKU for a variant)
 	BaseSKU string `json:"base_sku"`
+	// ParentID is the base product of a variant
+	ParentID string `json:"parent_id,omitempty"`
 	// Category is the product category
 	Category string `json:"category,omitempty"`
-	// Variants are the product variants
+	// Variants are the product variants (the variant itself for a variant)
 	Variants []ProductVariant `json:"variants,omitempty"`
+	// Components are the products a kit is made of
+	Components []KitComponent `json:"components,omitempty"`
+	// BaseUnit is the unit of measure stock quantities of the product are in
+	BaseUnit string `json:"base_unit"`
 	// LowStockThreshold is the quantity below which low-stock alerts trigger
 	LowStockThreshold int `json:"low_stock_threshold"`
-	// TotalStock is the aggregated stock across all warehouses
+	// Serialized indicates stock is tracked unit by unit by serial number
+	Serialized bool `json:"serialized"`
+	// IsKit indicates a kit, whose stock is the kits its components' stock makes up
+	IsKit bool `json:"is_kit"`
+	// TotalStock is the aggregated stock across all warehouses and variants
 	TotalStock int `json:"total_stock"`
 	// TotalReserved is the aggregated reserved quantity
 	TotalReserved int `json:"total_reserved"`
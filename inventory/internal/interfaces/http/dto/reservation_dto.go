@@ -7,10 +7,12 @@ import "time"
 type ReservationItem struct {
 	// ProductID is the product to reserve
 	ProductID string `json:"product_id" validate:"required,uuid"`
-	// VariantSKU is the specific variant SKU (optional)
+	// VariantSKU is the variant of the product to reserve; required when the product has variants
 	VariantSKU string `json:"variant_sku,omitempty" validate:"max=100"`
 	// Quantity is the amount to reserve
 	Quantity int `json:"quantity" validate:"required,min=1"`
+	// Unit is the unit of measure of Quantity; the product's base unit when omitted
+	Unit string `json:"unit,omitempty" validate:"max=20"`
 	// PreferredWarehouseID is the preferred warehouse (optional)
 	PreferredWarehouseID string `json:"preferred_warehouse_id,omitempty" validate:"omitempty,uuid"`
 }
@@ -26,6 +28,19 @@ type CreateReservationRequest struct {
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Metadata contains additional reservation context
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Policy decides what happens to lines that cannot be reserved in full:
+	// all_or_nothing (default), partial_allowed or backorder_remainder
+	Policy string `json:"policy,omitempty" validate:"omitempty,oneof=all_or_nothing partial_allowed backorder_remainder"`
+	// BackorderPriority ranks the backorders of this request in the backorder queue
+	// when it is priority ordered; higher is served first (optional)
+	BackorderPriority int `json:"backorder_priority,omitempty"`
+	// AllocationStrategy picks the warehouses stock is drawn from: preferred-first (default),
+	// priority, fewest-splits or nearest
+	AllocationStrategy string `json:"allocation_strategy,omitempty" validate:"max=50"`
+	// AllowSplit allows a line to be split across warehouses (default true)
+	AllowSplit *bool `json:"allow_split,omitempty"`
+	// ShipTo is the destination address used by the nearest strategy (optional)
+	ShipTo *WarehouseAddress `json:"ship_to,omitempty"`
 }
 
 // ReservationItemResponse represents a reserved item in the response.
@@ -38,12 +53,34 @@ type ReservationItemResponse struct {
 	VariantSKU string `json:"variant_sku,omitempty"`
 	// Quantity is the reserved amount
 	Quantity int `json:"quantity"`
+	// ReleasedQuantity is the part of Quantity returned to available stock
+	ReleasedQuantity int `json:"released_quantity"`
+	// FulfilledQuantity is the part of Quantity that has shipped
+	FulfilledQuantity int `json:"fulfilled_quantity"`
 	// WarehouseID is where the stock is reserved
 	WarehouseID string `json:"warehouse_id"`
 	// WarehouseName is the warehouse name
 	WarehouseName string `json:"warehouse_name"`
 	// StockItemID is the specific stock item
 	StockItemID string `json:"stock_item_id"`
+	// KitID is the kit the line reserves a component of
+	KitID string `json:"kit_id,omitempty"`
+	// AllocationReason explains why the item was drawn from this warehouse
+	AllocationReason string `json:"allocation_reason,omitempty"`
+}
+
+// AllocationResponse explains how one requested line was allocated.
+type AllocationResponse struct {
+	// ProductID is the requested product
+	ProductID string `json:"product_id"`
+	// Requested is the requested quantity
+	Requested int `json:"requested"`
+	// Allocated is the quantity drawn from warehouses
+	Allocated int `json:"allocated"`
+	// Strategy is the allocation strategy used
+	Strategy string `json:"strategy"`
+	// Explanation summarizes the allocation decision
+	Explanation string `json:"explanation"`
 }
 
 // ReservationResponse represents a reservation in API responses.
@@ -53,10 +90,23 @@ type ReservationResponse struct {
 	ID string `json:"id"`
 	// OrderID is the external order identifier
 	OrderID string `json:"order_id"`
-	// Status is the reservation status (pending, confirmed, released, fulfilled, expired)
+	// Status is the reservation status (pending, confirmed, partially_released,
+	// partially_fulfilled, released, fulfilled, expired),
+	// or backordered when nothing could be reserved and every line was backordered
 	Status string `json:"status"`
 	// Items are the reserved items
 	Items []ReservationItemResponse `json:"items"`
+	// Allocations explain how each requested line was allocated (on creation only)
+	Allocations []AllocationResponse `json:"allocations,omitempty"`
+	// FailedItems are the requested lines that were not reserved in full (on creation only)
+	FailedItems []FailedReservationItem `json:"failed_items,omitempty"`
+	// Backorders queue the unreserved remainder of failed lines (on creation only)
+	Backorders []BackorderResponse `json:"backorders,omitempty"`
+	// Shipments are the recorded shipments that fulfilled part of the reservation
+	Shipments []ShipmentResponse `json:"shipments,omitempty"`
+	// Picks are the bins to pick the held stock from (pick lists), or that the shipped stock
+	// was picked from (on fulfillment); stock in no bin is listed without one
+	Picks []BinPickResponse `json:"picks,omitempty"`
 	// ExpiresAt is when the reservation expires
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Metadata contains additional reservation context
@@ -67,6 +117,20 @@ type ReservationResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// FailedReservationItem describes a requested line that was not reserved in full.
+type FailedReservationItem struct {
+	// ProductID is the requested product
+	ProductID string `json:"product_id"`
+	// SKU is the product SKU
+	SKU string `json:"sku"`
+	// RequestedQuantity is the requested amount
+	RequestedQuantity int `json:"requested_quantity"`
+	// ReservedQuantity is the amount that was reserved
+	ReservedQuantity int `json:"reserved_quantity"`
+	// AvailableQuantity is the amount that was available to the line
+	AvailableQuantity int `json:"available_quantity"`
+}
+
 // ReleaseReservationRequest represents the request body for releasing a reservation.
 // @Description Request payload for releasing reserved stock
 type ReleaseReservationRequest struct {
@@ -86,17 +150,60 @@ type PartialReleaseItem struct {
 	Quantity int `json:"quantity" validate:"required,min=1"`
 }
 
+// ExtendReservationRequest represents the request body for extending a reservation.
+// With neither field set the hold is renewed for the default reservation TTL from now.
+// @Description Request payload for extending the hold of a reservation
+type ExtendReservationRequest struct {
+	// ExpiresAt is the new expiry (optional)
+	ExpiresAt *time.Time `json:"expires_at,omitempty" validate:"excluded_with=ExtendBySeconds"`
+	// ExtendBySeconds is added to the current expiry (optional)
+	ExtendBySeconds int `json:"extend_by_seconds,omitempty" validate:"omitempty,min=1,max=86400"`
+}
+
 // FulfillReservationRequest represents the request body for fulfilling a reservation.
 // @Description Request payload for fulfilling a reservation (decrementing stock)
 type FulfillReservationRequest struct {
-	// ShipmentID is the external shipment identifier (optional)
-	ShipmentID string `json:"shipment_id,omitempty" validate:"max=100"`
+	// ShipmentID is the external shipment identifier. Required to ship part of the
+	// reservation; a shipment ID is only ever applied once per reservation.
+	ShipmentID string `json:"shipment_id,omitempty" validate:"required_with=Items,max=100"`
+	// Items are the quantities in the shipment (optional, defaults to everything still reserved)
+	Items []ShipmentItem `json:"items,omitempty" validate:"omitempty,dive"`
 	// FulfilledBy is the user or system that fulfilled the reservation
 	FulfilledBy string `json:"fulfilled_by" validate:"required,max=255"`
 	// Notes contains any fulfillment notes
 	Notes string `json:"notes,omitempty" validate:"max=1000"`
 }
 
+// ShipmentItem specifies a shipped quantity of a product.
+type ShipmentItem struct {
+	// ProductID is the shipped product
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	// Quantity is the amount shipped
+	Quantity int `json:"quantity" validate:"required,min=1"`
+	// SerialNumbers name the shipped units, one per unit; required for serialized products
+	SerialNumbers []string `json:"serial_numbers,omitempty" validate:"omitempty,unique,dive,required,max=100"`
+}
+
+// ShipmentResponse represents a recorded shipment of a reservation.
+type ShipmentResponse struct {
+	// ID is the external shipment identifier
+	ID string `json:"id"`
+	// Items are the shipped quantities per reserved item
+	Items []ShipmentItemResponse `json:"items"`
+	// ShippedAt is when the shipment was recorded
+	ShippedAt time.Time `json:"shipped_at"`
+}
+
+// ShipmentItemResponse is the quantity a shipment took from one reserved item.
+type ShipmentItemResponse struct {
+	// ProductID is the shipped product
+	ProductID string `json:"product_id"`
+	// StockItemID is the stock item the quantity was shipped from
+	StockItemID string `json:"stock_item_id"`
+	// Quantity is the amount shipped
+	Quantity int `json:"quantity"`
+}
+
 // ListReservationsRequest represents query parameters for listing reservations.
 type ListReservationsRequest struct {
 	PaginationRequest
@@ -119,9 +226,12 @@ type ListReservationsResponse struct {
 
 // Reservation status constants
 const (
-	ReservationStatusPending   = "pending"
-	ReservationStatusConfirmed = "confirmed"
-	ReservationStatusReleased  = "released"
-	ReservationStatusFulfilled = "fulfilled"
-	ReservationStatusExpired   = "expired"
+	ReservationStatusPending            = "pending"
+	ReservationStatusConfirmed          = "confirmed"
+	ReservationStatusPartiallyReleased  = "partially_released"
+	ReservationStatusPartiallyFulfilled = "partially_fulfilled"
+	ReservationStatusReleased           = "released"
+	ReservationStatusFulfilled          = "fulfilled"
+	ReservationStatusExpired            = "expired"
+	ReservationStatusBackordered        = "backordered"
 )
\ No newline at end of file
@@ -0,0 +1,76 @@
+// file: internal/interfaces/http/dto/serial_unit_dto.go
+package dto
+
+import "time"
+
+// ScrapSerialUnitRequest represents the request body for writing a serial unit off.
+// @Description Request payload for scrapping an unreserved serial unit in stock
+type ScrapSerialUnitRequest struct {
+	// Reason explains why the unit is scrapped
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// SerialMovementResponse represents one status change of a serial unit.
+type SerialMovementResponse struct {
+	// ID is the unique serial movement identifier
+	ID string `json:"id"`
+	// StockItemID is the stock item holding the unit at the time
+	StockItemID string `json:"stock_item_id"`
+	// MovementID is the stock movement the change is part of
+	MovementID string `json:"movement_id"`
+	// MovementType is the type of the stock movement
+	MovementType string `json:"movement_type"`
+	// FromStatus is the status before the change (empty when first received)
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus is the status after the change
+	ToStatus string `json:"to_status"`
+	// ReferenceType is the type of reference
+	ReferenceType string `json:"reference_type"`
+	// ReferenceID is the reference identifier
+	ReferenceID string `json:"reference_id"`
+	// PerformedBy is who performed the movement
+	PerformedBy string `json:"performed_by"`
+	// CreatedAt is when the change happened
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SerialUnitResponse represents a serial unit in API responses.
+// @Description Serialized unit of a product and, when looked up by ID, its full history
+type SerialUnitResponse struct {
+	// ID is the unique serial unit identifier
+	ID string `json:"id"`
+	// ProductID is the product of the unit
+	ProductID string `json:"product_id"`
+	// SerialNumber is the unit's serial number
+	SerialNumber string `json:"serial_number"`
+	// StockItemID is the stock item holding the unit, or that last held it
+	StockItemID string `json:"stock_item_id"`
+	// Status is the unit status (available, reserved, shipped, returned, scrapped)
+	Status string `json:"status"`
+	// ReservationID is the reservation holding the unit while it is reserved
+	ReservationID string `json:"reservation_id,omitempty"`
+	// CreatedAt is when the unit was first received
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the unit last changed
+	UpdatedAt time.Time `json:"updated_at"`
+	// Movements is the unit's history, oldest first (on lookup by ID only)
+	Movements []SerialMovementResponse `json:"movements,omitempty"`
+}
+
+// ListSerialUnitsResponse represents the response for listing serial units.
+// @Description Paginated list of serial units
+type ListSerialUnitsResponse struct {
+	// SerialUnits is the list of serial units
+	SerialUnits []SerialUnitResponse `json:"serial_units"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// Serial unit status constants
+const (
+	SerialStatusAvailable = "available"
+	SerialStatusReserved  = "reserved"
+	SerialStatusShipped   = "shipped"
+	SerialStatusReturned  = "returned"
+	SerialStatusScrapped  = "scrapped"
+)
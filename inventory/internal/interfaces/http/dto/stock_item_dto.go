@@ -10,7 +10,7 @@ type CreateStockItemRequest struct {
 	ProductID string `json:"product_id" validate:"required,uuid"`
 	// WarehouseID is the ID of the warehouse
 	WarehouseID string `json:"warehouse_id" validate:"required,uuid"`
-	// VariantSKU is the SKU of the specific variant (optional)
+	// VariantSKU is the SKU of the variant to stock; required when the product has variants
 	VariantSKU string `json:"variant_sku,omitempty" validate:"max=100"`
 	// Quantity is the initial stock quantity
 	Quantity int `json:"quantity" validate:"min=0"`
@@ -18,7 +18,8 @@ type CreateStockItemRequest struct {
 	ReorderPoint int `json:"reorder_point" validate:"min=0"`
 	// ReorderQuantity is the quantity to order when reordering
 	ReorderQuantity int `json:"reorder_quantity" validate:"min=0"`
-	// BinLocation is the physical location within the warehouse
+	// BinLocation is the path of the item's home bin in the warehouse (e.g. "A-03-R2-B05");
+	// replenishments are put away to it by default
 	BinLocation string `json:"bin_location,omitempty" validate:"max=100"`
 }
 
@@ -43,14 +44,20 @@ type StockItemResponse struct {
 	ReservedQuantity int `json:"reserved_quantity"`
 	// AvailableQuantity is Quantity minus ReservedQuantity
 	AvailableQuantity int `json:"available_quantity"`
+	// ExpiredQuantity is the available quantity in expired lots, which cannot be reserved
+	ExpiredQuantity int `json:"expired_quantity"`
+	// ReservableQuantity is AvailableQuantity minus ExpiredQuantity
+	ReservableQuantity int `json:"reservable_quantity"`
 	// ReorderPoint is the quantity at which to trigger reorder
 	ReorderPoint int `json:"reorder_point"`
 	// ReorderQuantity is the quantity to order when reordering
 	ReorderQuantity int `json:"reorder_quantity"`
-	// BinLocation is the physical location within the warehouse
+	// BinLocation is the path of the item's home bin in the warehouse
 	BinLocation string `json:"bin_location,omitempty"`
 	// IsLowStock indicates if current quantity is below threshold
 	IsLowStock bool `json:"is_low_stock"`
+	// FrozenBy is the count session freezing on-hand changes, if any
+	FrozenBy string `json:"frozen_by,omitempty"`
 	// CreatedAt is when the stock item was created
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is when the stock item was last updated
@@ -92,11 +99,14 @@ type AggregatedStockResponse struct {
 	TotalReserved int `json:"total_reserved"`
 	// TotalAvailable is TotalQuantity minus TotalReserved
 	TotalAvailable int `json:"total_available"`
+	// TotalInTransit is stock dispatched by transfers and not received yet; it is
+	// counted in no warehouse
+	TotalInTransit int `json:"total_in_transit"`
 	// IsLowStock indicates if total stock is below threshold
 	IsLowStock bool `json:"is_low_stock"`
 	// WarehouseBreakdown shows stock per warehouse
 	WarehouseBreakdown []WarehouseStockBreakdown `json:"warehouse_breakdown"`
-	// VariantBreakdown shows stock per variant
+	// VariantBreakdown shows stock per variant of a product with variants
 	VariantBreakdown []VariantStockBreakdown `json:"variant_breakdown,omitempty"`
 }
 
@@ -116,6 +126,8 @@ type WarehouseStockBreakdown struct {
 
 // VariantStockBreakdown shows stock for a specific variant.
 type VariantStockBreakdown struct {
+	// ProductID is the variant's product identifier
+	ProductID string `json:"product_id"`
 	// VariantSKU is the variant SKU
 	VariantSKU string `json:"variant_sku"`
 	// Size is the variant size
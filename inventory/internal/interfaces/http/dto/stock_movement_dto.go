@@ -10,6 +10,8 @@ type ReplenishStockRequest struct {
 	StockItemID string `json:"stock_item_id" validate:"required,uuid"`
 	// Quantity is the amount to add
 	Quantity int `json:"quantity" validate:"required,min=1"`
+	// Unit is the unit of measure of Quantity (e.g., "CASE"); the product's base unit when omitted
+	Unit string `json:"unit,omitempty" validate:"max=20"`
 	// ReferenceType is the type of reference (e.g., "purchase_order", "transfer", "adjustment")
 	ReferenceType string `json:"reference_type" validate:"required,oneof=purchase_order transfer adjustment return"`
 	// ReferenceID is the external reference identifier
@@ -20,6 +22,23 @@ type ReplenishStockRequest struct {
 	Notes string `json:"notes,omitempty" validate:"max=1000"`
 	// PerformedBy is the user who performed the replenishment
 	PerformedBy string `json:"performed_by" validate:"required,max=255"`
+	// Lot is the lot the stock was received in; the stock is untracked when omitted
+	Lot *LotReceipt `json:"lot,omitempty" validate:"omitempty"`
+	// SerialNumbers name the received units, one per unit; required for serialized products
+	SerialNumbers []string `json:"serial_numbers,omitempty" validate:"omitempty,unique,dive,required,max=100"`
+	// BinLocationID is the bin to put the stock away to; by default it goes to the item's home
+	// bin and the bins already holding it as far as their capacity goes
+	BinLocationID string `json:"bin_location_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// LotReceipt identifies the lot replenished stock was received in.
+type LotReceipt struct {
+	// LotNumber is the supplier's lot or batch number; a new number creates a lot
+	LotNumber string `json:"lot_number" validate:"required,min=1,max=100"`
+	// ManufacturedAt is when the lot was manufactured (optional)
+	ManufacturedAt *time.Time `json:"manufactured_at,omitempty"`
+	// ExpiresAt is when the lot expires; lots without an expiry date never expire
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 // StockMovementResponse represents a stock movement in API responses.
@@ -59,6 +78,12 @@ type StockMovementResponse struct {
 	PerformedBy string `json:"performed_by"`
 	// CreatedAt is when the movement occurred
 	CreatedAt time.Time `json:"created_at"`
+	// Lot is the lot the stock was received in (on replenishment only)
+	Lot *LotResponse `json:"lot,omitempty"`
+	// Putaway are the bins the stock was put away to; stock in no bin is listed without one (on replenishment only)
+	Putaway []BinPickResponse `json:"putaway,omitempty"`
+	// FilledBackorders are the backorders replenished stock was reserved for (on replenishment only)
+	FilledBackorders []BackorderResponse `json:"filled_backorders,omitempty"`
 }
 
 // ListStockMovementsRequest represents query parameters for listing movements.
@@ -100,4 +125,5 @@ const (
 	MovementTypeAdjustment  = "adjustment"
 	MovementTypeTransferIn  = "transfer_in"
 	MovementTypeTransferOut = "transfer_out"
+	MovementTypeBinMove     = "bin_move"
 )
\ No newline at end of file
@@ -0,0 +1,107 @@
+// file: internal/interfaces/http/dto/transfer_dto.go
+package dto
+
+import "time"
+
+// TransferItem represents the quantity of one product in a transfer request.
+type TransferItem struct {
+	// ProductID is the product to move
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	// Quantity is the amount to move
+	Quantity int `json:"quantity" validate:"required,min=1"`
+}
+
+// CreateTransferRequest represents the request body for drafting a transfer.
+// @Description Request payload for moving stock from one warehouse to another
+type CreateTransferRequest struct {
+	// SourceWarehouseID is the warehouse the stock leaves
+	SourceWarehouseID string `json:"source_warehouse_id" validate:"required,uuid"`
+	// DestinationWarehouseID is the warehouse the stock goes to
+	DestinationWarehouseID string `json:"destination_warehouse_id" validate:"required,uuid,nefield=SourceWarehouseID"`
+	// Items are the products and quantities to move
+	Items []TransferItem `json:"items" validate:"required,min=1,dive"`
+	// Notes are optional transfer notes
+	Notes string `json:"notes,omitempty" validate:"max=500"`
+}
+
+// ReceivedTransferItem represents the quantity of one product counted on receipt.
+type ReceivedTransferItem struct {
+	// ProductID is the received product
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	// Quantity is the amount that arrived; zero when none did
+	Quantity *int `json:"quantity" validate:"required,min=0"`
+}
+
+// ReceiveTransferRequest represents the request body for receiving a transfer.
+// @Description Request payload for receiving a transfer; products left out arrived in full
+type ReceiveTransferRequest struct {
+	// Items are the quantities counted for products that did not arrive in full (optional)
+	Items []ReceivedTransferItem `json:"items,omitempty" validate:"omitempty,dive"`
+}
+
+// TransferItemResponse represents a transfer item in the response.
+type TransferItemResponse struct {
+	// ProductID is the moved product
+	ProductID string `json:"product_id"`
+	// SourceStockItemID is the stock item the quantity leaves
+	SourceStockItemID string `json:"source_stock_item_id"`
+	// DestinationStockItemID is the stock item the quantity is credited to
+	DestinationStockItemID string `json:"destination_stock_item_id"`
+	// Quantity is the dispatched amount
+	Quantity int `json:"quantity"`
+	// ReceivedQuantity is the amount that arrived
+	ReceivedQuantity int `json:"received_quantity"`
+	// ShortQuantity is the amount that was dispatched but never arrived
+	ShortQuantity int `json:"short_quantity"`
+}
+
+// TransferResponse represents a transfer in API responses.
+// @Description Transfer information returned by the API
+type TransferResponse struct {
+	// ID is the unique transfer identifier
+	ID string `json:"id"`
+	// SourceWarehouseID is the warehouse the stock leaves
+	SourceWarehouseID string `json:"source_warehouse_id"`
+	// DestinationWarehouseID is the warehouse the stock goes to
+	DestinationWarehouseID string `json:"destination_warehouse_id"`
+	// Status is the transfer status (draft, dispatched, in_transit, received, discrepancy)
+	Status string `json:"status"`
+	// Items are the products in the transfer
+	Items []TransferItemResponse `json:"items"`
+	// InTransitQuantity is the quantity on its way, available in neither warehouse
+	InTransitQuantity int `json:"in_transit_quantity"`
+	// ShortQuantity is the quantity that was dispatched but never arrived
+	ShortQuantity int `json:"short_quantity"`
+	// Notes are the transfer notes
+	Notes string `json:"notes,omitempty"`
+	// CreatedBy is who drafted the transfer
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt is when the transfer was drafted
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the transfer was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+	// DispatchedAt is when the transfer left the source
+	DispatchedAt *time.Time `json:"dispatched_at,omitempty"`
+	// ReceivedAt is when the destination received the transfer
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	// FilledBackorders are the backorders received stock was reserved for (on receipt only)
+	FilledBackorders []BackorderResponse `json:"filled_backorders,omitempty"`
+}
+
+// ListTransfersResponse represents the response for listing transfers.
+// @Description Paginated list of transfers
+type ListTransfersResponse struct {
+	// Transfers is the list of transfers
+	Transfers []TransferResponse `json:"transfers"`
+	// Pagination contains pagination metadata
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// Transfer status constants
+const (
+	TransferStatusDraft       = "draft"
+	TransferStatusDispatched  = "dispatched"
+	TransferStatusInTransit   = "in_transit"
+	TransferStatusReceived    = "received"
+	TransferStatusDiscrepancy = "discrepancy"
+)
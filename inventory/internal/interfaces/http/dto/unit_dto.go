@@ -0,0 +1,66 @@
+// file: internal/interfaces/http/dto/unit_dto.go
+package dto
+
+import "time"
+
+// CreateUnitRequest represents the request body for adding a unit of measure.
+// @Description Request payload for adding a unit of measure to the catalog
+type CreateUnitRequest struct {
+	// Code is the unit code (e.g., "CASE"); stored in upper case
+	Code string `json:"code" validate:"required,min=1,max=20"`
+	// Name is the unit display name
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+// UnitResponse represents a unit of measure in API responses.
+// @Description Unit of measure in the catalog
+type UnitResponse struct {
+	// Code is the unit code
+	Code string `json:"code"`
+	// Name is the unit display name
+	Name string `json:"name"`
+	// CreatedAt is when the unit was added
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListUnitsResponse represents the response for listing units of measure.
+// @Description Unit of measure catalog, ordered by code
+type ListUnitsResponse struct {
+	// Units is the list of units
+	Units []UnitResponse `json:"units"`
+}
+
+// SetUnitConversionRequest represents the request body for converting a unit of a product.
+// @Description Request payload for converting a unit into the base unit of a product:
+// Quantity of the unit are BaseQuantity base units
+type SetUnitConversionRequest struct {
+	// Quantity is the amount of the unit; 1 when omitted
+	Quantity int `json:"quantity,omitempty" validate:"min=0"`
+	// BaseQuantity is the amount of base units Quantity of the unit makes up
+	BaseQuantity int `json:"base_quantity" validate:"required,min=1"`
+}
+
+// UnitConversionResponse represents a unit conversion in API responses.
+// @Description Conversion of a unit into the base unit of a product
+type UnitConversionResponse struct {
+	// ProductID is the product the conversion is defined on; the base product for
+	// conversions a variant inherits
+	ProductID string `json:"product_id"`
+	// Unit is the converted unit code
+	Unit string `json:"unit"`
+	// Quantity is the amount of the unit
+	Quantity int `json:"quantity"`
+	// BaseQuantity is the amount of base units Quantity of the unit makes up
+	BaseQuantity int `json:"base_quantity"`
+	// CreatedAt is when the conversion was first set
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is when the conversion was last set
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListUnitConversionsResponse represents the response for listing unit conversions.
+// @Description Unit conversions that apply to a product, ordered by unit
+type ListUnitConversionsResponse struct {
+	// Conversions is the list of conversions
+	Conversions []UnitConversionResponse `json:"conversions"`
+}
@@ -0,0 +1,196 @@
+// file: internal/interfaces/http/handler/adjustment_handler.go
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
+
+// AdjustmentUseCase defines the use case operations the handler depends on.
+type AdjustmentUseCase interface {
+	ReasonCodes() []string
+	Create(ctx context.Context, in usecase.CreateAdjustmentInput) (*usecase.AdjustmentDetails, error)
+	GetByID(ctx context.Context, id string) (*entity.Adjustment, error)
+	List(ctx context.Context, filter repository.AdjustmentFilter) ([]*entity.Adjustment, int, error)
+	Approve(ctx context.Context, id string, in usecase.ReviewAdjustmentInput) (*usecase.AdjustmentDetails, error)
+	Reject(ctx context.Context, id string, in usecase.ReviewAdjustmentInput) (*entity.Adjustment, error)
+}
+
+// AdjustmentHandler handles HTTP requests for the /api/v1/adjustments resource.
+type AdjustmentHandler struct {
+	useCase AdjustmentUseCase
+}
+
+// NewAdjustmentHandler constructs an AdjustmentHandler with its use case dependency.
+func NewAdjustmentHandler(uc AdjustmentUseCase) *AdjustmentHandler {
+	return &AdjustmentHandler{useCase: uc}
+}
+
+// Create handles POST /api/v1/adjustments
+func (h *AdjustmentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateAdjustmentRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	details, err := h.useCase.Create(requestContext(r), usecase.CreateAdjustmentInput{
+		StockItemID: req.StockItemID,
+		Quantity:    req.Quantity,
+		Unit:        req.Unit,
+		ReasonCode:  req.ReasonCode,
+		Notes:       req.Notes,
+		UnitCost:    req.UnitCost,
+		PerformedBy: middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toAdjustmentDetailsResponse(details))
+}
+
+// ListReasonCodes handles GET /api/v1/adjustments/reason-codes
+func (h *AdjustmentHandler) ListReasonCodes(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, dto.AdjustmentReasonCodesResponse{ReasonCodes: h.useCase.ReasonCodes()})
+}
+
+// Get handles GET /api/v1/adjustments/{adjustmentId}
+func (h *AdjustmentHandler) Get(w http.ResponseWriter, r *http.Request) {
+	adjustmentID, ok := pathValue(w, r, "adjustmentId")
+	if !ok {
+		return
+	}
+
+	adjustment, err := h.useCase.GetByID(requestContext(r), adjustmentID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toAdjustmentResponse(adjustment))
+}
+
+// List handles GET /api/v1/adjustments
+func (h *AdjustmentHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.AdjustmentFilter{
+		StockItemID: queryString(r, "stock_item_id"),
+		Limit:       limit,
+		Offset:      offset,
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		status := entity.AdjustmentStatus(strings.ToUpper(v))
+		switch status {
+		case entity.AdjustmentStatusPendingApproval, entity.AdjustmentStatusApplied, entity.AdjustmentStatusRejected:
+		default:
+			writeError(w, r, fmt.Errorf("%w: unknown status %q", errInvalidParameter, v))
+			return
+		}
+		filter.Status = &status
+	}
+
+	adjustments, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListAdjustmentsResponse{
+		Adjustments: make([]dto.AdjustmentResponse, 0, len(adjustments)),
+		Pagination:  newPaginationResponse(page, total),
+	}
+	for _, a := range adjustments {
+		resp.Adjustments = append(resp.Adjustments, toAdjustmentResponse(a))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Approve handles POST /api/v1/adjustments/{adjustmentId}/approve
+func (h *AdjustmentHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	adjustmentID, ok := pathValue(w, r, "adjustmentId")
+	if !ok {
+		return
+	}
+
+	var req dto.ReviewAdjustmentRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	details, err := h.useCase.Approve(requestContext(r), adjustmentID, usecase.ReviewAdjustmentInput{
+		Notes:      req.Notes,
+		ReviewedBy: middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toAdjustmentDetailsResponse(details))
+}
+
+// Reject handles POST /api/v1/adjustments/{adjustmentId}/reject
+func (h *AdjustmentHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	adjustmentID, ok := pathValue(w, r, "adjustmentId")
+	if !ok {
+		return
+	}
+
+	var req dto.ReviewAdjustmentRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	adjustment, err := h.useCase.Reject(requestContext(r), adjustmentID, usecase.ReviewAdjustmentInput{
+		Notes:      req.Notes,
+		ReviewedBy: middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toAdjustmentResponse(adjustment))
+}
+
+func toAdjustmentDetailsResponse(d *usecase.AdjustmentDetails) dto.AdjustmentResponse {
+	resp := toAdjustmentResponse(d.Adjustment)
+	for _, b := range d.FilledBackorders {
+		resp.FilledBackorders = append(resp.FilledBackorders, toBackorderResponse(b))
+	}
+	return resp
+}
+
+func toAdjustmentResponse(a *entity.Adjustment) dto.AdjustmentResponse {
+	return dto.AdjustmentResponse{
+		ID:          a.ID,
+		StockItemID: a.StockItemID,
+		Quantity:    a.Quantity,
+		ReasonCode:  a.ReasonCode,
+		Notes:       a.Notes,
+		UnitCost:    a.UnitCost,
+		Status:      strings.ToLower(string(a.Status)),
+		MovementID:  a.MovementID,
+		RequestedBy: a.RequestedBy,
+		ReviewedBy:  a.ReviewedBy,
+		ReviewNotes: a.ReviewNotes,
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+		ReviewedAt:  a.ReviewedAt,
+		AppliedAt:   a.AppliedAt,
+	}
+}
@@ -1,11 +1,17 @@
 // file: internal/interfaces/http/handler/alert_handler.go
 package handler
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
 
 // AlertUseCase defines the use case operations the handler depends on.
 type AlertUseCase interface {
-	// TODO: define methods once application/usecase/ files are generated.
+	GetLowStockAlerts(ctx context.Context) ([]*usecase.LowStockAlertDetails, error)
 }
 
 // AlertHandler handles HTTP requests for the /api/v1/alerts resource.
@@ -20,6 +26,29 @@ func NewAlertHandler(uc AlertUseCase) *AlertHandler {
 
 // ListLowStock handles GET /api/v1/alerts/low-stock
 func (h *AlertHandler) ListLowStock(w http.ResponseWriter, r *http.Request) {
-	// TODO: call h.useCase.GetLowStockAlerts, encode []dto.LowStockAlertResponse
-	writeNotImplemented(w)
+	alerts, err := h.useCase.GetLowStockAlerts(requestContext(r))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]dto.LowStockAlertResponse, 0, len(alerts))
+	for _, a := range alerts {
+		resp = append(resp, dto.LowStockAlertResponse{
+			StockItemID:       a.ID,
+			ProductID:         a.ProductID,
+			ProductName:       a.ProductName,
+			SKU:               a.SKU,
+			WarehouseID:       a.WarehouseID,
+			WarehouseName:     a.WarehouseName,
+			CurrentQuantity:   a.QuantityOnHand,
+			AvailableQuantity: a.AvailableQuantity(),
+			Threshold:         a.ReorderPoint,
+			ReorderQuantity:   a.ReorderQuantity,
+			Kit:               a.Kit,
+			Severity:          string(a.Severity),
+			UpdatedAt:         a.UpdatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
@@ -0,0 +1,88 @@
+// file: internal/interfaces/http/handler/backorder_handler.go
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
+
+// BackorderUseCase defines the use case operations the handler depends on.
+type BackorderUseCase interface {
+	GetByID(ctx context.Context, id string) (*entity.Backorder, error)
+	ListByOrder(ctx context.Context, orderID string) ([]*entity.Backorder, error)
+	Reprioritize(ctx context.Context, id string, priority int) (*entity.Backorder, error)
+}
+
+// BackorderHandler handles HTTP requests for the /api/v1/backorders resource.
+type BackorderHandler struct {
+	useCase BackorderUseCase
+}
+
+// NewBackorderHandler constructs a BackorderHandler with its use case dependency.
+func NewBackorderHandler(uc BackorderUseCase) *BackorderHandler {
+	return &BackorderHandler{useCase: uc}
+}
+
+// Get handles GET /api/v1/backorders/{backorderId}
+func (h *BackorderHandler) Get(w http.ResponseWriter, r *http.Request) {
+	backorderID, ok := pathValue(w, r, "backorderId")
+	if !ok {
+		return
+	}
+
+	backorder, err := h.useCase.GetByID(requestContext(r), backorderID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toBackorderResponse(backorder))
+}
+
+// UpdatePriority handles PUT /api/v1/backorders/{backorderId}/priority
+func (h *BackorderHandler) UpdatePriority(w http.ResponseWriter, r *http.Request) {
+	backorderID, ok := pathValue(w, r, "backorderId")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateBackorderPriorityRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	backorder, err := h.useCase.Reprioritize(requestContext(r), backorderID, *req.Priority)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toBackorderResponse(backorder))
+}
+
+// ListByOrder handles GET /api/v1/orders/{orderId}/backorders
+func (h *BackorderHandler) ListByOrder(w http.ResponseWriter, r *http.Request) {
+	orderID, ok := pathValue(w, r, "orderId")
+	if !ok {
+		return
+	}
+
+	backorders, err := h.useCase.ListByOrder(requestContext(r), orderID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// All backorders of an order are returned as a single page
+	page := dto.PaginationRequest{Page: dto.DefaultPage, PageSize: max(len(backorders), 1)}
+	resp := dto.ListBackordersResponse{
+		Backorders: make([]dto.BackorderResponse, 0, len(backorders)),
+		Pagination: newPaginationResponse(page, len(backorders)),
+	}
+	for _, b := range backorders {
+		resp.Backorders = append(resp.Backorders, toBackorderResponse(b))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,254 @@
+// file: internal/interfaces/http/handler/count_session_handler.go
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
+
+// CountSessionUseCase defines the use case operations the handler depends on.
+type CountSessionUseCase interface {
+	Create(ctx context.Context, in usecase.CreateCountSessionInput) (*entity.CountSession, error)
+	GetByID(ctx context.Context, id string) (*entity.CountSession, error)
+	List(ctx context.Context, filter repository.CountSessionFilter) ([]*entity.CountSession, int, error)
+	Record(ctx context.Context, in usecase.RecordCountsInput) (*entity.CountSession, error)
+	Submit(ctx context.Context, id, performedBy string) (*entity.CountSession, error)
+	Approve(ctx context.Context, in usecase.ApproveCountSessionInput) (*usecase.CountSessionDetails, error)
+	Cancel(ctx context.Context, id string) (*entity.CountSession, error)
+}
+
+// CountSessionHandler handles HTTP requests for the /api/v1/count-sessions resource.
+type CountSessionHandler struct {
+	useCase CountSessionUseCase
+}
+
+// NewCountSessionHandler constructs a CountSessionHandler with its use case dependency.
+func NewCountSessionHandler(uc CountSessionUseCase) *CountSessionHandler {
+	return &CountSessionHandler{useCase: uc}
+}
+
+// Create handles POST /api/v1/count-sessions
+func (h *CountSessionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateCountSessionRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	session, err := h.useCase.Create(requestContext(r), usecase.CreateCountSessionInput{
+		WarehouseID:  req.WarehouseID,
+		StockItemIDs: req.StockItemIDs,
+		Blind:        req.Blind,
+		Freeze:       req.Freeze,
+		Notes:        req.Notes,
+		PerformedBy:  middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toCountSessionResponse(session))
+}
+
+// Get handles GET /api/v1/count-sessions/{countSessionId}
+func (h *CountSessionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := pathValue(w, r, "countSessionId")
+	if !ok {
+		return
+	}
+
+	session, err := h.useCase.GetByID(requestContext(r), sessionID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toCountSessionResponse(session))
+}
+
+// List handles GET /api/v1/count-sessions
+func (h *CountSessionHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.CountSessionFilter{
+		WarehouseID: queryString(r, "warehouse_id"),
+		Limit:       limit,
+		Offset:      offset,
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		status := entity.CountSessionStatus(strings.ToUpper(v))
+		switch status {
+		case entity.CountSessionStatusOpen, entity.CountSessionStatusSubmitted, entity.CountSessionStatusPosted,
+			entity.CountSessionStatusCancelled:
+		default:
+			writeError(w, r, fmt.Errorf("%w: unknown status %q", errInvalidParameter, v))
+			return
+		}
+		filter.Status = &status
+	}
+
+	sessions, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListCountSessionsResponse{
+		CountSessions: make([]dto.CountSessionResponse, 0, len(sessions)),
+		Pagination:    newPaginationResponse(page, total),
+	}
+	for _, s := range sessions {
+		resp.CountSessions = append(resp.CountSessions, toCountSessionResponse(s))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Record handles POST /api/v1/count-sessions/{countSessionId}/counts
+func (h *CountSessionHandler) Record(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := pathValue(w, r, "countSessionId")
+	if !ok {
+		return
+	}
+
+	var req dto.RecordCountsRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.RecordCountsInput{
+		CountSessionID: sessionID,
+		Counts:         make([]usecase.CountInput, 0, len(req.Counts)),
+		PerformedBy:    middleware.GetUserID(r.Context()),
+	}
+	for _, c := range req.Counts {
+		in.Counts = append(in.Counts, usecase.CountInput{StockItemID: c.StockItemID, Quantity: *c.Quantity})
+	}
+
+	session, err := h.useCase.Record(requestContext(r), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toCountSessionResponse(session))
+}
+
+// Submit handles POST /api/v1/count-sessions/{countSessionId}/submit
+func (h *CountSessionHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := pathValue(w, r, "countSessionId")
+	if !ok {
+		return
+	}
+
+	session, err := h.useCase.Submit(requestContext(r), sessionID, middleware.GetUserID(r.Context()))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toCountSessionResponse(session))
+}
+
+// Approve handles POST /api/v1/count-sessions/{countSessionId}/approve
+func (h *CountSessionHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := pathValue(w, r, "countSessionId")
+	if !ok {
+		return
+	}
+
+	var req dto.ApproveCountSessionRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	details, err := h.useCase.Approve(requestContext(r), usecase.ApproveCountSessionInput{
+		CountSessionID: sessionID,
+		StockItemIDs:   req.StockItemIDs,
+		ApprovedBy:     middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := toCountSessionResponse(details.CountSession)
+	for _, b := range details.FilledBackorders {
+		resp.FilledBackorders = append(resp.FilledBackorders, toBackorderResponse(b))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Cancel handles POST /api/v1/count-sessions/{countSessionId}/cancel
+func (h *CountSessionHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := pathValue(w, r, "countSessionId")
+	if !ok {
+		return
+	}
+
+	session, err := h.useCase.Cancel(requestContext(r), sessionID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toCountSessionResponse(session))
+}
+
+// toCountSessionResponse converts a count session, leaving out expected quantities and
+// variances while a blind count is open
+func toCountSessionResponse(s *entity.CountSession) dto.CountSessionResponse {
+	resp := dto.CountSessionResponse{
+		ID:          s.ID,
+		WarehouseID: s.WarehouseID,
+		Blind:       s.Blind,
+		Freeze:      s.Freeze,
+		Status:      strings.ToLower(string(s.Status)),
+		Lines:       make([]dto.CountLineResponse, 0, len(s.Lines)),
+		Notes:       s.Notes,
+		CreatedBy:   s.CreatedBy,
+		ReviewedBy:  s.ReviewedBy,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+		SubmittedAt: s.SubmittedAt,
+		PostedAt:    s.PostedAt,
+	}
+	hidden := s.HidesExpected()
+	if !hidden {
+		total := s.TotalVariance()
+		resp.TotalVariance = &total
+	}
+	for _, l := range s.Lines {
+		line := dto.CountLineResponse{
+			StockItemID:     l.StockItemID,
+			ProductID:       l.ProductID,
+			CountedQuantity: l.CountedQuantity,
+			CountedBy:       l.CountedBy,
+			CountedAt:       l.CountedAt,
+			Approved:        l.Approved,
+			MovementID:      l.MovementID,
+		}
+		if !hidden {
+			expected := l.ExpectedQuantity
+			line.ExpectedQuantity = &expected
+			if l.Counted() {
+				variance := l.Variance()
+				line.Variance = &variance
+			}
+		}
+		if l.Counted() {
+			resp.CountedLines++
+		}
+		resp.Lines = append(resp.Lines, line)
+	}
+	return resp
+}
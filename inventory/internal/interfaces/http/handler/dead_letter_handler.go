@@ -0,0 +1,135 @@
+// file: internal/interfaces/http/handler/dead_letter_handler.go
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/inventory-service/internal/application/port"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
+
+// DeadLetterUseCase defines the use case operations the handler depends on.
+type DeadLetterUseCase interface {
+	List(ctx context.Context, filter port.DeadLetterFilter) ([]*port.DeadLetter, int, error)
+	Get(ctx context.Context, id string) (*port.DeadLetter, error)
+	Replay(ctx context.Context, id, replayedBy string) (*port.DeadLetter, error)
+}
+
+// DeadLetterHandler handles HTTP requests for the /api/v1/admin/dead-letters resource.
+type DeadLetterHandler struct {
+	useCase DeadLetterUseCase
+}
+
+// NewDeadLetterHandler constructs a DeadLetterHandler with its use case dependency.
+func NewDeadLetterHandler(uc DeadLetterUseCase) *DeadLetterHandler {
+	return &DeadLetterHandler{useCase: uc}
+}
+
+// List handles GET /api/v1/admin/dead-letters
+func (h *DeadLetterHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := port.DeadLetterFilter{
+		Topic:  queryString(r, "topic"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		status := port.DeadLetterStatus(v)
+		if status != port.DeadLetterStatusQuarantined && status != port.DeadLetterStatusReplayed {
+			writeError(w, r, fmt.Errorf("%w: unknown status %q", errInvalidParameter, v))
+			return
+		}
+		filter.Status = &status
+	}
+
+	dls, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListDeadLettersResponse{
+		DeadLetters: make([]dto.DeadLetterResponse, 0, len(dls)),
+		Pagination:  newPaginationResponse(page, total),
+	}
+	for _, dl := range dls {
+		resp.DeadLetters = append(resp.DeadLetters, toDeadLetterResponse(dl))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Get handles GET /api/v1/admin/dead-letters/{deadLetterId}
+func (h *DeadLetterHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathValue(w, r, "deadLetterId")
+	if !ok {
+		return
+	}
+
+	dl, err := h.useCase.Get(requestContext(r), id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toDeadLetterResponse(dl))
+}
+
+// Replay handles POST /api/v1/admin/dead-letters/{deadLetterId}/replay
+func (h *DeadLetterHandler) Replay(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathValue(w, r, "deadLetterId")
+	if !ok {
+		return
+	}
+	var req dto.ReplayDeadLetterRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	dl, err := h.useCase.Replay(requestContext(r), id, req.ReplayedBy)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, toDeadLetterResponse(dl))
+}
+
+func toDeadLetterResponse(dl *port.DeadLetter) dto.DeadLetterResponse {
+	resp := dto.DeadLetterResponse{
+		ID:             dl.ID,
+		Topic:          dl.Topic,
+		Partition:      dl.Partition,
+		Offset:         dl.Offset,
+		Key:            string(dl.Key),
+		Headers:        dl.Headers,
+		Reason:         dl.Reason,
+		Attempts:       dl.Attempts,
+		Status:         string(dl.Status),
+		DeadLetteredAt: dl.DeadLetteredAt,
+		ReplayCount:    dl.ReplayCount,
+		ReplayedAt:     dl.ReplayedAt,
+		ReplayedBy:     dl.ReplayedBy,
+	}
+	if resp.Headers == nil {
+		resp.Headers = map[string]string{}
+	}
+	if json.Valid(dl.Value) {
+		resp.Payload = dl.Value
+	} else {
+		resp.PayloadBase64 = dl.Value
+	}
+	if dl.Timestamp > 0 {
+		t := time.UnixMilli(dl.Timestamp).UTC()
+		resp.MessageTimestamp = &t
+	}
+	return resp
+}
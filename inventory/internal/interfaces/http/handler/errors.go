@@ -0,0 +1,173 @@
+// file: internal/interfaces/http/handler/errors.go
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
+
+// errorMapping associates domain and use case errors with an HTTP status and error code
+type errorMapping struct {
+	err    error
+	status int
+	code   string
+}
+
+// errorMappings is consulted in order; the first matching entry wins
+var errorMappings = []errorMapping{
+	// Request validation
+	{errInvalidBody, http.StatusBadRequest, dto.ErrCodeValidation},
+	{errInvalidParameter, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrExpiryInPast, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrUnknownAllocationStrategy, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrUnknownReservationPolicy, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrReservationLifetimeExceeded, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrReservationExpiryNotLater, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrProductIDRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrProductSKURequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrProductNameRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrMinStockNegative, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrVariantAttributesRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrVariantOfVariant, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrVariantDetailsInherited, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrVariantRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrVariantNotOfProduct, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitComponentsRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitComponentQuantity, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitComponentDuplicate, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitComponentSelf, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitComponentKit, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitSerialized, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrKitVariants, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrKitNotStocked, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrUnitCodeRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrUnitCodeInvalid, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrUnitNameRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrUnitIsBaseUnit, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrConversionQuantity, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrQuantityNotWholeUnit, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrConversionOverflow, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrUnitNotConvertible, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrWarehouseCodeRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrWarehouseNameRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrWarehousePriority, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrQuantityNegative, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrReorderPointNegative, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrReorderQuantityNegative, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrReservationOrderRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrReservationItemsRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrReservationItemQuantity, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrShipmentIDRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrTransferSameWarehouse, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrTransferItemsRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrTransferItemQuantity, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrTransferDuplicateProduct, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrTransferReceiptQuantity, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrTransferProductNotListed, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrUnknownReasonCode, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrAdjustmentQuantityZero, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrAdjustmentReasonRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrAdjustmentUnitCostNegative, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrCountLinesRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrCountDuplicateStockItem, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrCountQuantityNegative, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrCountStockItemNotListed, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrStockItemNotInWarehouse, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLotNumberRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLotDates, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrSerialNumbersRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrSerialNumberCount, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrSerialNumberDuplicate, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrSerialUnitNotInShipment, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrProductNotSerialized, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrSerialNumberRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationCodeRequired, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationCodeInvalid, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationTypeInvalid, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationParent, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationCapacity, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationNotBin, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrLocationWarehouseMismatch, http.StatusBadRequest, dto.ErrCodeValidation},
+	{usecase.ErrBinMoveSameLocation, http.StatusBadRequest, dto.ErrCodeValidation},
+
+	// Lookups
+	{repository.ErrNotFound, http.StatusNotFound, dto.ErrCodeNotFound},
+
+	// Uniqueness
+	{repository.ErrAlreadyExists, http.StatusConflict, dto.ErrCodeConflict},
+	{usecase.ErrProductSKUExists, http.StatusConflict, dto.ErrCodeConflict},
+	{usecase.ErrVariantExists, http.StatusConflict, dto.ErrCodeConflict},
+	{usecase.ErrWarehouseCodeExists, http.StatusConflict, dto.ErrCodeConflict},
+	{usecase.ErrStockItemExists, http.StatusConflict, dto.ErrCodeConflict},
+	{entity.ErrLotDatesMismatch, http.StatusConflict, dto.ErrCodeConflict},
+	{usecase.ErrLocationPathExists, http.StatusConflict, dto.ErrCodeConflict},
+	{usecase.ErrUnitExists, http.StatusConflict, dto.ErrCodeConflict},
+
+	// Optimistic concurrency, after the use case exhausted its retries
+	{repository.ErrConcurrentModification, http.StatusConflict, dto.ErrCodeConcurrentModification},
+
+	// Stock availability
+	{entity.ErrInsufficientStock, http.StatusConflict, dto.ErrCodeInsufficientStock},
+	{entity.ErrInsufficientReserved, http.StatusConflict, dto.ErrCodeInsufficientStock},
+	{usecase.ErrProductNotStocked, http.StatusConflict, dto.ErrCodeInsufficientStock},
+	{entity.ErrAdjustmentBelowReserved, http.StatusConflict, dto.ErrCodeInsufficientStock},
+	{entity.ErrBinCapacityExceeded, http.StatusConflict, dto.ErrCodeInsufficientStock},
+
+	// Lifecycle state
+	{entity.ErrProductDeleted, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrWarehouseDeleted, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrProductInactive, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrWarehouseInactive, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrReservationNotPending, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrReservationNotConfirmed, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrReservationAlreadyReleased, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrReservationAlreadyFulfilled, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrReservationExpired, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrReservationReleaseQuantity, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrShipmentQuantity, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrBackorderNotOpen, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrTransferNotDraft, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrTransferNotDispatched, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrTransferNotInTransit, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrNotStockedInWarehouse, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrBaseProductStocked, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrAdjustmentNotPending, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrStockItemFrozen, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrCountSessionNotOpen, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrCountSessionNotSubmitted, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrCountSessionIncomplete, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrCountSessionClosed, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrLotExpired, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrSerializedMovement, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrSerializedCount, http.StatusBadRequest, dto.ErrCodeValidation},
+	{entity.ErrSerialUnitInStock, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrSerialUnitNotAvailable, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrSerialUnitNotReserved, http.StatusConflict, dto.ErrCodeInvalidState},
+	{entity.ErrSerialUnitScrapped, http.StatusConflict, dto.ErrCodeInvalidState},
+	{usecase.ErrDeadLetterReplayed, http.StatusConflict, dto.ErrCodeInvalidState},
+
+	// Separation of duties
+	{entity.ErrAdjustmentSelfApproval, http.StatusForbidden, dto.ErrCodeForbidden},
+}
+
+// classifyError returns the HTTP status and error code for err
+func classifyError(err error) (int, string) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return http.StatusBadRequest, dto.ErrCodeValidation
+	}
+
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return m.status, m.code
+		}
+	}
+	return http.StatusInternalServerError, dto.ErrCodeInternal
+}
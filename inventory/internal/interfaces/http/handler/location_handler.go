@@ -0,0 +1,258 @@
+// file: internal/interfaces/http/handler/location_handler.go
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
+
+// LocationUseCase defines the use case operations the handler depends on.
+type LocationUseCase interface {
+	Create(ctx context.Context, in usecase.CreateLocationInput) (*usecase.LocationDetails, error)
+	GetByID(ctx context.Context, id string) (*usecase.LocationDetails, error)
+	List(ctx context.Context, filter repository.LocationFilter) ([]*entity.Location, int, error)
+	GetMovements(ctx context.Context, locationID string, limit, offset int) ([]*entity.BinMovement, int, error)
+	ListByStockItem(ctx context.Context, stockItemID string) (*usecase.BinStockDetails, error)
+	Move(ctx context.Context, in usecase.MoveBinStockInput) (*usecase.StockMovementDetails, error)
+}
+
+// LocationHandler handles HTTP requests for the /api/v1/locations and /api/v1/bin-moves
+// resources.
+type LocationHandler struct {
+	useCase LocationUseCase
+}
+
+// NewLocationHandler constructs a LocationHandler with its use case dependency.
+func NewLocationHandler(uc LocationUseCase) *LocationHandler {
+	return &LocationHandler{useCase: uc}
+}
+
+// Create handles POST /api/v1/locations
+func (h *LocationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateLocationRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	location, err := h.useCase.Create(requestContext(r), usecase.CreateLocationInput{
+		WarehouseID: req.WarehouseID,
+		ParentID:    req.ParentID,
+		Type:        entity.LocationType(strings.ToUpper(req.Type)),
+		Code:        req.Code,
+		Capacity:    req.Capacity,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toLocationResponse(location.Location, location.Contents))
+}
+
+// Get handles GET /api/v1/locations/{locationId}
+func (h *LocationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	locationID, ok := pathValue(w, r, "locationId")
+	if !ok {
+		return
+	}
+
+	location, err := h.useCase.GetByID(requestContext(r), locationID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toLocationResponse(location.Location, location.Contents))
+}
+
+// List handles GET /api/v1/locations
+func (h *LocationHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	locationType, err := parseLocationType(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	locations, total, err := h.useCase.List(requestContext(r), repository.LocationFilter{
+		WarehouseID: queryString(r, "warehouse_id"),
+		ParentID:    queryString(r, "parent_id"),
+		Type:        locationType,
+		PathPrefix:  queryString(r, "path_prefix"),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListLocationsResponse{
+		Locations:  make([]dto.LocationResponse, 0, len(locations)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, l := range locations {
+		resp.Locations = append(resp.Locations, toLocationResponse(l, nil))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListMovements handles GET /api/v1/locations/{locationId}/movements
+func (h *LocationHandler) ListMovements(w http.ResponseWriter, r *http.Request) {
+	locationID, ok := pathValue(w, r, "locationId")
+	if !ok {
+		return
+	}
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	movements, total, err := h.useCase.GetMovements(requestContext(r), locationID, limit, offset)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListBinMovementsResponse{
+		Movements:  make([]dto.BinMovementResponse, 0, len(movements)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, m := range movements {
+		resp.Movements = append(resp.Movements, dto.BinMovementResponse{
+			ID:             m.ID,
+			LocationID:     m.LocationID,
+			StockItemID:    m.StockItemID,
+			MovementID:     m.MovementID,
+			MovementType:   toDTOMovementType(m.MovementType, m.Quantity),
+			Quantity:       m.Quantity,
+			QuantityBefore: m.PreviousQuantity,
+			QuantityAfter:  m.NewQuantity,
+			ReferenceType:  m.ReferenceType,
+			ReferenceID:    m.ReferenceID,
+			PerformedBy:    m.CreatedBy,
+			CreatedAt:      m.CreatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListForStockItem handles GET /api/v1/stock-items/{stockItemId}/bins
+func (h *LocationHandler) ListForStockItem(w http.ResponseWriter, r *http.Request) {
+	stockItemID, ok := pathValue(w, r, "stockItemId")
+	if !ok {
+		return
+	}
+
+	item, err := h.useCase.ListByStockItem(requestContext(r), stockItemID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.StockItemBinsResponse{
+		StockItemID:      item.ID,
+		Quantity:         item.QuantityOnHand,
+		UnbinnedQuantity: item.QuantityUnbinned,
+		Bins:             toBinStockResponses(item.Bins),
+	})
+}
+
+// Move handles POST /api/v1/bin-moves
+func (h *LocationHandler) Move(w http.ResponseWriter, r *http.Request) {
+	var req dto.MoveBinStockRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	movement, err := h.useCase.Move(requestContext(r), usecase.MoveBinStockInput{
+		StockItemID:    req.StockItemID,
+		FromLocationID: req.FromLocationID,
+		ToLocationID:   req.ToLocationID,
+		Quantity:       req.Quantity,
+		Reason:         req.Reason,
+		PerformedBy:    middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toStockMovementResponse(movement))
+}
+
+// parseLocationType reads the optional type query parameter
+func parseLocationType(r *http.Request) (*entity.LocationType, error) {
+	v := r.URL.Query().Get("type")
+	if v == "" {
+		return nil, nil
+	}
+	locationType := entity.LocationType(strings.ToUpper(v))
+	switch locationType {
+	case entity.LocationTypeZone, entity.LocationTypeAisle, entity.LocationTypeRack, entity.LocationTypeBin:
+		return &locationType, nil
+	}
+	return nil, fmt.Errorf("%w: unknown location type %q", errInvalidParameter, v)
+}
+
+func toLocationResponse(l *entity.Location, contents []*entity.BinStock) dto.LocationResponse {
+	resp := dto.LocationResponse{
+		ID:             l.ID,
+		WarehouseID:    l.WarehouseID,
+		ParentID:       l.ParentID,
+		Type:           strings.ToLower(string(l.Type)),
+		Code:           l.Code,
+		Path:           l.Path,
+		Capacity:       l.Capacity,
+		QuantityStored: l.QuantityStored,
+		CreatedAt:      l.CreatedAt,
+		UpdatedAt:      l.UpdatedAt,
+	}
+	if free := l.FreeCapacity(); l.IsBin() && free >= 0 {
+		resp.FreeCapacity = &free
+	}
+	if len(contents) > 0 {
+		resp.Contents = toBinStockResponses(contents)
+	}
+	return resp
+}
+
+func toBinStockResponses(stocks []*entity.BinStock) []dto.BinStockResponse {
+	resp := make([]dto.BinStockResponse, 0, len(stocks))
+	for _, s := range stocks {
+		resp = append(resp, dto.BinStockResponse{
+			StockItemID: s.StockItemID,
+			LocationID:  s.LocationID,
+			Path:        s.Path,
+			Quantity:    s.Quantity,
+			UpdatedAt:   s.UpdatedAt,
+		})
+	}
+	return resp
+}
+
+func toBinPickResponses(picks []usecase.BinPick) []dto.BinPickResponse {
+	var resp []dto.BinPickResponse
+	for _, p := range picks {
+		resp = append(resp, dto.BinPickResponse{
+			StockItemID: p.StockItemID,
+			LocationID:  p.LocationID,
+			Path:        p.Path,
+			Quantity:    p.Quantity,
+		})
+	}
+	return resp
+}
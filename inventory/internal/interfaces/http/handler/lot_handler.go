@@ -0,0 +1,183 @@
+// file: internal/interfaces/http/handler/lot_handler.go
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
+
+// LotUseCase defines the use case operations the handler depends on.
+type LotUseCase interface {
+	GetByID(ctx context.Context, id string) (*entity.Lot, error)
+	List(ctx context.Context, filter repository.LotFilter) ([]*entity.Lot, int, error)
+	ListByStockItem(ctx context.Context, stockItemID string, filter repository.LotFilter) ([]*entity.Lot, int, error)
+	GetMovements(ctx context.Context, lotID string, limit, offset int) ([]*entity.LotMovement, int, error)
+}
+
+// LotHandler handles HTTP requests for the /api/v1/lots resource.
+type LotHandler struct {
+	useCase LotUseCase
+}
+
+// NewLotHandler constructs a LotHandler with its use case dependency.
+func NewLotHandler(uc LotUseCase) *LotHandler {
+	return &LotHandler{useCase: uc}
+}
+
+// Get handles GET /api/v1/lots/{lotId}
+func (h *LotHandler) Get(w http.ResponseWriter, r *http.Request) {
+	lotID, ok := pathValue(w, r, "lotId")
+	if !ok {
+		return
+	}
+
+	lot, err := h.useCase.GetByID(requestContext(r), lotID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toLotResponse(lot))
+}
+
+// List handles GET /api/v1/lots
+func (h *LotHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, filter, err := parseLotFilter(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	filter.StockItemID = queryString(r, "stock_item_id")
+	filter.ProductID = queryString(r, "product_id")
+	filter.LotNumber = queryString(r, "lot_number")
+
+	lots, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toListLotsResponse(lots, page, total))
+}
+
+// ListForStockItem handles GET /api/v1/stock-items/{stockItemId}/lots
+func (h *LotHandler) ListForStockItem(w http.ResponseWriter, r *http.Request) {
+	stockItemID, ok := pathValue(w, r, "stockItemId")
+	if !ok {
+		return
+	}
+	page, filter, err := parseLotFilter(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	lots, total, err := h.useCase.ListByStockItem(requestContext(r), stockItemID, filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toListLotsResponse(lots, page, total))
+}
+
+// ListMovements handles GET /api/v1/lots/{lotId}/movements
+func (h *LotHandler) ListMovements(w http.ResponseWriter, r *http.Request) {
+	lotID, ok := pathValue(w, r, "lotId")
+	if !ok {
+		return
+	}
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	movements, total, err := h.useCase.GetMovements(requestContext(r), lotID, limit, offset)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListLotMovementsResponse{
+		Movements:  make([]dto.LotMovementResponse, 0, len(movements)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, m := range movements {
+		before, after := m.PreviousOnHand, m.NewOnHand
+		if before == after {
+			// Reservation movements leave on-hand untouched; report the reserved quantity instead
+			before, after = m.PreviousReserved, m.NewReserved
+		}
+		resp.Movements = append(resp.Movements, dto.LotMovementResponse{
+			ID:             m.ID,
+			LotID:          m.LotID,
+			StockItemID:    m.StockItemID,
+			MovementID:     m.MovementID,
+			MovementType:   toDTOMovementType(m.MovementType, m.Quantity),
+			Quantity:       m.Quantity,
+			QuantityBefore: before,
+			QuantityAfter:  after,
+			ReferenceType:  m.ReferenceType,
+			ReferenceID:    m.ReferenceID,
+			PerformedBy:    m.CreatedBy,
+			CreatedAt:      m.CreatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// parseLotFilter reads the pagination, expires_before and include_empty query parameters
+func parseLotFilter(r *http.Request) (dto.PaginationRequest, repository.LotFilter, error) {
+	page, err := parsePagination(r)
+	if err != nil {
+		return page, repository.LotFilter{}, err
+	}
+	expiresBefore, err := queryTime(r, "expires_before")
+	if err != nil {
+		return page, repository.LotFilter{}, err
+	}
+	includeEmpty, err := queryBool(r, "include_empty")
+	if err != nil {
+		return page, repository.LotFilter{}, err
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.LotFilter{
+		ExpiresBefore: expiresBefore,
+		IncludeEmpty:  includeEmpty != nil && *includeEmpty,
+		Limit:         limit,
+		Offset:        offset,
+	}
+	return page, filter, nil
+}
+
+func toListLotsResponse(lots []*entity.Lot, page dto.PaginationRequest, total int) dto.ListLotsResponse {
+	resp := dto.ListLotsResponse{
+		Lots:       make([]dto.LotResponse, 0, len(lots)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, l := range lots {
+		resp.Lots = append(resp.Lots, toLotResponse(l))
+	}
+	return resp
+}
+
+func toLotResponse(l *entity.Lot) dto.LotResponse {
+	return dto.LotResponse{
+		ID:                l.ID,
+		StockItemID:       l.StockItemID,
+		LotNumber:         l.LotNumber,
+		ManufacturedAt:    l.ManufacturedAt,
+		ExpiresAt:         l.ExpiresAt,
+		Expired:           l.IsExpired(time.Now()),
+		Quantity:          l.QuantityOnHand,
+		ReservedQuantity:  l.QuantityReserved,
+		AvailableQuantity: l.AvailableQuantity(),
+		CreatedAt:         l.CreatedAt,
+		UpdatedAt:         l.UpdatedAt,
+	}
+}
@@ -2,15 +2,24 @@
 package handler
 
 import (
-	"encoding/json"
+	"context"
 	"net/http"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
 )
 
 // ProductUseCase defines the use case operations the handler depends on.
 // Implemented by the application layer (application/usecase/).
 type ProductUseCase interface {
-	// TODO: define methods once application/usecase/ files are generated,
-	// e.g. Create, GetByID, List, Update, Delete.
+	Create(ctx context.Context, in usecase.CreateProductInput) (*usecase.ProductDetails, error)
+	AddVariant(ctx context.Context, productID string, in usecase.CreateVariantInput) (*usecase.ProductDetails, error)
+	GetByID(ctx context.Context, id string) (*usecase.ProductDetails, error)
+	List(ctx context.Context, filter repository.ProductFilter) ([]*usecase.ProductDetails, int, error)
+	Update(ctx context.Context, id string, in usecase.UpdateProductInput) (*usecase.ProductDetails, error)
+	Delete(ctx context.Context, id string) error
 }
 
 // ProductHandler handles HTTP requests for the /api/v1/products resource.
@@ -25,39 +34,182 @@ func NewProductHandler(uc ProductUseCase) *ProductHandler {
 
 // Create handles POST /api/v1/products
 func (h *ProductHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// TODO: decode dto.CreateProductRequest, call h.useCase.Create, encode dto.ProductResponse
-	writeNotImplemented(w)
+	var req dto.CreateProductRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.CreateProductInput{
+		SKU:         req.BaseSKU,
+		Name:        req.Name,
+		Description: req.Description,
+		Category:    req.Category,
+		BaseUnit:    req.BaseUnit,
+		MinStock:    req.LowStockThreshold,
+		Serialized:  req.Serialized,
+	}
+	for _, v := range req.Variants {
+		in.Variants = append(in.Variants, toCreateVariantInput(v))
+	}
+	for _, c := range req.Components {
+		in.Components = append(in.Components, entity.KitComponent{ProductID: c.ProductID, Quantity: c.Quantity})
+	}
+
+	product, err := h.useCase.Create(requestContext(r), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toProductResponse(product))
+}
+
+// AddVariant handles POST /api/v1/products/{productId}/variants
+func (h *ProductHandler) AddVariant(w http.ResponseWriter, r *http.Request) {
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+
+	var req dto.ProductVariant
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	product, err := h.useCase.AddVariant(requestContext(r), productID, toCreateVariantInput(req))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toProductResponse(product))
 }
 
 // List handles GET /api/v1/products
 func (h *ProductHandler) List(w http.ResponseWriter, r *http.Request) {
-	// TODO: parse query params into dto.ListProductsRequest, call h.useCase.List, encode dto.ListProductsResponse
-	writeNotImplemented(w)
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.ProductFilter{
+		SKU:      queryString(r, "sku"),
+		Name:     queryString(r, "search"),
+		Category: queryString(r, "category"),
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	products, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListProductsResponse{
+		Products:   make([]dto.ProductResponse, 0, len(products)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, p := range products {
+		resp.Products = append(resp.Products, toProductResponse(p))
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // Get handles GET /api/v1/products/{productId}
 func (h *ProductHandler) Get(w http.ResponseWriter, r *http.Request) {
-	// productID := r.PathValue("productId")
-	// TODO: call h.useCase.GetByID(r.Context(), productID), encode dto.ProductResponse
-	writeNotImplemented(w)
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+
+	product, err := h.useCase.GetByID(requestContext(r), productID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toProductResponse(product))
 }
 
 // Update handles PUT /api/v1/products/{productId}
 func (h *ProductHandler) Update(w http.ResponseWriter, r *http.Request) {
-	// productID := r.PathValue("productId")
-	// TODO: decode dto.UpdateProductRequest, call h.useCase.Update, encode dto.ProductResponse
-	writeNotImplemented(w)
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateProductRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	product, err := h.useCase.Update(requestContext(r), productID, usecase.UpdateProductInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Category:    req.Category,
+		MinStock:    req.LowStockThreshold,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toProductResponse(product))
 }
 
 // Delete handles DELETE /api/v1/products/{productId}
 func (h *ProductHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	// productID := r.PathValue("productId")
-	// TODO: call h.useCase.Delete(r.Context(), productID)
-	w.WriteHeader(http.StatusNotImplemented)
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+
+	if err := h.useCase.Delete(requestContext(r), productID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toProductResponse(p *usecase.ProductDetails) dto.ProductResponse {
+	resp := dto.ProductResponse{
+		ID:                p.ID,
+		Name:              p.Name,
+		Description:       p.Description,
+		BaseSKU:           p.SKU,
+		ParentID:          p.ParentID,
+		Category:          p.Category,
+		LowStockThreshold: p.MinStock,
+		BaseUnit:          p.BaseUnit,
+		Serialized:        p.Serialized,
+		IsKit:             p.IsKit,
+		TotalStock:        p.TotalOnHand,
+		TotalReserved:     p.TotalReserved,
+		AvailableStock:    p.TotalAvailable,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+	if p.IsVariant() {
+		resp.Variants = []dto.ProductVariant{toProductVariantResponse(p.Product)}
+	}
+	for _, v := range p.Variants {
+		resp.Variants = append(resp.Variants, toProductVariantResponse(v))
+	}
+	for _, c := range p.Components {
+		resp.Components = append(resp.Components, dto.KitComponent{ProductID: c.ProductID, Quantity: c.Quantity})
+	}
+	return resp
+}
+
+func toProductVariantResponse(p *entity.Product) dto.ProductVariant {
+	return dto.ProductVariant{ID: p.ID, Size: p.Variant.Size, Color: p.Variant.Color, SKU: p.SKU}
 }
 
-func writeNotImplemented(w http.ResponseWriter) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusNotImplemented)
-	_ = json.NewEncoder(w).Encode(map[string]string{"error": "not implemented"})
+func toCreateVariantInput(v dto.ProductVariant) usecase.CreateVariantInput {
+	return usecase.CreateVariantInput{
+		SKU:     v.SKU,
+		Variant: entity.ProductVariant{Size: v.Size, Color: v.Color},
+	}
 }
@@ -1,11 +1,27 @@
 // file: internal/interfaces/http/handler/reservation_handler.go
 package handler
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
 
 // ReservationUseCase defines the use case operations the handler depends on.
 type ReservationUseCase interface {
-	// TODO: define methods once application/usecase/ files are generated.
+	Reserve(ctx context.Context, in usecase.ReserveInput) (*usecase.ReservationDetails, error)
+	GetByID(ctx context.Context, id string) (*usecase.ReservationDetails, error)
+	ListByOrder(ctx context.Context, orderID string) ([]*usecase.ReservationDetails, error)
+	Release(ctx context.Context, id string, in usecase.ReleaseInput) (*usecase.ReservationDetails, error)
+	Fulfill(ctx context.Context, id string, in usecase.FulfillInput) (*usecase.ReservationDetails, error)
+	Extend(ctx context.Context, id string, in usecase.ExtendInput) (*usecase.ReservationDetails, error)
+	Picks(ctx context.Context, id string) (*usecase.ReservationDetails, error)
 }
 
 // ReservationHandler handles HTTP requests for the /api/v1/reservations resource.
@@ -20,34 +36,293 @@ func NewReservationHandler(uc ReservationUseCase) *ReservationHandler {
 
 // Create handles POST /api/v1/reservations
 func (h *ReservationHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// TODO: decode dto.CreateReservationRequest, call h.useCase.Reserve, encode dto.ReservationResponse
-	writeNotImplemented(w)
+	var req dto.CreateReservationRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.ReserveInput{
+		OrderID:     req.OrderID,
+		ExpiresAt:   req.ExpiresAt,
+		PerformedBy: middleware.GetUserID(r.Context()),
+		Policy:      usecase.ReservationPolicy(strings.ToUpper(req.Policy)),
+		Priority:    req.BackorderPriority,
+		Strategy:    req.AllocationStrategy,
+		NoSplit:     req.AllowSplit != nil && !*req.AllowSplit,
+	}
+	if req.ShipTo != nil {
+		destination := toEntityAddress(*req.ShipTo)
+		in.Destination = &destination
+	}
+	for _, item := range req.Items {
+		in.Items = append(in.Items, usecase.ReserveItemInput{
+			ProductID:            item.ProductID,
+			VariantSKU:           item.VariantSKU,
+			Quantity:             item.Quantity,
+			Unit:                 item.Unit,
+			PreferredWarehouseID: item.PreferredWarehouseID,
+		})
+	}
+
+	reservation, err := h.useCase.Reserve(requestContext(r), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if reservation.Reservation == nil {
+		// Every line was backordered; nothing is held yet
+		writeJSON(w, http.StatusAccepted, toReservationResponse(reservation))
+		return
+	}
+	writeJSON(w, http.StatusCreated, toReservationResponse(reservation))
 }
 
 // Get handles GET /api/v1/reservations/{reservationId}
 func (h *ReservationHandler) Get(w http.ResponseWriter, r *http.Request) {
-	// reservationID := r.PathValue("reservationId")
-	// TODO: call h.useCase.GetByID, encode dto.ReservationResponse
-	writeNotImplemented(w)
+	reservationID, ok := pathValue(w, r, "reservationId")
+	if !ok {
+		return
+	}
+
+	reservation, err := h.useCase.GetByID(requestContext(r), reservationID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toReservationResponse(reservation))
+}
+
+// Picks handles GET /api/v1/reservations/{reservationId}/picks
+func (h *ReservationHandler) Picks(w http.ResponseWriter, r *http.Request) {
+	reservationID, ok := pathValue(w, r, "reservationId")
+	if !ok {
+		return
+	}
+
+	reservation, err := h.useCase.Picks(requestContext(r), reservationID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toReservationResponse(reservation))
+}
+
+// Extend handles POST /api/v1/reservations/{reservationId}/extend
+func (h *ReservationHandler) Extend(w http.ResponseWriter, r *http.Request) {
+	reservationID, ok := pathValue(w, r, "reservationId")
+	if !ok {
+		return
+	}
+
+	var req dto.ExtendReservationRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	reservation, err := h.useCase.Extend(requestContext(r), reservationID, usecase.ExtendInput{
+		ExpiresAt:   req.ExpiresAt,
+		ExtendBy:    time.Duration(req.ExtendBySeconds) * time.Second,
+		Roles:       middleware.GetRoles(r.Context()),
+		PerformedBy: middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toReservationResponse(reservation))
 }
 
 // Release handles POST /api/v1/reservations/{reservationId}/release
 func (h *ReservationHandler) Release(w http.ResponseWriter, r *http.Request) {
-	// reservationID := r.PathValue("reservationId")
-	// TODO: decode dto.ReleaseReservationRequest, call h.useCase.Release
-	writeNotImplemented(w)
+	reservationID, ok := pathValue(w, r, "reservationId")
+	if !ok {
+		return
+	}
+
+	var req dto.ReleaseReservationRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.ReleaseInput{
+		Reason:      req.Reason,
+		PerformedBy: middleware.GetUserID(r.Context()),
+	}
+	for _, item := range req.PartialItems {
+		in.Items = append(in.Items, usecase.ReleaseItemInput{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	reservation, err := h.useCase.Release(requestContext(r), reservationID, in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toReservationResponse(reservation))
 }
 
 // Fulfill handles POST /api/v1/reservations/{reservationId}/fulfill
 func (h *ReservationHandler) Fulfill(w http.ResponseWriter, r *http.Request) {
-	// reservationID := r.PathValue("reservationId")
-	// TODO: decode dto.FulfillReservationRequest, call h.useCase.Fulfill
-	writeNotImplemented(w)
+	reservationID, ok := pathValue(w, r, "reservationId")
+	if !ok {
+		return
+	}
+
+	var req dto.FulfillReservationRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.FulfillInput{
+		ShipmentID:  req.ShipmentID,
+		FulfilledBy: req.FulfilledBy,
+		Notes:       req.Notes,
+	}
+	for _, item := range req.Items {
+		in.Items = append(in.Items, usecase.ShipmentItemInput{
+			ProductID:     item.ProductID,
+			Quantity:      item.Quantity,
+			SerialNumbers: item.SerialNumbers,
+		})
+	}
+
+	reservation, err := h.useCase.Fulfill(requestContext(r), reservationID, in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toReservationResponse(reservation))
 }
 
 // ListByOrder handles GET /api/v1/orders/{orderId}/reservations
 func (h *ReservationHandler) ListByOrder(w http.ResponseWriter, r *http.Request) {
-	// orderID := r.PathValue("orderId")
-	// TODO: call h.useCase.ListByOrder, encode dto.ListReservationsResponse
-	writeNotImplemented(w)
+	orderID, ok := pathValue(w, r, "orderId")
+	if !ok {
+		return
+	}
+
+	reservations, err := h.useCase.ListByOrder(requestContext(r), orderID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Orders have few reservations, so the whole set is returned as a single page
+	page := dto.PaginationRequest{Page: dto.DefaultPage, PageSize: max(len(reservations), 1)}
+	resp := dto.ListReservationsResponse{
+		Reservations: make([]dto.ReservationResponse, 0, len(reservations)),
+		Pagination:   newPaginationResponse(page, len(reservations)),
+	}
+	for _, res := range reservations {
+		resp.Reservations = append(resp.Reservations, toReservationResponse(res))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func toReservationResponse(r *usecase.ReservationDetails) dto.ReservationResponse {
+	if r.Reservation == nil {
+		resp := dto.ReservationResponse{
+			Status: dto.ReservationStatusBackordered,
+			Items:  []dto.ReservationItemResponse{},
+		}
+		addReservationOutcome(&resp, r)
+		if len(r.Backorders) > 0 {
+			resp.OrderID = r.Backorders[0].OrderID
+			resp.CreatedAt, resp.UpdatedAt = r.Backorders[0].CreatedAt, r.Backorders[0].CreatedAt
+		}
+		return resp
+	}
+
+	expiresAt := r.ExpiresAt
+	resp := dto.ReservationResponse{
+		ID:        r.ID,
+		OrderID:   r.OrderID,
+		Status:    strings.ToLower(string(r.Status)),
+		Items:     make([]dto.ReservationItemResponse, 0, len(r.ItemDetails)),
+		ExpiresAt: &expiresAt,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+	for _, item := range r.ItemDetails {
+		resp.Items = append(resp.Items, dto.ReservationItemResponse{
+			ProductID:         item.ProductID,
+			ProductName:       item.ProductName,
+			VariantSKU:        item.VariantSKU,
+			Quantity:          item.Quantity,
+			ReleasedQuantity:  item.ReleasedQuantity,
+			FulfilledQuantity: item.FulfilledQuantity,
+			WarehouseID:       item.WarehouseID,
+			WarehouseName:     item.WarehouseName,
+			StockItemID:       item.StockItemID,
+			KitID:             item.KitID,
+			AllocationReason:  item.AllocationReason,
+		})
+	}
+	for _, shipment := range r.Shipments {
+		resp.Shipments = append(resp.Shipments, toShipmentResponse(shipment, r.Items))
+	}
+	resp.Picks = toBinPickResponses(r.Picks)
+	addReservationOutcome(&resp, r)
+	return resp
+}
+
+func toShipmentResponse(s entity.Shipment, lines []entity.ReservationItem) dto.ShipmentResponse {
+	resp := dto.ShipmentResponse{
+		ID:        s.ID,
+		Items:     make([]dto.ShipmentItemResponse, 0, len(s.Lines)),
+		ShippedAt: s.ShippedAt,
+	}
+	for _, line := range s.Lines {
+		resp.Items = append(resp.Items, dto.ShipmentItemResponse{
+			ProductID:   lines[line.Line].ProductID,
+			StockItemID: lines[line.Line].StockItemID,
+			Quantity:    line.Quantity,
+		})
+	}
+	return resp
+}
+
+// addReservationOutcome adds how the lines of a new reservation were allocated, which
+// fell short and what was backordered
+func addReservationOutcome(resp *dto.ReservationResponse, r *usecase.ReservationDetails) {
+	for _, plan := range r.Allocations {
+		resp.Allocations = append(resp.Allocations, dto.AllocationResponse{
+			ProductID:   plan.ProductID,
+			Requested:   plan.Requested,
+			Allocated:   plan.Allocated(),
+			Strategy:    plan.Strategy,
+			Explanation: plan.Explanation,
+		})
+	}
+	for _, s := range r.Shortfalls {
+		resp.FailedItems = append(resp.FailedItems, dto.FailedReservationItem{
+			ProductID:         s.ProductID,
+			SKU:               s.SKU,
+			RequestedQuantity: s.Requested,
+			ReservedQuantity:  s.Reserved,
+			AvailableQuantity: s.Available,
+		})
+	}
+	for _, b := range r.Backorders {
+		resp.Backorders = append(resp.Backorders, toBackorderResponse(b))
+	}
+}
+
+func toBackorderResponse(b *entity.Backorder) dto.BackorderResponse {
+	return dto.BackorderResponse{
+		ID:                b.ID,
+		OrderID:           b.OrderID,
+		ProductID:         b.ProductID,
+		WarehouseID:       b.WarehouseID,
+		Quantity:          b.Quantity,
+		ReservedQuantity:  b.ReservedQuantity,
+		RemainingQuantity: b.RemainingQuantity(),
+		Priority:          b.Priority,
+		Status:            strings.ToLower(string(b.Status)),
+		CreatedAt:         b.CreatedAt,
+		UpdatedAt:         b.UpdatedAt,
+	}
 }
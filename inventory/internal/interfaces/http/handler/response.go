@@ -0,0 +1,216 @@
+// file: internal/interfaces/http/handler/response.go
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
+
+// Request headers read by every handler
+const (
+	headerRequestID     = "X-Request-ID"
+	headerCorrelationID = "X-Correlation-ID"
+)
+
+// validate checks request DTOs against their `validate` struct tags.
+// Field names in validation errors use the JSON tag so they match the request body.
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// errInvalidBody is returned when the request body is not valid JSON
+var errInvalidBody = errors.New("request body must be a valid JSON object")
+
+// decodeAndValidate decodes the JSON request body into v and validates it
+func decodeAndValidate(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidBody, err.Error())
+	}
+	return validate.Struct(v)
+}
+
+// requestContext returns the request context carrying the caller's correlation ID
+func requestContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if id := r.Header.Get(headerCorrelationID); id != "" {
+		return usecase.WithCorrelationID(ctx, id)
+	}
+	if id := r.Header.Get(headerRequestID); id != "" {
+		return usecase.WithCorrelationID(ctx, id)
+	}
+	return ctx
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError maps an error onto the standard error envelope
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code := classifyError(err)
+
+	detail := dto.ErrorDetail{
+		Code:      code,
+		Message:   err.Error(),
+		Timestamp: time.Now().UTC(),
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		detail.Message = "request validation failed"
+		for _, fe := range validationErrs {
+			detail.Details = append(detail.Details, dto.FieldError{
+				Field:   fe.Namespace()[strings.Index(fe.Namespace(), ".")+1:],
+				Message: validationMessage(fe),
+			})
+		}
+	}
+	var failed *usecase.ReservationFailedError
+	if errors.As(err, &failed) {
+		for _, s := range failed.Shortfalls {
+			detail.Details = append(detail.Details, dto.FieldError{
+				Field:   fmt.Sprintf("items[%d].quantity", s.Line),
+				Message: fmt.Sprintf("requested %d of product %s, %d available", s.Requested, s.ProductID, s.Available),
+			})
+		}
+	}
+	if status == http.StatusInternalServerError {
+		detail.Message = "an internal error occurred"
+	}
+
+	writeJSON(w, status, dto.ErrorResponse{
+		Error:     detail,
+		RequestID: r.Header.Get(headerRequestID),
+	})
+}
+
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return "must be at least " + fe.Param()
+	case "max":
+		return "must be at most " + fe.Param()
+	case "len":
+		return "must be exactly " + fe.Param() + " characters"
+	case "uuid":
+		return "must be a valid UUID"
+	case "oneof":
+		return "must be one of: " + fe.Param()
+	default:
+		return "failed " + fe.Tag() + " validation"
+	}
+}
+
+// pathValue returns a path parameter or writes a validation error when it is missing
+func pathValue(w http.ResponseWriter, r *http.Request, name string) (string, bool) {
+	value := r.PathValue(name)
+	if value == "" {
+		writeError(w, r, fmt.Errorf("%w: %s is required", errInvalidParameter, name))
+		return "", false
+	}
+	return value, true
+}
+
+// errInvalidParameter is returned when a path or query parameter cannot be parsed
+var errInvalidParameter = errors.New("invalid parameter")
+
+// parsePagination reads page and page_size query parameters, applying defaults and limits
+func parsePagination(r *http.Request) (dto.PaginationRequest, error) {
+	p := dto.PaginationRequest{Page: dto.DefaultPage, PageSize: dto.DefaultPageSize}
+
+	q := r.URL.Query()
+	if v := q.Get("page"); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil || page < 1 {
+			return p, fmt.Errorf("%w: page must be a positive integer", errInvalidParameter)
+		}
+		p.Page = page
+	}
+	if v := q.Get("page_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size < 1 || size > dto.MaxPageSize {
+			return p, fmt.Errorf("%w: page_size must be between 1 and %d", errInvalidParameter, dto.MaxPageSize)
+		}
+		p.PageSize = size
+	}
+	return p, nil
+}
+
+// limitOffset converts page-based pagination into repository limit and offset
+func limitOffset(p dto.PaginationRequest) (int, int) {
+	return p.PageSize, (p.Page - 1) * p.PageSize
+}
+
+func newPaginationResponse(p dto.PaginationRequest, total int) dto.PaginationResponse {
+	totalPages := (total + p.PageSize - 1) / p.PageSize
+	return dto.PaginationResponse{
+		Page:       p.Page,
+		PageSize:   p.PageSize,
+		TotalItems: int64(total),
+		TotalPages: totalPages,
+		HasNext:    p.Page < totalPages,
+		HasPrev:    p.Page > 1,
+	}
+}
+
+// queryString returns a pointer to a non-empty query parameter, or nil
+func queryString(r *http.Request, name string) *string {
+	if v := r.URL.Query().Get(name); v != "" {
+		return &v
+	}
+	return nil
+}
+
+// queryBool returns a pointer to a boolean query parameter, or nil when absent
+func queryBool(r *http.Request, name string) (*bool, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be a boolean", errInvalidParameter, name)
+	}
+	return &b, nil
+}
+
+// queryTime returns a pointer to an RFC 3339 query parameter, or nil when absent
+func queryTime(r *http.Request, name string) (*time.Time, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be an RFC 3339 timestamp", errInvalidParameter, name)
+	}
+	return &t, nil
+}
@@ -0,0 +1,150 @@
+// file: internal/interfaces/http/handler/serial_unit_handler.go
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
+
+// SerialUseCase defines the use case operations the handler depends on.
+type SerialUseCase interface {
+	GetByID(ctx context.Context, id string) (*usecase.SerialUnitDetails, error)
+	List(ctx context.Context, filter repository.SerialUnitFilter) ([]*entity.SerialUnit, int, error)
+	Scrap(ctx context.Context, in usecase.ScrapSerialUnitInput) (*usecase.SerialUnitDetails, error)
+}
+
+// SerialUnitHandler handles HTTP requests for the /api/v1/serials resource.
+type SerialUnitHandler struct {
+	useCase SerialUseCase
+}
+
+// NewSerialUnitHandler constructs a SerialUnitHandler with its use case dependency.
+func NewSerialUnitHandler(uc SerialUseCase) *SerialUnitHandler {
+	return &SerialUnitHandler{useCase: uc}
+}
+
+// Get handles GET /api/v1/serials/{serialUnitId}
+func (h *SerialUnitHandler) Get(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := pathValue(w, r, "serialUnitId")
+	if !ok {
+		return
+	}
+
+	unit, err := h.useCase.GetByID(requestContext(r), unitID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toSerialUnitDetailsResponse(unit))
+}
+
+// List handles GET /api/v1/serials
+func (h *SerialUnitHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.SerialUnitFilter{
+		ProductID:     queryString(r, "product_id"),
+		StockItemID:   queryString(r, "stock_item_id"),
+		SerialNumber:  queryString(r, "serial_number"),
+		ReservationID: queryString(r, "reservation_id"),
+		Limit:         limit,
+		Offset:        offset,
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		status := entity.SerialStatus(strings.ToUpper(v))
+		switch status {
+		case entity.SerialStatusAvailable, entity.SerialStatusReserved, entity.SerialStatusShipped,
+			entity.SerialStatusReturned, entity.SerialStatusScrapped:
+		default:
+			writeError(w, r, fmt.Errorf("%w: unknown status %q", errInvalidParameter, v))
+			return
+		}
+		filter.Statuses = []entity.SerialStatus{status}
+	}
+
+	units, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListSerialUnitsResponse{
+		SerialUnits: make([]dto.SerialUnitResponse, 0, len(units)),
+		Pagination:  newPaginationResponse(page, total),
+	}
+	for _, u := range units {
+		resp.SerialUnits = append(resp.SerialUnits, toSerialUnitResponse(u))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Scrap handles POST /api/v1/serials/{serialUnitId}/scrap
+func (h *SerialUnitHandler) Scrap(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := pathValue(w, r, "serialUnitId")
+	if !ok {
+		return
+	}
+
+	var req dto.ScrapSerialUnitRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	unit, err := h.useCase.Scrap(requestContext(r), usecase.ScrapSerialUnitInput{
+		SerialUnitID: unitID,
+		Reason:       req.Reason,
+		PerformedBy:  middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toSerialUnitDetailsResponse(unit))
+}
+
+func toSerialUnitDetailsResponse(d *usecase.SerialUnitDetails) dto.SerialUnitResponse {
+	resp := toSerialUnitResponse(d.SerialUnit)
+	resp.Movements = make([]dto.SerialMovementResponse, 0, len(d.Movements))
+	for _, m := range d.Movements {
+		resp.Movements = append(resp.Movements, dto.SerialMovementResponse{
+			ID:            m.ID,
+			StockItemID:   m.StockItemID,
+			MovementID:    m.MovementID,
+			MovementType:  toDTOMovementType(m.MovementType, 0),
+			FromStatus:    strings.ToLower(string(m.FromStatus)),
+			ToStatus:      strings.ToLower(string(m.ToStatus)),
+			ReferenceType: m.ReferenceType,
+			ReferenceID:   m.ReferenceID,
+			PerformedBy:   m.CreatedBy,
+			CreatedAt:     m.CreatedAt,
+		})
+	}
+	return resp
+}
+
+func toSerialUnitResponse(u *entity.SerialUnit) dto.SerialUnitResponse {
+	return dto.SerialUnitResponse{
+		ID:            u.ID,
+		ProductID:     u.ProductID,
+		SerialNumber:  u.SerialNumber,
+		StockItemID:   u.StockItemID,
+		Status:        strings.ToLower(string(u.Status)),
+		ReservationID: u.ReservationID,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}
+}
@@ -1,11 +1,22 @@
 // file: internal/interfaces/http/handler/stock_item_handler.go
 package handler
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
 
 // StockItemUseCase defines the use case operations the handler depends on.
 type StockItemUseCase interface {
-	// TODO: define methods once application/usecase/ files are generated.
+	Create(ctx context.Context, in usecase.CreateStockItemInput) (*usecase.StockItemDetails, error)
+	GetByID(ctx context.Context, id string) (*usecase.StockItemDetails, error)
+	List(ctx context.Context, filter repository.StockItemFilter) ([]*usecase.StockItemDetails, int, error)
+	AggregateByProduct(ctx context.Context, productID string) (*usecase.AggregatedStockDetails, error)
 }
 
 // StockItemHandler handles HTTP requests for the /api/v1/stock-items resource.
@@ -20,26 +31,149 @@ func NewStockItemHandler(uc StockItemUseCase) *StockItemHandler {
 
 // Create handles POST /api/v1/stock-items
 func (h *StockItemHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// TODO: decode dto.CreateStockItemRequest, call h.useCase.Create, encode dto.StockItemResponse
-	writeNotImplemented(w)
+	var req dto.CreateStockItemRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	item, err := h.useCase.Create(requestContext(r), usecase.CreateStockItemInput{
+		ProductID:       req.ProductID,
+		WarehouseID:     req.WarehouseID,
+		VariantSKU:      req.VariantSKU,
+		InitialQuantity: req.Quantity,
+		ReorderPoint:    req.ReorderPoint,
+		ReorderQuantity: req.ReorderQuantity,
+		BinLocation:     req.BinLocation,
+		PerformedBy:     middleware.GetUserID(r.Context()),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toStockItemResponse(item))
 }
 
 // List handles GET /api/v1/stock-items
 func (h *StockItemHandler) List(w http.ResponseWriter, r *http.Request) {
-	// TODO: parse query params into dto.ListStockItemsRequest, call h.useCase.List, encode dto.ListStockItemsResponse
-	writeNotImplemented(w)
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	lowStockOnly, err := queryBool(r, "low_stock_only")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.StockItemFilter{
+		ProductID:   queryString(r, "product_id"),
+		WarehouseID: queryString(r, "warehouse_id"),
+		Limit:       limit,
+		Offset:      offset,
+	}
+	if lowStockOnly != nil && *lowStockOnly {
+		filter.LowStock = lowStockOnly
+	}
+
+	items, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListStockItemsResponse{
+		StockItems: make([]dto.StockItemResponse, 0, len(items)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, item := range items {
+		resp.StockItems = append(resp.StockItems, toStockItemResponse(item))
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // Get handles GET /api/v1/stock-items/{stockItemId}
 func (h *StockItemHandler) Get(w http.ResponseWriter, r *http.Request) {
-	// stockItemID := r.PathValue("stockItemId")
-	// TODO: call h.useCase.GetByID, encode dto.StockItemResponse
-	writeNotImplemented(w)
+	stockItemID, ok := pathValue(w, r, "stockItemId")
+	if !ok {
+		return
+	}
+
+	item, err := h.useCase.GetByID(requestContext(r), stockItemID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toStockItemResponse(item))
 }
 
 // GetAggregatedStock handles GET /api/v1/products/{productId}/stock
 func (h *StockItemHandler) GetAggregatedStock(w http.ResponseWriter, r *http.Request) {
-	// productID := r.PathValue("productId")
-	// TODO: call h.useCase.AggregateByProduct, encode dto.AggregatedStockResponse
-	writeNotImplemented(w)
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+
+	stock, err := h.useCase.AggregateByProduct(requestContext(r), productID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.AggregatedStockResponse{
+		ProductID:          stock.ProductID,
+		ProductName:        stock.ProductName,
+		TotalQuantity:      stock.TotalOnHand,
+		TotalReserved:      stock.TotalReserved,
+		TotalAvailable:     stock.TotalAvailable,
+		TotalInTransit:     stock.TotalInTransit,
+		IsLowStock:         stock.IsLowStock,
+		WarehouseBreakdown: make([]dto.WarehouseStockBreakdown, 0, len(stock.WarehouseDetails)),
+	}
+	for _, wd := range stock.WarehouseDetails {
+		resp.WarehouseBreakdown = append(resp.WarehouseBreakdown, dto.WarehouseStockBreakdown{
+			WarehouseID:   wd.WarehouseID,
+			WarehouseName: wd.WarehouseName,
+			Quantity:      wd.QuantityOnHand,
+			Reserved:      wd.QuantityReserved,
+			Available:     wd.Available,
+		})
+	}
+	for _, vd := range stock.VariantDetails {
+		resp.VariantBreakdown = append(resp.VariantBreakdown, dto.VariantStockBreakdown{
+			ProductID:  vd.ProductID,
+			VariantSKU: vd.SKU,
+			Size:       vd.Variant.Size,
+			Color:      vd.Variant.Color,
+			Quantity:   vd.QuantityOnHand,
+			Reserved:   vd.QuantityReserved,
+			Available:  vd.Available,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func toStockItemResponse(item *usecase.StockItemDetails) dto.StockItemResponse {
+	return dto.StockItemResponse{
+		ID:                 item.ID,
+		ProductID:          item.ProductID,
+		ProductName:        item.ProductName,
+		WarehouseID:        item.WarehouseID,
+		WarehouseName:      item.WarehouseName,
+		VariantSKU:         item.VariantSKU,
+		Quantity:           item.QuantityOnHand,
+		ReservedQuantity:   item.QuantityReserved,
+		AvailableQuantity:  item.AvailableQuantity(),
+		ExpiredQuantity:    item.QuantityExpired,
+		ReservableQuantity: item.ReservableQuantity(),
+		ReorderPoint:       item.ReorderPoint,
+		ReorderQuantity:    item.ReorderQuantity,
+		BinLocation:        item.BinLocation,
+		IsLowStock:         item.IsLowStock(),
+		FrozenBy:           item.FrozenBy,
+		CreatedAt:          item.CreatedAt,
+		UpdatedAt:          item.UpdatedAt,
+	}
 }
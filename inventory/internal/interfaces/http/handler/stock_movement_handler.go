@@ -1,11 +1,22 @@
 // file: internal/interfaces/http/handler/stock_movement_handler.go
 package handler
 
-import "net/http"
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
 
 // StockMovementUseCase defines the use case operations the handler depends on.
 type StockMovementUseCase interface {
-	// TODO: define methods once application/usecase/ files are generated.
+	Replenish(ctx context.Context, in usecase.ReplenishInput) (*usecase.StockMovementDetails, error)
+	List(ctx context.Context, filter repository.StockMovementFilter) ([]*usecase.StockMovementDetails, int, error)
+	ListByStockItem(ctx context.Context, stockItemID string, limit, offset int) ([]*usecase.StockMovementDetails, int, error)
 }
 
 // StockMovementHandler handles HTTP requests for stock movement resources.
@@ -20,19 +31,192 @@ func NewStockMovementHandler(uc StockMovementUseCase) *StockMovementHandler {
 
 // Replenish handles POST /api/v1/stock-movements/replenish
 func (h *StockMovementHandler) Replenish(w http.ResponseWriter, r *http.Request) {
-	// TODO: decode dto.ReplenishStockRequest, call h.useCase.Replenish, encode dto.StockMovementResponse
-	writeNotImplemented(w)
+	var req dto.ReplenishStockRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.ReplenishInput{
+		StockItemID:   req.StockItemID,
+		Quantity:      req.Quantity,
+		Unit:          req.Unit,
+		ReferenceType: req.ReferenceType,
+		ReferenceID:   req.ReferenceID,
+		Notes:         req.Notes,
+		PerformedBy:   req.PerformedBy,
+		SerialNumbers: req.SerialNumbers,
+		BinLocationID: req.BinLocationID,
+	}
+	if req.Lot != nil {
+		in.Lot = &usecase.LotInput{
+			LotNumber:      req.Lot.LotNumber,
+			ManufacturedAt: req.Lot.ManufacturedAt,
+			ExpiresAt:      req.Lot.ExpiresAt,
+		}
+	}
+
+	movement, err := h.useCase.Replenish(requestContext(r), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toStockMovementResponse(movement))
 }
 
 // List handles GET /api/v1/stock-movements
 func (h *StockMovementHandler) List(w http.ResponseWriter, r *http.Request) {
-	// TODO: parse query params into dto.ListStockMovementsRequest, call h.useCase.List, encode dto.ListStockMovementsResponse
-	writeNotImplemented(w)
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	startDate, err := queryTime(r, "start_date")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	endDate, err := queryTime(r, "end_date")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.StockMovementFilter{
+		StockItemID:   queryString(r, "stock_item_id"),
+		ReferenceID:   queryString(r, "reference_id"),
+		ReferenceType: queryString(r, "reference_type"),
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Limit:         limit,
+		Offset:        offset,
+	}
+	if v := r.URL.Query().Get("movement_type"); v != "" {
+		mt, ok := fromDTOMovementType(v)
+		if !ok {
+			writeError(w, r, fmt.Errorf("%w: unknown movement_type %q", errInvalidParameter, v))
+			return
+		}
+		filter.MovementType = &mt
+	}
+
+	movements, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toListStockMovementsResponse(movements, page, total))
 }
 
 // ListForStockItem handles GET /api/v1/stock-items/{stockItemId}/movements
 func (h *StockMovementHandler) ListForStockItem(w http.ResponseWriter, r *http.Request) {
-	// stockItemID := r.PathValue("stockItemId")
-	// TODO: call h.useCase.ListByStockItem, encode dto.ListStockMovementsResponse
-	writeNotImplemented(w)
+	stockItemID, ok := pathValue(w, r, "stockItemId")
+	if !ok {
+		return
+	}
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	movements, total, err := h.useCase.ListByStockItem(requestContext(r), stockItemID, limit, offset)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toListStockMovementsResponse(movements, page, total))
+}
+
+func toListStockMovementsResponse(movements []*usecase.StockMovementDetails, page dto.PaginationRequest, total int) dto.ListStockMovementsResponse {
+	resp := dto.ListStockMovementsResponse{
+		Movements:  make([]dto.StockMovementResponse, 0, len(movements)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, m := range movements {
+		resp.Movements = append(resp.Movements, toStockMovementResponse(m))
+	}
+	return resp
+}
+
+func toStockMovementResponse(m *usecase.StockMovementDetails) dto.StockMovementResponse {
+	before, after := m.PreviousOnHand, m.NewOnHand
+	if before == after {
+		// Reservation movements leave on-hand untouched; report the reserved quantity instead
+		before, after = m.PreviousReserved, m.NewReserved
+	}
+	resp := dto.StockMovementResponse{
+		ID:             m.ID,
+		StockItemID:    m.StockItemID,
+		ProductID:      m.ProductID,
+		ProductName:    m.ProductName,
+		VariantSKU:     m.VariantSKU,
+		WarehouseID:    m.WarehouseID,
+		WarehouseName:  m.WarehouseName,
+		MovementType:   toDTOMovementType(m.MovementType, m.Quantity),
+		Quantity:       m.Quantity,
+		QuantityBefore: before,
+		QuantityAfter:  after,
+		ReferenceType:  m.ReferenceType,
+		ReferenceID:    m.ReferenceID,
+		Notes:          m.Reason,
+		PerformedBy:    m.CreatedBy,
+		CreatedAt:      m.CreatedAt,
+	}
+	if m.Lot != nil {
+		lot := toLotResponse(m.Lot)
+		resp.Lot = &lot
+	}
+	resp.Putaway = toBinPickResponses(m.Putaway)
+	for _, b := range m.FilledBackorders {
+		resp.FilledBackorders = append(resp.FilledBackorders, toBackorderResponse(b))
+	}
+	return resp
+}
+
+// toDTOMovementType maps a domain movement type onto its API representation
+func toDTOMovementType(mt entity.MovementType, quantity int) string {
+	switch mt {
+	case entity.MovementTypeReplenishment:
+		return dto.MovementTypeReplenish
+	case entity.MovementTypeReservation:
+		return dto.MovementTypeReserve
+	case entity.MovementTypeRelease:
+		return dto.MovementTypeRelease
+	case entity.MovementTypeFulfillment:
+		return dto.MovementTypeFulfill
+	case entity.MovementTypeAdjustment:
+		return dto.MovementTypeAdjustment
+	case entity.MovementTypeTransfer:
+		if quantity < 0 {
+			return dto.MovementTypeTransferOut
+		}
+		return dto.MovementTypeTransferIn
+	case entity.MovementTypeBinMove:
+		return dto.MovementTypeBinMove
+	}
+	return string(mt)
+}
+
+// fromDTOMovementType maps an API movement type onto the domain movement type
+func fromDTOMovementType(mt string) (entity.MovementType, bool) {
+	switch mt {
+	case dto.MovementTypeReplenish:
+		return entity.MovementTypeReplenishment, true
+	case dto.MovementTypeReserve:
+		return entity.MovementTypeReservation, true
+	case dto.MovementTypeRelease:
+		return entity.MovementTypeRelease, true
+	case dto.MovementTypeFulfill:
+		return entity.MovementTypeFulfillment, true
+	case dto.MovementTypeAdjustment:
+		return entity.MovementTypeAdjustment, true
+	case dto.MovementTypeTransferIn, dto.MovementTypeTransferOut:
+		return entity.MovementTypeTransfer, true
+	case dto.MovementTypeBinMove:
+		return entity.MovementTypeBinMove, true
+	}
+	return "", false
 }
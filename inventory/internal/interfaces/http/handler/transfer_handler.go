@@ -0,0 +1,217 @@
+// file: internal/interfaces/http/handler/transfer_handler.go
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+	"github.com/inventory-service/internal/interfaces/http/middleware"
+)
+
+// TransferUseCase defines the use case operations the handler depends on.
+type TransferUseCase interface {
+	Create(ctx context.Context, in usecase.CreateTransferInput) (*entity.Transfer, error)
+	GetByID(ctx context.Context, id string) (*entity.Transfer, error)
+	List(ctx context.Context, filter repository.TransferFilter) ([]*entity.Transfer, int, error)
+	Dispatch(ctx context.Context, id, performedBy string) (*entity.Transfer, error)
+	MarkInTransit(ctx context.Context, id string) (*entity.Transfer, error)
+	Receive(ctx context.Context, in usecase.ReceiveTransferInput) (*usecase.TransferDetails, error)
+}
+
+// TransferHandler handles HTTP requests for the /api/v1/transfers resource.
+type TransferHandler struct {
+	useCase TransferUseCase
+}
+
+// NewTransferHandler constructs a TransferHandler with its use case dependency.
+func NewTransferHandler(uc TransferUseCase) *TransferHandler {
+	return &TransferHandler{useCase: uc}
+}
+
+// Create handles POST /api/v1/transfers
+func (h *TransferHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateTransferRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.CreateTransferInput{
+		SourceWarehouseID:      req.SourceWarehouseID,
+		DestinationWarehouseID: req.DestinationWarehouseID,
+		Items:                  make([]usecase.TransferItemInput, 0, len(req.Items)),
+		Notes:                  req.Notes,
+		PerformedBy:            middleware.GetUserID(r.Context()),
+	}
+	for _, item := range req.Items {
+		in.Items = append(in.Items, usecase.TransferItemInput{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	transfer, err := h.useCase.Create(requestContext(r), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toTransferResponse(transfer))
+}
+
+// Get handles GET /api/v1/transfers/{transferId}
+func (h *TransferHandler) Get(w http.ResponseWriter, r *http.Request) {
+	transferID, ok := pathValue(w, r, "transferId")
+	if !ok {
+		return
+	}
+
+	transfer, err := h.useCase.GetByID(requestContext(r), transferID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toTransferResponse(transfer))
+}
+
+// List handles GET /api/v1/transfers
+func (h *TransferHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.TransferFilter{
+		SourceWarehouseID:      queryString(r, "source_warehouse_id"),
+		DestinationWarehouseID: queryString(r, "destination_warehouse_id"),
+		Limit:                  limit,
+		Offset:                 offset,
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		status := entity.TransferStatus(strings.ToUpper(v))
+		switch status {
+		case entity.TransferStatusDraft, entity.TransferStatusDispatched, entity.TransferStatusInTransit,
+			entity.TransferStatusReceived, entity.TransferStatusDiscrepancy:
+		default:
+			writeError(w, r, fmt.Errorf("%w: unknown status %q", errInvalidParameter, v))
+			return
+		}
+		filter.Status = &status
+	}
+
+	transfers, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListTransfersResponse{
+		Transfers:  make([]dto.TransferResponse, 0, len(transfers)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, t := range transfers {
+		resp.Transfers = append(resp.Transfers, toTransferResponse(t))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Dispatch handles POST /api/v1/transfers/{transferId}/dispatch
+func (h *TransferHandler) Dispatch(w http.ResponseWriter, r *http.Request) {
+	transferID, ok := pathValue(w, r, "transferId")
+	if !ok {
+		return
+	}
+
+	transfer, err := h.useCase.Dispatch(requestContext(r), transferID, middleware.GetUserID(r.Context()))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toTransferResponse(transfer))
+}
+
+// MarkInTransit handles POST /api/v1/transfers/{transferId}/in-transit
+func (h *TransferHandler) MarkInTransit(w http.ResponseWriter, r *http.Request) {
+	transferID, ok := pathValue(w, r, "transferId")
+	if !ok {
+		return
+	}
+
+	transfer, err := h.useCase.MarkInTransit(requestContext(r), transferID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toTransferResponse(transfer))
+}
+
+// Receive handles POST /api/v1/transfers/{transferId}/receive
+func (h *TransferHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	transferID, ok := pathValue(w, r, "transferId")
+	if !ok {
+		return
+	}
+
+	var req dto.ReceiveTransferRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.ReceiveTransferInput{
+		TransferID:  transferID,
+		Items:       make([]usecase.TransferItemInput, 0, len(req.Items)),
+		PerformedBy: middleware.GetUserID(r.Context()),
+	}
+	for _, item := range req.Items {
+		in.Items = append(in.Items, usecase.TransferItemInput{ProductID: item.ProductID, Quantity: *item.Quantity})
+	}
+
+	details, err := h.useCase.Receive(requestContext(r), in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := toTransferResponse(details.Transfer)
+	for _, b := range details.FilledBackorders {
+		resp.FilledBackorders = append(resp.FilledBackorders, toBackorderResponse(b))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func toTransferResponse(t *entity.Transfer) dto.TransferResponse {
+	resp := dto.TransferResponse{
+		ID:                     t.ID,
+		SourceWarehouseID:      t.SourceWarehouseID,
+		DestinationWarehouseID: t.DestinationWarehouseID,
+		Status:                 strings.ToLower(string(t.Status)),
+		Items:                  make([]dto.TransferItemResponse, 0, len(t.Items)),
+		InTransitQuantity:      t.InTransitQuantity(),
+		ShortQuantity:          t.ShortQuantity(),
+		Notes:                  t.Notes,
+		CreatedBy:              t.CreatedBy,
+		CreatedAt:              t.CreatedAt,
+		UpdatedAt:              t.UpdatedAt,
+		DispatchedAt:           t.DispatchedAt,
+		ReceivedAt:             t.ReceivedAt,
+	}
+	received := t.ReceivedAt != nil
+	for _, item := range t.Items {
+		line := dto.TransferItemResponse{
+			ProductID:              item.ProductID,
+			SourceStockItemID:      item.SourceStockItemID,
+			DestinationStockItemID: item.DestinationStockItemID,
+			Quantity:               item.Quantity,
+			ReceivedQuantity:       item.ReceivedQuantity,
+		}
+		if received {
+			line.ShortQuantity = item.ShortQuantity()
+		}
+		resp.Items = append(resp.Items, line)
+	}
+	return resp
+}
@@ -0,0 +1,128 @@
+// file: internal/interfaces/http/handler/unit_handler.go
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
+
+// UnitUseCase defines the use case operations the handler depends on.
+type UnitUseCase interface {
+	CreateUnit(ctx context.Context, in usecase.CreateUnitInput) (*entity.UnitOfMeasure, error)
+	ListUnits(ctx context.Context) ([]*entity.UnitOfMeasure, error)
+	SetConversion(ctx context.Context, productID, unitCode string, in usecase.SetConversionInput) (*entity.UnitConversion, error)
+	ListConversions(ctx context.Context, productID string) ([]*entity.UnitConversion, error)
+}
+
+// UnitHandler handles HTTP requests for the /api/v1/units resource and the unit
+// conversions of products.
+type UnitHandler struct {
+	useCase UnitUseCase
+}
+
+// NewUnitHandler constructs a UnitHandler with its use case dependency.
+func NewUnitHandler(uc UnitUseCase) *UnitHandler {
+	return &UnitHandler{useCase: uc}
+}
+
+// Create handles POST /api/v1/units
+func (h *UnitHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateUnitRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	unit, err := h.useCase.CreateUnit(requestContext(r), usecase.CreateUnitInput{Code: req.Code, Name: req.Name})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toUnitResponse(unit))
+}
+
+// List handles GET /api/v1/units
+func (h *UnitHandler) List(w http.ResponseWriter, r *http.Request) {
+	units, err := h.useCase.ListUnits(requestContext(r))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListUnitsResponse{Units: make([]dto.UnitResponse, 0, len(units))}
+	for _, u := range units {
+		resp.Units = append(resp.Units, toUnitResponse(u))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// SetConversion handles PUT /api/v1/products/{productId}/units/{unitCode}
+func (h *UnitHandler) SetConversion(w http.ResponseWriter, r *http.Request) {
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+	unitCode, ok := pathValue(w, r, "unitCode")
+	if !ok {
+		return
+	}
+
+	var req dto.SetUnitConversionRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	conversion, err := h.useCase.SetConversion(requestContext(r), productID, unitCode, usecase.SetConversionInput{
+		Quantity:     quantity,
+		BaseQuantity: req.BaseQuantity,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUnitConversionResponse(conversion))
+}
+
+// ListConversions handles GET /api/v1/products/{productId}/units
+func (h *UnitHandler) ListConversions(w http.ResponseWriter, r *http.Request) {
+	productID, ok := pathValue(w, r, "productId")
+	if !ok {
+		return
+	}
+
+	conversions, err := h.useCase.ListConversions(requestContext(r), productID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListUnitConversionsResponse{Conversions: make([]dto.UnitConversionResponse, 0, len(conversions))}
+	for _, c := range conversions {
+		resp.Conversions = append(resp.Conversions, toUnitConversionResponse(c))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func toUnitResponse(u *entity.UnitOfMeasure) dto.UnitResponse {
+	return dto.UnitResponse{Code: u.Code, Name: u.Name, CreatedAt: u.CreatedAt}
+}
+
+func toUnitConversionResponse(c *entity.UnitConversion) dto.UnitConversionResponse {
+	return dto.UnitConversionResponse{
+		ProductID:    c.ProductID,
+		Unit:         c.UnitCode,
+		Quantity:     c.Quantity,
+		BaseQuantity: c.BaseQuantity,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
@@ -1,11 +1,23 @@
 // file: internal/interfaces/http/handler/warehouse_handler.go
 package handler
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+
+	"github.com/inventory-service/internal/application/usecase"
+	"github.com/inventory-service/internal/domain/entity"
+	"github.com/inventory-service/internal/domain/repository"
+	"github.com/inventory-service/internal/interfaces/http/dto"
+)
 
 // WarehouseUseCase defines the use case operations the handler depends on.
 type WarehouseUseCase interface {
-	// TODO: define methods once application/usecase/ files are generated.
+	Create(ctx context.Context, in usecase.CreateWarehouseInput) (*usecase.WarehouseDetails, error)
+	GetByID(ctx context.Context, id string) (*usecase.WarehouseDetails, error)
+	List(ctx context.Context, filter repository.WarehouseFilter) ([]*usecase.WarehouseDetails, int, error)
+	Update(ctx context.Context, id string, in usecase.UpdateWarehouseInput) (*usecase.WarehouseDetails, error)
+	Delete(ctx context.Context, id string) error
 }
 
 // WarehouseHandler handles HTTP requests for the /api/v1/warehouses resource.
@@ -20,33 +32,147 @@ func NewWarehouseHandler(uc WarehouseUseCase) *WarehouseHandler {
 
 // Create handles POST /api/v1/warehouses
 func (h *WarehouseHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// TODO: decode dto.CreateWarehouseRequest, call h.useCase.Create, encode dto.WarehouseResponse
-	writeNotImplemented(w)
+	var req dto.CreateWarehouseRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	warehouse, err := h.useCase.Create(requestContext(r), usecase.CreateWarehouseInput{
+		Code:     req.Code,
+		Name:     req.Name,
+		Address:  toEntityAddress(req.Address),
+		Priority: req.Priority,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toWarehouseResponse(warehouse))
 }
 
 // List handles GET /api/v1/warehouses
 func (h *WarehouseHandler) List(w http.ResponseWriter, r *http.Request) {
-	// TODO: parse query params, call h.useCase.List, encode dto.ListWarehousesResponse
-	writeNotImplemented(w)
+	page, err := parsePagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	activeOnly, err := queryBool(r, "active_only")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	limit, offset := limitOffset(page)
+	filter := repository.WarehouseFilter{
+		Code:   queryString(r, "code"),
+		Name:   queryString(r, "name"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if activeOnly != nil && *activeOnly {
+		filter.IsActive = activeOnly
+	}
+
+	warehouses, total, err := h.useCase.List(requestContext(r), filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := dto.ListWarehousesResponse{
+		Warehouses: make([]dto.WarehouseResponse, 0, len(warehouses)),
+		Pagination: newPaginationResponse(page, total),
+	}
+	for _, wh := range warehouses {
+		resp.Warehouses = append(resp.Warehouses, toWarehouseResponse(wh))
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
 // Get handles GET /api/v1/warehouses/{warehouseId}
 func (h *WarehouseHandler) Get(w http.ResponseWriter, r *http.Request) {
-	// warehouseID := r.PathValue("warehouseId")
-	// TODO: call h.useCase.GetByID, encode dto.WarehouseResponse
-	writeNotImplemented(w)
+	warehouseID, ok := pathValue(w, r, "warehouseId")
+	if !ok {
+		return
+	}
+
+	warehouse, err := h.useCase.GetByID(requestContext(r), warehouseID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toWarehouseResponse(warehouse))
 }
 
 // Update handles PUT /api/v1/warehouses/{warehouseId}
 func (h *WarehouseHandler) Update(w http.ResponseWriter, r *http.Request) {
-	// warehouseID := r.PathValue("warehouseId")
-	// TODO: decode dto.UpdateWarehouseRequest, call h.useCase.Update, encode dto.WarehouseResponse
-	writeNotImplemented(w)
+	warehouseID, ok := pathValue(w, r, "warehouseId")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateWarehouseRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	in := usecase.UpdateWarehouseInput{Name: req.Name, Priority: req.Priority}
+	if req.Address != nil {
+		address := toEntityAddress(*req.Address)
+		in.Address = &address
+	}
+
+	warehouse, err := h.useCase.Update(requestContext(r), warehouseID, in)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toWarehouseResponse(warehouse))
 }
 
 // Delete handles DELETE /api/v1/warehouses/{warehouseId}
 func (h *WarehouseHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	// warehouseID := r.PathValue("warehouseId")
-	// TODO: call h.useCase.Delete
-	w.WriteHeader(http.StatusNotImplemented)
+	warehouseID, ok := pathValue(w, r, "warehouseId")
+	if !ok {
+		return
+	}
+
+	if err := h.useCase.Delete(requestContext(r), warehouseID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toEntityAddress(a dto.WarehouseAddress) entity.WarehouseAddress {
+	return entity.WarehouseAddress{
+		Street:     a.Street,
+		City:       a.City,
+		State:      a.State,
+		Country:    a.Country,
+		PostalCode: a.PostalCode,
+	}
+}
+
+func toWarehouseResponse(w *usecase.WarehouseDetails) dto.WarehouseResponse {
+	return dto.WarehouseResponse{
+		ID:   w.ID,
+		Name: w.Name,
+		Code: w.Code,
+		Address: dto.WarehouseAddress{
+			Street:     w.Address.Street,
+			City:       w.Address.City,
+			State:      w.Address.State,
+			PostalCode: w.Address.PostalCode,
+			Country:    w.Address.Country,
+		},
+		IsActive:      w.IsActive,
+		Priority:      w.Priority,
+		TotalProducts: w.TotalProducts,
+		CreatedAt:     w.CreatedAt,
+		UpdatedAt:     w.UpdatedAt,
+	}
 }
@@ -349,29 +349,6 @@ func (m *JWTMiddleware) writeError(w http.ResponseWriter, status int, code, mess
 	})
 }
 
-// Helper functions to avoid crypto imports in this file
-func sha256Hash() interface{ Write([]byte); Sum([]byte) []byte } {
-	return &sha256Hasher{}
-}
-
-type sha256Hasher struct {
-	data []byte
-}
-
-func (h *sha256Hasher) Write(p []byte) {
-	h.data = append(h.data, p...)
-}
-
-func (h *sha256Hasher) Sum(b []byte) []byte {
-	// This is a placeholder - actual implementation uses crypto/sha256
-	return append(b, h.data...)
-}
-
-func rsaVerifyPKCS1v15(pub *rsa.PublicKey, hashed, sig []byte) error {
-	// This is a placeholder - actual implementation uses crypto/rsa
-	return nil
-}
-
 // GetUserID extracts user ID from context
 func GetUserID(ctx context.Context) string {
 	if v := ctx.Value(ContextKeyUserID); v != nil {
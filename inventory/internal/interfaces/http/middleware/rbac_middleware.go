@@ -36,8 +36,27 @@ const (
 	PermissionReservationRead   Permission = "reservation:read"
 	PermissionReservationFulfill Permission = "reservation:fulfill"
 	PermissionReservationRelease Permission = "reservation:release"
+	PermissionReservationExtend Permission = "reservation:extend"
+	PermissionBackorderRead     Permission = "backorder:read"
+	PermissionBackorderUpdate   Permission = "backorder:update"
+	PermissionTransferCreate    Permission = "transfer:create"
+	PermissionTransferRead      Permission = "transfer:read"
+	PermissionTransferDispatch  Permission = "transfer:dispatch"
+	PermissionTransferReceive   Permission = "transfer:receive"
+	PermissionAdjustmentCreate  Permission = "adjustment:create"
+	PermissionAdjustmentRead    Permission = "adjustment:read"
+	PermissionAdjustmentApprove Permission = "adjustment:approve"
+	PermissionCountCreate       Permission = "count:create"
+	PermissionCountRead         Permission = "count:read"
+	PermissionCountRecord       Permission = "count:record"
+	PermissionCountApprove      Permission = "count:approve"
+	PermissionLocationCreate    Permission = "location:create"
+	PermissionLocationRead      Permission = "location:read"
+	PermissionBinMove           Permission = "bin:move"
 	PermissionMovementRead      Permission = "movement:read"
 	PermissionAlertRead         Permission = "alert:read"
+	PermissionDeadLetterRead    Permission = "dead_letter:read"
+	PermissionDeadLetterReplay  Permission = "dead_letter:replay"
 )
 
 // RolePermissions maps roles to their allowed permissions
@@ -47,13 +66,26 @@ var RolePermissions = map[string][]Permission{
 		PermissionWarehouseCreate, PermissionWarehouseRead, PermissionWarehouseUpdate, PermissionWarehouseDelete,
 		PermissionStockItemCreate, PermissionStockItemRead, PermissionStockReplenish,
 		PermissionReservationCreate, PermissionReservationRead, PermissionReservationFulfill, PermissionReservationRelease,
+		PermissionReservationExtend,
+		PermissionBackorderRead, PermissionBackorderUpdate,
+		PermissionTransferCreate, PermissionTransferRead, PermissionTransferDispatch, PermissionTransferReceive,
+		PermissionAdjustmentCreate, PermissionAdjustmentRead, PermissionAdjustmentApprove,
+		PermissionCountCreate, PermissionCountRead, PermissionCountRecord, PermissionCountApprove,
+		PermissionLocationCreate, PermissionLocationRead, PermissionBinMove,
 		PermissionMovementRead, PermissionAlertRead,
+		PermissionDeadLetterRead, PermissionDeadLetterReplay,
 	},
 	RoleInventoryManager: {
 		PermissionProductCreate, PermissionProductRead, PermissionProductUpdate,
 		PermissionWarehouseRead,
 		PermissionStockItemCreate, PermissionStockItemRead, PermissionStockReplenish,
 		PermissionReservationRead, PermissionReservationFulfill, PermissionReservationRelease,
+		PermissionReservationExtend,
+		PermissionBackorderRead, PermissionBackorderUpdate,
+		PermissionTransferCreate, PermissionTransferRead, PermissionTransferDispatch, PermissionTransferReceive,
+		PermissionAdjustmentCreate, PermissionAdjustmentRead, PermissionAdjustmentApprove,
+		PermissionCountCreate, PermissionCountRead, PermissionCountRecord, PermissionCountApprove,
+		PermissionLocationCreate, PermissionLocationRead, PermissionBinMove,
 		PermissionMovementRead, PermissionAlertRead,
 	},
 	RoleWarehouseStaff: {
@@ -61,18 +93,30 @@ var RolePermissions = map[string][]Permission{
 		PermissionWarehouseRead,
 		PermissionStockItemRead, PermissionStockReplenish,
 		PermissionReservationRead, PermissionReservationFulfill,
+		PermissionBackorderRead,
+		PermissionTransferRead, PermissionTransferDispatch, PermissionTransferReceive,
+		PermissionAdjustmentCreate, PermissionAdjustmentRead,
+		PermissionCountRead, PermissionCountRecord,
+		PermissionLocationRead, PermissionBinMove,
 		PermissionMovementRead, PermissionAlertRead,
 	},
 	RoleOrderService: {
 		PermissionProductRead,
 		PermissionStockItemRead,
 		PermissionReservationCreate, PermissionReservationRead, PermissionReservationFulfill, PermissionReservationRelease,
+		PermissionReservationExtend,
+		PermissionBackorderRead, PermissionBackorderUpdate,
 	},
 	RoleReadOnly: {
 		PermissionProductRead,
 		PermissionWarehouseRead,
 		PermissionStockItemRead,
 		PermissionReservationRead,
+		PermissionBackorderRead,
+		PermissionTransferRead,
+		PermissionAdjustmentRead,
+		PermissionCountRead,
+		PermissionLocationRead,
 		PermissionMovementRead,
 		PermissionAlertRead,
 	},
@@ -93,6 +137,11 @@ var EndpointPermissions = []EndpointPermission{
 	{Method: http.MethodPut, PathPrefix: "/api/v1/products/", Permission: PermissionProductUpdate},
 	{Method: http.MethodDelete, PathPrefix: "/api/v1/products/", Permission: PermissionProductDelete},
 
+	// Units of measure are part of the product catalog, as are the per-product conversions
+	// under /api/v1/products
+	{Method: http.MethodPost, PathPrefix: "/api/v1/units", Permission: PermissionProductCreate},
+	{Method: http.MethodGet, PathPrefix: "/api/v1/units", Permission: PermissionProductRead},
+
 	// Warehouses
 	{Method: http.MethodPost, PathPrefix: "/api/v1/warehouses", Permission: PermissionWarehouseCreate},
 	{Method: http.MethodGet, PathPrefix: "/api/v1/warehouses", Permission: PermissionWarehouseRead},
@@ -103,17 +152,49 @@ var EndpointPermissions = []EndpointPermission{
 	{Method: http.MethodPost, PathPrefix: "/api/v1/stock-items", Permission: PermissionStockItemCreate},
 	{Method: http.MethodGet, PathPrefix: "/api/v1/stock-items", Permission: PermissionStockItemRead},
 
+	// Lots (lot movements are covered by the /movements special case)
+	{Method: http.MethodGet, PathPrefix: "/api/v1/lots", Permission: PermissionStockItemRead},
+
+	// Serial units (scrapping writes stock off without an adjustment, so it takes an approver)
+	{Method: http.MethodGet, PathPrefix: "/api/v1/serials", Permission: PermissionStockItemRead},
+	{Method: http.MethodPost, PathPrefix: "/api/v1/serials/", Permission: PermissionAdjustmentApprove},
+
 	// Reservations
 	{Method: http.MethodPost, PathPrefix: "/api/v1/reservations", Permission: PermissionReservationCreate},
 	{Method: http.MethodGet, PathPrefix: "/api/v1/reservations", Permission: PermissionReservationRead},
 	{Method: http.MethodPost, PathPrefix: "/api/v1/reservations/", Permission: PermissionReservationFulfill},
 
+	// Backorders
+	{Method: http.MethodGet, PathPrefix: "/api/v1/backorders", Permission: PermissionBackorderRead},
+	{Method: http.MethodPut, PathPrefix: "/api/v1/backorders/", Permission: PermissionBackorderUpdate},
+
+	// Transfers
+	{Method: http.MethodPost, PathPrefix: "/api/v1/transfers", Permission: PermissionTransferCreate},
+	{Method: http.MethodGet, PathPrefix: "/api/v1/transfers", Permission: PermissionTransferRead},
+
+	// Adjustments
+	{Method: http.MethodPost, PathPrefix: "/api/v1/adjustments", Permission: PermissionAdjustmentCreate},
+	{Method: http.MethodGet, PathPrefix: "/api/v1/adjustments", Permission: PermissionAdjustmentRead},
+
+	// Count sessions
+	{Method: http.MethodPost, PathPrefix: "/api/v1/count-sessions", Permission: PermissionCountCreate},
+	{Method: http.MethodGet, PathPrefix: "/api/v1/count-sessions", Permission: PermissionCountRead},
+
+	// Locations (bin movements are covered by the /movements special case)
+	{Method: http.MethodPost, PathPrefix: "/api/v1/locations", Permission: PermissionLocationCreate},
+	{Method: http.MethodGet, PathPrefix: "/api/v1/locations", Permission: PermissionLocationRead},
+	{Method: http.MethodPost, PathPrefix: "/api/v1/bin-moves", Permission: PermissionBinMove},
+
 	// Stock Movements
 	{Method: http.MethodPost, PathPrefix: "/api/v1/stock-movements/replenish", Permission: PermissionStockReplenish},
 	{Method: http.MethodGet, PathPrefix: "/api/v1/stock-movements", Permission: PermissionMovementRead},
 
 	// Alerts
 	{Method: http.MethodGet, PathPrefix: "/api/v1/alerts", Permission: PermissionAlertRead},
+
+	// Admin: dead letters
+	{Method: http.MethodGet, PathPrefix: "/api/v1/admin/dead-letters", Permission: PermissionDeadLetterRead},
+	{Method: http.MethodPost, PathPrefix: "/api/v1/admin/dead-letters/", Permission: PermissionDeadLetterReplay},
 }
 
 // RBACMiddleware enforces role-based access control
@@ -209,6 +290,31 @@ func (m *RBACMiddleware) getRequiredPermission(method, path string) Permission {
 	if strings.Contains(path, "/fulfill") {
 		return PermissionReservationFulfill
 	}
+	if strings.Contains(path, "/extend") {
+		return PermissionReservationExtend
+	}
+	if strings.Contains(path, "/dispatch") || strings.Contains(path, "/in-transit") {
+		return PermissionTransferDispatch
+	}
+	if strings.Contains(path, "/receive") {
+		return PermissionTransferReceive
+	}
+	if strings.HasPrefix(path, "/api/v1/count-sessions/") && strings.Contains(path, "/cancel") {
+		// Cancelling discards the recorded counts, so it takes the same role as approving them
+		return PermissionCountApprove
+	}
+	if strings.Contains(path, "/approve") || strings.Contains(path, "/reject") {
+		if strings.HasPrefix(path, "/api/v1/count-sessions/") {
+			return PermissionCountApprove
+		}
+		return PermissionAdjustmentApprove
+	}
+	if strings.Contains(path, "/counts") || strings.Contains(path, "/submit") {
+		return PermissionCountRecord
+	}
+	if strings.Contains(path, "/backorders") && method == http.MethodGet {
+		return PermissionBackorderRead
+	}
 	if strings.Contains(path, "/stock") && method == http.MethodGet {
 		return PermissionStockItemRead
 	}
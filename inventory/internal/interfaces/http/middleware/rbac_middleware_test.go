@@ -0,0 +1,32 @@
+// file: internal/interfaces/http/middleware/rbac_middleware_test.go
+package middleware
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetRequiredPermissionCountSessions(t *testing.T) {
+	m := NewRBACMiddleware()
+	tests := []struct {
+		method string
+		path   string
+		want   Permission
+	}{
+		{http.MethodPost, "/api/v1/count-sessions", PermissionCountCreate},
+		{http.MethodGet, "/api/v1/count-sessions/cs-1", PermissionCountRead},
+		{http.MethodPost, "/api/v1/count-sessions/cs-1/counts", PermissionCountRecord},
+		{http.MethodPost, "/api/v1/count-sessions/cs-1/submit", PermissionCountRecord},
+		{http.MethodPost, "/api/v1/count-sessions/cs-1/approve", PermissionCountApprove},
+		{http.MethodPost, "/api/v1/count-sessions/cs-1/reject", PermissionCountApprove},
+		{http.MethodPost, "/api/v1/count-sessions/cs-1/cancel", PermissionCountApprove},
+		{http.MethodPost, "/api/v1/adjustments/adj-1/approve", PermissionAdjustmentApprove},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			if got := m.getRequiredPermission(tt.method, tt.path); got != tt.want {
+				t.Fatalf("permission: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,8 +18,17 @@ type Config struct {
 	Warehouse    *handler.WarehouseHandler
 	StockItem    *handler.StockItemHandler
 	Reservation  *handler.ReservationHandler
+	Backorder    *handler.BackorderHandler
+	Transfer     *handler.TransferHandler
+	Adjustment   *handler.AdjustmentHandler
+	CountSession *handler.CountSessionHandler
+	Lot          *handler.LotHandler
+	Serial       *handler.SerialUnitHandler
+	Location     *handler.LocationHandler
+	Unit         *handler.UnitHandler
 	StockMovement *handler.StockMovementHandler
 	Alert        *handler.AlertHandler
+	DeadLetter   *handler.DeadLetterHandler
 }
 
 // New builds and returns the fully-wired http.Handler.
@@ -37,12 +46,15 @@ func New(cfg Config) http.Handler {
 	}
 
 	// ── Products ─────────────────────────────────────────────────────────────
-	mux.Handle("POST /api/v1/products",                      auth(cfg.Product.Create))
-	mux.Handle("GET /api/v1/products",                       auth(cfg.Product.List))
-	mux.Handle("GET /api/v1/products/{productId}",           auth(cfg.Product.Get))
-	mux.Handle("PUT /api/v1/products/{productId}",           auth(cfg.Product.Update))
-	mux.Handle("DELETE /api/v1/products/{productId}",        auth(cfg.Product.Delete))
-	mux.Handle("GET /api/v1/products/{productId}/stock",     auth(cfg.StockItem.GetAggregatedStock))
+	mux.Handle("POST /api/v1/products",                                      auth(cfg.Product.Create))
+	mux.Handle("GET /api/v1/products",                                       auth(cfg.Product.List))
+	mux.Handle("GET /api/v1/products/{productId}",                           auth(cfg.Product.Get))
+	mux.Handle("PUT /api/v1/products/{productId}",                           auth(cfg.Product.Update))
+	mux.Handle("DELETE /api/v1/products/{productId}",                        auth(cfg.Product.Delete))
+	mux.Handle("POST /api/v1/products/{productId}/variants",                 auth(cfg.Product.AddVariant))
+	mux.Handle("GET /api/v1/products/{productId}/stock",                     auth(cfg.StockItem.GetAggregatedStock))
+	mux.Handle("GET /api/v1/products/{productId}/units",                     auth(cfg.Unit.ListConversions))
+	mux.Handle("PUT /api/v1/products/{productId}/units/{unitCode}",          auth(cfg.Unit.SetConversion))
 
 	// ── Warehouses ────────────────────────────────────────────────────────────
 	mux.Handle("POST /api/v1/warehouses",                    auth(cfg.Warehouse.Create))
@@ -56,14 +68,69 @@ func New(cfg Config) http.Handler {
 	mux.Handle("GET /api/v1/stock-items",                             auth(cfg.StockItem.List))
 	mux.Handle("GET /api/v1/stock-items/{stockItemId}",               auth(cfg.StockItem.Get))
 	mux.Handle("GET /api/v1/stock-items/{stockItemId}/movements",     auth(cfg.StockMovement.ListForStockItem))
+	mux.Handle("GET /api/v1/stock-items/{stockItemId}/lots",          auth(cfg.Lot.ListForStockItem))
+	mux.Handle("GET /api/v1/stock-items/{stockItemId}/bins",          auth(cfg.Location.ListForStockItem))
 
 	// ── Reservations ──────────────────────────────────────────────────────────
 	mux.Handle("POST /api/v1/reservations",                                  auth(cfg.Reservation.Create))
 	mux.Handle("GET /api/v1/reservations/{reservationId}",                   auth(cfg.Reservation.Get))
 	mux.Handle("POST /api/v1/reservations/{reservationId}/release",          auth(cfg.Reservation.Release))
 	mux.Handle("POST /api/v1/reservations/{reservationId}/fulfill",          auth(cfg.Reservation.Fulfill))
+	mux.Handle("POST /api/v1/reservations/{reservationId}/extend",           auth(cfg.Reservation.Extend))
+	mux.Handle("GET /api/v1/reservations/{reservationId}/picks",             auth(cfg.Reservation.Picks))
 	mux.Handle("GET /api/v1/orders/{orderId}/reservations",                  auth(cfg.Reservation.ListByOrder))
 
+	// ── Backorders ────────────────────────────────────────────────────────────
+	mux.Handle("GET /api/v1/backorders/{backorderId}",                       auth(cfg.Backorder.Get))
+	mux.Handle("PUT /api/v1/backorders/{backorderId}/priority",              auth(cfg.Backorder.UpdatePriority))
+	mux.Handle("GET /api/v1/orders/{orderId}/backorders",                    auth(cfg.Backorder.ListByOrder))
+
+	// ── Transfers ─────────────────────────────────────────────────────────────
+	mux.Handle("POST /api/v1/transfers",                                     auth(cfg.Transfer.Create))
+	mux.Handle("GET /api/v1/transfers",                                      auth(cfg.Transfer.List))
+	mux.Handle("GET /api/v1/transfers/{transferId}",                         auth(cfg.Transfer.Get))
+	mux.Handle("POST /api/v1/transfers/{transferId}/dispatch",               auth(cfg.Transfer.Dispatch))
+	mux.Handle("POST /api/v1/transfers/{transferId}/in-transit",             auth(cfg.Transfer.MarkInTransit))
+	mux.Handle("POST /api/v1/transfers/{transferId}/receive",                auth(cfg.Transfer.Receive))
+
+	// ── Adjustments ───────────────────────────────────────────────────────────
+	mux.Handle("POST /api/v1/adjustments",                                   auth(cfg.Adjustment.Create))
+	mux.Handle("GET /api/v1/adjustments",                                    auth(cfg.Adjustment.List))
+	mux.Handle("GET /api/v1/adjustments/reason-codes",                       auth(cfg.Adjustment.ListReasonCodes))
+	mux.Handle("GET /api/v1/adjustments/{adjustmentId}",                     auth(cfg.Adjustment.Get))
+	mux.Handle("POST /api/v1/adjustments/{adjustmentId}/approve",            auth(cfg.Adjustment.Approve))
+	mux.Handle("POST /api/v1/adjustments/{adjustmentId}/reject",             auth(cfg.Adjustment.Reject))
+
+	// ── Count Sessions ────────────────────────────────────────────────────────
+	mux.Handle("POST /api/v1/count-sessions",                                auth(cfg.CountSession.Create))
+	mux.Handle("GET /api/v1/count-sessions",                                 auth(cfg.CountSession.List))
+	mux.Handle("GET /api/v1/count-sessions/{countSessionId}",                auth(cfg.CountSession.Get))
+	mux.Handle("POST /api/v1/count-sessions/{countSessionId}/counts",        auth(cfg.CountSession.Record))
+	mux.Handle("POST /api/v1/count-sessions/{countSessionId}/submit",        auth(cfg.CountSession.Submit))
+	mux.Handle("POST /api/v1/count-sessions/{countSessionId}/approve",       auth(cfg.CountSession.Approve))
+	mux.Handle("POST /api/v1/count-sessions/{countSessionId}/cancel",        auth(cfg.CountSession.Cancel))
+
+	// ── Lots ──────────────────────────────────────────────────────────────────
+	mux.Handle("GET /api/v1/lots",                           auth(cfg.Lot.List))
+	mux.Handle("GET /api/v1/lots/{lotId}",                   auth(cfg.Lot.Get))
+	mux.Handle("GET /api/v1/lots/{lotId}/movements",         auth(cfg.Lot.ListMovements))
+
+	// ── Serial Units ──────────────────────────────────────────────────────────
+	mux.Handle("GET /api/v1/serials",                        auth(cfg.Serial.List))
+	mux.Handle("GET /api/v1/serials/{serialUnitId}",         auth(cfg.Serial.Get))
+	mux.Handle("POST /api/v1/serials/{serialUnitId}/scrap",  auth(cfg.Serial.Scrap))
+
+	// ── Locations ─────────────────────────────────────────────────────────────
+	mux.Handle("POST /api/v1/locations",                           auth(cfg.Location.Create))
+	mux.Handle("GET /api/v1/locations",                            auth(cfg.Location.List))
+	mux.Handle("GET /api/v1/locations/{locationId}",               auth(cfg.Location.Get))
+	mux.Handle("GET /api/v1/locations/{locationId}/movements",     auth(cfg.Location.ListMovements))
+	mux.Handle("POST /api/v1/bin-moves",                           auth(cfg.Location.Move))
+
+	// ── Units of Measure ──────────────────────────────────────────────────────
+	mux.Handle("POST /api/v1/units",                         auth(cfg.Unit.Create))
+	mux.Handle("GET /api/v1/units",                          auth(cfg.Unit.List))
+
 	// ── Stock Movements ───────────────────────────────────────────────────────
 	mux.Handle("POST /api/v1/stock-movements/replenish",     auth(cfg.StockMovement.Replenish))
 	mux.Handle("GET /api/v1/stock-movements",                auth(cfg.StockMovement.List))
@@ -71,6 +138,11 @@ func New(cfg Config) http.Handler {
 	// ── Alerts ────────────────────────────────────────────────────────────────
 	mux.Handle("GET /api/v1/alerts/low-stock",               auth(cfg.Alert.ListLowStock))
 
+	// ── Admin: Dead Letters ───────────────────────────────────────────────────
+	mux.Handle("GET /api/v1/admin/dead-letters",                          auth(cfg.DeadLetter.List))
+	mux.Handle("GET /api/v1/admin/dead-letters/{deadLetterId}",           auth(cfg.DeadLetter.Get))
+	mux.Handle("POST /api/v1/admin/dead-letters/{deadLetterId}/replay",   auth(cfg.DeadLetter.Replay))
+
 	return mux
 }
 
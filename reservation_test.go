@@ -0,0 +1,146 @@
+
+func TestReservationShip(t *testing.T) {
+	type shipment struct {
+		id    string
+		items []ProductQuantity
+	}
+	tests := []struct {
+		name          string
+		shipments     []shipment
+		wantFulfilled []int // Per line
+		wantStatus    ReservationStatus
+		wantErr       error
+	}{
+		{
+			name: "from the first line first",
+			shipments: []shipment{
+				{id: "shipment-1", items: []ProductQuantity{{ProductID: "product-1", Quantity: 4}}},
+			},
+			wantFulfilled: []int{3, 0, 1},
+			wantStatus:    ReservationStatusPartiallyFulfilled,
+		},
+		{
+			name: "everything held",
+			shipments: []shipment{
+				{id: "shipment-1"},
+			},
+			wantFulfilled: []int{3, 2, 4},
+			wantStatus:    ReservationStatusFulfilled,
+		},
+		{
+			name: "the rest in a second shipment",
+			shipments: []shipment{
+				{id: "shipment-1", items: []ProductQuantity{{ProductID: "product-1", Quantity: 4}}},
+				{id: "shipment-2", items: []ProductQuantity{
+					{ProductID: "product-1", Quantity: 3},
+					{ProductID: "product-2", Quantity: 2},
+				}},
+			},
+			wantFulfilled: []int{3, 2, 4},
+			wantStatus:    ReservationStatusFulfilled,
+		},
+		{
+			name: "shipment ID repeated",
+			shipments: []shipment{
+				{id: "shipment-1", items: []ProductQuantity{{ProductID: "product-1", Quantity: 4}}},
+				{id: "shipment-1", items: []ProductQuantity{{ProductID: "product-1", Quantity: 3}}},
+			},
+			wantFulfilled: []int{3, 0, 1},
+			wantStatus:    ReservationStatusPartiallyFulfilled,
+			wantErr:       ErrShipmentAlreadyRecorded,
+		},
+		{
+			name: "shipment ID repeated after the reservation is fulfilled",
+			shipments: []shipment{
+				{id: "shipment-1"},
+				{id: "shipment-1"},
+			},
+			wantFulfilled: []int{3, 2, 4},
+			wantStatus:    ReservationStatusFulfilled,
+			wantErr:       ErrShipmentAlreadyRecorded,
+		},
+		{
+			name: "more than a line holds",
+			shipments: []shipment{
+				{id: "shipment-1", items: []ProductQuantity{{ProductID: "product-2", Quantity: 3}}},
+			},
+			wantFulfilled: []int{0, 0, 0},
+			wantStatus:    ReservationStatusConfirmed,
+			wantErr:       ErrShipmentQuantity,
+		},
+		{
+			name: "product listed twice, together more than held",
+			shipments: []shipment{
+				{id: "shipment-1", items: []ProductQuantity{
+					{ProductID: "product-1", Quantity: 4},
+					{ProductID: "product-1", Quantity: 4},
+				}},
+			},
+			wantFulfilled: []int{0, 0, 0},
+			wantStatus:    ReservationStatusConfirmed,
+			wantErr:       ErrShipmentQuantity,
+		},
+		{
+			name: "more than left after an earlier shipment",
+			shipments: []shipment{
+				{id: "shipment-1", items: []ProductQuantity{{ProductID: "product-1", Quantity: 4}}},
+				{id: "shipment-2", items: []ProductQuantity{{ProductID: "product-1", Quantity: 4}}},
+			},
+			wantFulfilled: []int{3, 0, 1},
+			wantStatus:    ReservationStatusPartiallyFulfilled,
+			wantErr:       ErrShipmentQuantity,
+		},
+		{
+			name: "without a shipment ID",
+			shipments: []shipment{
+				{items: []ProductQuantity{{ProductID: "product-1", Quantity: 1}}},
+			},
+			wantFulfilled: []int{0, 0, 0},
+			wantStatus:    ReservationStatusConfirmed,
+			wantErr:       ErrShipmentIDRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReservation(t)
+			var err error
+			for _, s := range tt.shipments {
+				if _, err = r.Ship(s.id, s.items, time.Now()); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("error: got %v, want %v", err, tt.wantErr)
+			}
+
+			var fulfilled []int
+			for _, item := range r.Items {
+				fulfilled = append(fulfilled, item.FulfilledQuantity)
+			}
+			if !slices.Equal(fulfilled, tt.wantFulfilled) {
+				t.Errorf("fulfilled: got %v, want %v", fulfilled, tt.wantFulfilled)
+			}
+			if r.Status != tt.wantStatus {
+				t.Errorf("status: got %s, want %s", r.Status, tt.wantStatus)
+			}
+			if tt.wantStatus == ReservationStatusFulfilled && r.FulfilledAt == nil {
+				t.Error("fulfilled reservation has no fulfilled time")
+			}
+		})
+	}
+}
+
+func TestReservationShipRecordsLines(t *testing.T) {
+	r := newTestReservation(t)
+	shipment, err := r.Ship("shipment-1", []ProductQuantity{{ProductID: "product-1", Quantity: 4}}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ShipmentLine{{Line: 0, Quantity: 3}, {Line: 2, Quantity: 1}}
+	if !slices.Equal(shipment.Lines, want) {
+		t.Errorf("shipment lines: got %v, want %v", shipment.Lines, want)
+	}
+	if got := r.Shipment("shipment-1"); got == nil || !slices.Equal(got.Lines, want) {
+		t.Errorf("recorded shipment: got %v, want lines %v", got, want)
+	}
+}